@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/xssnick/tonutils-go/tlb"
@@ -22,12 +23,86 @@ type InterfaceDesc struct {
 	OutMessages  []OperationDesc           `json:"out_messages,omitempty"`
 	GetMethods   []GetMethodDesc           `json:"get_methods,omitempty"`
 	ContractData TLBFieldsDesc             `json:"contract_data,omitempty"`
+	Verification *VerificationDesc         `json:"verification,omitempty"`
 }
 
-func RegisterDefinitions(definitions map[TLBType]TLBFieldsDesc, depth ...int) error {
+// Registry keeps named TL-B definitions, which can be used as formats of fields and values.
+// Local registries are layered over the global one, which is used for messages and account states parsing,
+// so new definitions can be checked before they are registered globally.
+type Registry struct {
+	parent *Registry
+
+	mx          sync.RWMutex
+	definitions map[TLBType]TLBFieldsDesc
+	tagged      map[TLBType]reflect.Type // definitions registered for the use in unions
+}
+
+var registry = &Registry{definitions: map[TLBType]TLBFieldsDesc{}}
+
+// NewRegistry returns local registry over the globally registered definitions.
+func NewRegistry() *Registry {
+	return &Registry{
+		parent:      registry,
+		definitions: map[TLBType]TLBFieldsDesc{},
+		tagged:      map[TLBType]reflect.Type{},
+	}
+}
+
+// orGlobal returns the global registry if r is nil.
+func (r *Registry) orGlobal() *Registry {
+	if r == nil {
+		return registry
+	}
+	return r
+}
+
+func (r *Registry) get(name TLBType) (TLBFieldsDesc, bool) {
+	r.mx.RLock()
+	d, ok := r.definitions[name]
+	r.mx.RUnlock()
+
+	if !ok && r.parent != nil {
+		return r.parent.get(name)
+	}
+	return d, ok
+}
+
+// names returns names of the definitions in the registry and its parents.
+func (r *Registry) names() (ret []TLBType) {
+	if r.parent != nil {
+		ret = r.parent.names()
+	}
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	for dn := range r.definitions {
+		ret = append(ret, dn)
+	}
+	return ret
+}
+
+func (r *Registry) put(name TLBType, d TLBFieldsDesc, tagged reflect.Type) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.definitions[name] = d
+	if tagged == nil {
+		return
+	}
+	if r.parent != nil {
+		r.tagged[name] = tagged
+		return
+	}
+	tlb.RegisterWithName(string(name), reflect.New(tagged).Elem().Interface())
+}
+
+// Register checks and adds definitions to the registry.
+// Definitions can refer to each other, so they are registered in several passes.
+func (r *Registry) Register(definitions map[TLBType]TLBFieldsDesc, depth ...int) error {
 	noDef := map[TLBType]TLBFieldsDesc{}
 	for dn, d := range definitions {
-		dt, err := tlbParseDesc(nil, d)
+		dt, err := tlbParseDesc(r, nil, d)
 		if err != nil && strings.Contains(err.Error(), "cannot find definition") {
 			noDef[dn] = d
 			continue
@@ -36,13 +111,14 @@ func RegisterDefinitions(definitions map[TLBType]TLBFieldsDesc, depth ...int) er
 			return errors.Wrapf(err, "parse '%s' definition", dn)
 		}
 
+		var tagged reflect.Type
 		if dt.Field(0).Type == typeNameMap[TLBTag] {
 			// if the first struct field has tag,
 			// we register it for the use in unions
-			tlb.RegisterWithName(string(dn), reflect.New(dt).Elem().Interface())
+			tagged = dt
 		}
 
-		registeredDefinitions[dn] = d
+		r.put(dn, d, tagged)
 	}
 
 	if len(noDef) == 0 {
@@ -65,5 +141,25 @@ func RegisterDefinitions(definitions map[TLBType]TLBFieldsDesc, depth ...int) er
 		return fmt.Errorf("cannot register [%s] definitions", strings.Join(faultNames, ", "))
 	}
 
-	return RegisterDefinitions(noDef, currentDepth+1, maxDepth)
+	return r.Register(noDef, currentDepth+1, maxDepth)
+}
+
+// Commit registers definitions of the local registry globally.
+// It should be called after the definitions are saved.
+func (r *Registry) Commit() {
+	if r.parent == nil {
+		return
+	}
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	for dn, d := range r.definitions {
+		r.parent.put(dn, d, r.tagged[dn])
+	}
+}
+
+// RegisterDefinitions adds definitions to the global registry.
+func RegisterDefinitions(definitions map[TLBType]TLBFieldsDesc, depth ...int) error {
+	return registry.Register(definitions, depth...)
 }
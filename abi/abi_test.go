@@ -31,3 +31,33 @@ func TestInterfaceDesc_UnmarshalJSON(t *testing.T) {
 	require.Equal(t, 1, len(d.Addresses))
 	require.Equal(t, "EQAOQdwdw8kGftJCSFgOErM1mBjYPe4DBPq8-AhF6vr9si5N", d.Addresses[0].Base64())
 }
+
+func TestRegistry_Commit(t *testing.T) {
+	definitions := map[abi.TLBType]abi.TLBFieldsDesc{
+		"registry_test_inner": {{Name: "value", Type: "## 32"}},
+		"registry_test_outer": {{Name: "inner", Type: "^", Format: "registry_test_inner"}},
+	}
+	desc := abi.TLBFieldsDesc{{Name: "outer", Type: ".", Format: "registry_test_outer"}}
+
+	reg := abi.NewRegistry()
+	require.Nil(t, reg.Register(definitions))
+
+	_, err := desc.NewIn(reg)
+	require.Nil(t, err)
+
+	_, err = desc.New()
+	require.ErrorContains(t, err, "cannot find definition for 'registry_test_outer' format")
+
+	reg.Commit()
+
+	_, err = desc.New()
+	require.Nil(t, err)
+}
+
+func TestRegistry_Register_Unknown(t *testing.T) {
+	reg := abi.NewRegistry()
+	err := reg.Register(map[abi.TLBType]abi.TLBFieldsDesc{
+		"registry_test_unknown": {{Name: "x", Type: ".", Format: "registry_test_missing"}},
+	})
+	require.ErrorContains(t, err, "cannot register [registry_test_unknown] definitions")
+}
@@ -0,0 +1,47 @@
+package abi
+
+// AccountColumn is the name of the universal indexed account state column,
+// which can be filled with the get-method return value.
+type AccountColumn string
+
+const (
+	ColumnOwnerAddress  AccountColumn = "owner_address"
+	ColumnMinterAddress AccountColumn = "minter_address"
+	ColumnTokenBalance  AccountColumn = "token_balance"
+	ColumnContent       AccountColumn = "content" // TEP-64 token data is mapped into content_* columns
+	ColumnTokenSupply   AccountColumn = "token_supply"
+
+	// liquidity pool columns
+	ColumnAsset0Address AccountColumn = "asset0_address"
+	ColumnAsset1Address AccountColumn = "asset1_address"
+	ColumnReserve0      AccountColumn = "reserve0"
+	ColumnReserve1      AccountColumn = "reserve1"
+)
+
+var accountColumns = map[AccountColumn]struct{}{
+	ColumnOwnerAddress:  {},
+	ColumnMinterAddress: {},
+	ColumnTokenBalance:  {},
+	ColumnContent:       {},
+	ColumnTokenSupply:   {},
+	ColumnAsset0Address: {},
+	ColumnAsset1Address: {},
+	ColumnReserve0:      {},
+	ColumnReserve1:      {},
+}
+
+// IsValid returns true if the column is supported.
+func (c AccountColumn) IsValid() bool {
+	_, ok := accountColumns[c]
+	return ok
+}
+
+// MappedColumns returns all account state columns filled with the get-method return values.
+func (d *GetMethodDesc) MappedColumns() (ret []AccountColumn) {
+	for it := range d.ReturnValues {
+		if d.ReturnValues[it].Column != "" {
+			ret = append(ret, d.ReturnValues[it].Column)
+		}
+	}
+	return ret
+}
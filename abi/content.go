@@ -0,0 +1,226 @@
+package abi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+// TokenData is a token metadata as described in TEP-64.
+// https://github.com/ton-blockchain/TEPs/blob/master/text/0064-token-data-standard.md
+//
+// Off-chain content has only URI set. On-chain content can have both URI
+// (semi-chain layout) and attributes stored in the dictionary of sha256 keys.
+// Attributes, which are not defined in TEP-64, are kept in Attributes map
+// with the hex-encoded sha256 hash of the attribute name as a key.
+//
+// URI, name, description and image fields keep json keys of the tonutils-go content structures,
+// which were used before, so stored get-method executions have the same format.
+type TokenData struct {
+	Onchain bool `json:"onchain,omitempty"`
+
+	URI         string `json:"URI,omitempty"`
+	Name        string `json:"Name,omitempty"`
+	Description string `json:"Description,omitempty"`
+	Image       string `json:"Image,omitempty"`
+	ImageData   []byte `json:"ImageData,omitempty"`
+	Symbol      string `json:"symbol,omitempty"`
+	Decimals    *uint8 `json:"decimals,omitempty"`
+	AmountStyle string `json:"amount_style,omitempty"`
+	RenderType  string `json:"render_type,omitempty"`
+
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+var tokenDataAttributes = map[string]string{}
+
+func init() {
+	for _, k := range []string{"uri", "name", "description", "image", "image_data", "symbol", "decimals", "amount_style", "render_type"} {
+		h := sha256.Sum256([]byte(k))
+		tokenDataAttributes[string(h[:])] = k
+	}
+}
+
+// loadContentData loads snake#00 or chunks#01 ContentData.
+func loadContentData(s *cell.Slice) ([]byte, error) {
+	prefix, err := s.LoadUInt(8)
+	if err != nil {
+		return nil, errors.Wrap(err, "load content data prefix")
+	}
+
+	switch prefix {
+	case 0x00:
+		data, err := s.LoadBinarySnake()
+		if err != nil {
+			return nil, errors.Wrap(err, "load snake data")
+		}
+		return data, nil
+
+	case 0x01:
+		// chunked_data#_ data:(HashMapE 32 ^(SnakeData ~0)) = ChunkedData;
+		dict, err := s.LoadDict(32)
+		if err != nil {
+			return nil, errors.Wrap(err, "load chunks dict")
+		}
+		kv, err := dict.LoadAll()
+		if err != nil {
+			return nil, errors.Wrap(err, "load all chunks")
+		}
+
+		chunks := make(map[uint64][]byte, len(kv))
+		keys := make([]uint64, 0, len(kv))
+		for _, c := range kv {
+			k, err := c.Key.LoadUInt(32)
+			if err != nil {
+				return nil, errors.Wrap(err, "load chunk key")
+			}
+			ref, err := c.Value.LoadRef()
+			if err != nil {
+				return nil, errors.Wrapf(err, "load %d chunk ref", k)
+			}
+			chunk, err := ref.LoadSlice(ref.BitsLeft())
+			if err != nil {
+				return nil, errors.Wrapf(err, "load %d chunk", k)
+			}
+			chunks[k] = chunk
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+		var data []byte
+		for _, k := range keys {
+			data = append(data, chunks[k]...)
+		}
+		return data, nil
+
+	default:
+		return nil, errors.Wrapf(ErrWrongValueFormat, "unknown content data prefix 0x%02x", prefix)
+	}
+}
+
+func (x *TokenData) setAttribute(key []byte, val []byte) {
+	name, ok := tokenDataAttributes[string(key)]
+	if !ok {
+		if x.Attributes == nil {
+			x.Attributes = map[string]string{}
+		}
+		x.Attributes[hex.EncodeToString(key)] = string(val)
+		return
+	}
+
+	switch name {
+	case "uri":
+		x.URI = string(val)
+	case "name":
+		x.Name = string(val)
+	case "description":
+		x.Description = string(val)
+	case "image":
+		x.Image = string(val)
+	case "image_data":
+		x.ImageData = val
+	case "symbol":
+		x.Symbol = string(val)
+	case "decimals":
+		if d, err := strconv.ParseUint(string(val), 10, 8); err == nil {
+			d8 := uint8(d)
+			x.Decimals = &d8
+		}
+	case "amount_style":
+		x.AmountStyle = string(val)
+	case "render_type":
+		x.RenderType = string(val)
+	}
+}
+
+func (x *TokenData) loadOnchain(s *cell.Slice) error {
+	// onchain#00 data:(HashMapE 256 ^ContentData) = FullContent;
+	dict, err := s.LoadDict(256)
+	if err != nil {
+		return errors.Wrap(err, "load onchain data dict")
+	}
+	kv, err := dict.LoadAll()
+	if err != nil {
+		return errors.Wrap(err, "load all onchain attributes")
+	}
+
+	x.Onchain = true
+
+	for _, a := range kv {
+		key, err := a.Key.LoadSlice(256)
+		if err != nil {
+			return errors.Wrap(err, "load attribute key")
+		}
+
+		v := a.Value
+		if v.BitsLeft() == 0 && v.RefsNum() > 0 {
+			if v, err = v.LoadRef(); err != nil {
+				return errors.Wrap(err, "load attribute ref")
+			}
+		}
+
+		val, err := loadContentData(v)
+		if err != nil {
+			// skip malformed attributes, as they do not break other ones
+			continue
+		}
+		x.setAttribute(key, val)
+	}
+
+	return nil
+}
+
+func (x *TokenData) LoadFromCell(loader *cell.Slice) error {
+	if loader.BitsLeft() < 8 {
+		if loader.RefsNum() == 0 {
+			return nil
+		}
+		ref, err := loader.LoadRef()
+		if err != nil {
+			return errors.Wrap(err, "load content ref")
+		}
+		loader = ref
+	}
+
+	prefix, err := loader.LoadUInt(8)
+	if err != nil {
+		return errors.Wrap(err, "load content prefix")
+	}
+
+	switch prefix {
+	case 0x00:
+		return x.loadOnchain(loader)
+
+	case 0x01:
+		// offchain#01 uri:Text = FullContent;
+		uri, err := loader.LoadStringSnake()
+		if err != nil {
+			return errors.Wrap(err, "load offchain uri")
+		}
+		x.URI = uri
+		return nil
+
+	default:
+		// some contracts store uri without the prefix
+		uri, err := loader.LoadStringSnake()
+		if err != nil {
+			return errors.Wrap(err, "load offchain uri")
+		}
+		x.URI = string([]byte{byte(prefix)}) + uri
+		return nil
+	}
+}
+
+// TokenDataFromCell parses TEP-64 token metadata from the given content cell.
+func TokenDataFromCell(c *cell.Cell) (*TokenData, error) {
+	var x TokenData
+	if err := x.LoadFromCell(c.BeginParse()); err != nil {
+		return nil, err
+	}
+	return &x, nil
+}
@@ -0,0 +1,104 @@
+package abi_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+)
+
+func contentSnake(t *testing.T, val string) *cell.Cell {
+	b := cell.BeginCell().MustStoreUInt(0x00, 8)
+	require.Nil(t, b.StoreStringSnake(val))
+	return b.EndCell()
+}
+
+func contentChunks(t *testing.T, chunks ...string) *cell.Cell {
+	dict := cell.NewDict(32)
+	for i, c := range chunks {
+		chunk := cell.BeginCell().MustStoreSlice([]byte(c), uint(len(c)*8)).EndCell()
+		err := dict.SetIntKey(big.NewInt(int64(i)), cell.BeginCell().MustStoreRef(chunk).EndCell())
+		require.Nil(t, err)
+	}
+	return cell.BeginCell().MustStoreUInt(0x01, 8).MustStoreDict(dict).EndCell()
+}
+
+func contentOnchain(t *testing.T, attrs map[string]*cell.Cell) *cell.Cell {
+	dict := cell.NewDict(256)
+	for k, v := range attrs {
+		h := sha256.Sum256([]byte(k))
+		err := dict.Set(cell.BeginCell().MustStoreSlice(h[:], 256).EndCell(), cell.BeginCell().MustStoreRef(v).EndCell())
+		require.Nil(t, err)
+	}
+	return cell.BeginCell().MustStoreUInt(0x00, 8).MustStoreDict(dict).EndCell()
+}
+
+func TestTokenDataFromCell(t *testing.T) {
+	t.Run("offchain", func(t *testing.T) {
+		c := cell.BeginCell().MustStoreUInt(0x01, 8).MustStoreStringSnake("https://example.com/jetton.json").EndCell()
+
+		content, err := abi.TokenDataFromCell(c)
+		require.Nil(t, err)
+		require.Equal(t, &abi.TokenData{URI: "https://example.com/jetton.json"}, content)
+	})
+
+	t.Run("onchain", func(t *testing.T) {
+		c := contentOnchain(t, map[string]*cell.Cell{
+			"name":     contentSnake(t, "Test Jetton"),
+			"symbol":   contentSnake(t, "TST"),
+			"decimals": contentSnake(t, "6"),
+			"image":    contentChunks(t, "https://example.com/", "image.png"),
+			"custom":   contentSnake(t, "value"),
+		})
+
+		content, err := abi.TokenDataFromCell(c)
+		require.Nil(t, err)
+
+		customKey := sha256.Sum256([]byte("custom"))
+
+		j, err := json.Marshal(content)
+		require.Nil(t, err)
+		require.JSONEq(t, `{
+			"onchain": true,
+			"Name": "Test Jetton",
+			"symbol": "TST",
+			"decimals": 6,
+			"Image": "https://example.com/image.png",
+			"attributes": {"`+hex.EncodeToString(customKey[:])+`": "value"}
+		}`, string(j))
+	})
+
+	t.Run("semichain", func(t *testing.T) {
+		c := contentOnchain(t, map[string]*cell.Cell{
+			"uri":  contentSnake(t, "https://example.com/nft.json"),
+			"name": contentSnake(t, "NFT"),
+		})
+
+		content, err := abi.TokenDataFromCell(c)
+		require.Nil(t, err)
+		require.Equal(t, &abi.TokenData{Onchain: true, URI: "https://example.com/nft.json", Name: "NFT"}, content)
+	})
+}
+
+func TestTLBFieldsDesc_FromCell_Content(t *testing.T) {
+	var d abi.TLBFieldsDesc
+
+	err := json.Unmarshal([]byte(`[{"name":"content","tlb_type":"^","format":"content"}]`), &d)
+	require.Nil(t, err)
+
+	content := contentOnchain(t, map[string]*cell.Cell{"symbol": contentSnake(t, "TST")})
+
+	got, err := d.FromCell(cell.BeginCell().MustStoreRef(content).EndCell())
+	require.Nil(t, err)
+
+	j, err := json.Marshal(got)
+	require.Nil(t, err)
+	require.Equal(t, `{"content":{"onchain":true,"symbol":"TST"}}`, string(j))
+}
@@ -18,13 +18,26 @@ const (
 	VmInt   StackType = "int"
 	VmCell  StackType = "cell"
 	VmSlice StackType = "slice"
+	VmTuple StackType = "tuple"
+)
+
+// Tuple formats. Tuple with an empty format is a fixed-size tuple,
+// every element of which is described in TupleElements.
+const (
+	// TupleArray is a tuple of variable length
+	// with all elements described by the single element descriptor.
+	TupleArray TLBType = "array"
+	// TupleList is a lisp-style list: nested pairs [head, tail] terminated with null.
+	TupleList TLBType = "list"
 )
 
 type VmValueDesc struct {
 	Name      string        `json:"name"`
 	StackType StackType     `json:"stack_type"`
 	Format    TLBType       `json:"format,omitempty"`
-	Fields    TLBFieldsDesc `json:"struct_fields,omitempty"` // Format = "struct"
+	Fields    TLBFieldsDesc `json:"struct_fields,omitempty"`  // Format = "struct"
+	Elements  []VmValueDesc `json:"tuple_elements,omitempty"` // StackType = "tuple"
+	Column    AccountColumn `json:"column,omitempty"`         // account state column filled with the return value
 }
 
 type GetMethodDesc struct {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"strconv"
 
 	"github.com/tonkeeper/tongo/ton"
 	"github.com/tonkeeper/tongo/txemulator"
@@ -19,7 +20,6 @@ import (
 
 	"github.com/xssnick/tonutils-go/address"
 	tutlb "github.com/xssnick/tonutils-go/tlb"
-	"github.com/xssnick/tonutils-go/ton/nft"
 	"github.com/xssnick/tonutils-go/tvm/cell"
 
 	"github.com/stepandra/anton/addr"
@@ -48,9 +48,16 @@ type GetMethodExecution struct {
 
 var ErrWrongValueFormat = errors.New("wrong value for this format")
 
+// ErrTupleArgument is returned for tuple arguments, which are not implemented:
+// tongo v1.3.0 does not marshal VmStkTuple, so a stack with a tuple cannot be passed to the emulator.
+// Tuples are supported only in return values.
+var ErrTupleArgument = errors.New("tuple arguments are not implemented")
+
 type Emulator struct {
 	Emulator  *tvm.Emulator
 	AccountID tongo.AccountID
+
+	registry *Registry // definitions used to parse return values, the global ones if nil
 }
 
 func newEmulator(a *address.Address, e *tvm.Emulator) (*Emulator, error) {
@@ -62,6 +69,12 @@ func newEmulator(a *address.Address, e *tvm.Emulator) (*Emulator, error) {
 	return &Emulator{Emulator: e, AccountID: accId}, nil
 }
 
+// WithRegistry makes the emulator parse return values with the definitions of the given registry.
+func (e *Emulator) WithRegistry(r *Registry) *Emulator {
+	e.registry = r
+	return e
+}
+
 func NewEmulator(a *address.Address, code, data, cfg *cell.Cell) (*Emulator, error) {
 	return NewEmulatorBase64(a,
 		base64.StdEncoding.EncodeToString(code.ToBOC()),
@@ -264,6 +277,10 @@ func vmMakeValue(v *VmValue) (ret tlb.VmStackValue, _ error) {
 	case VmSlice:
 		return vmMakeValueSlice(v)
 
+	case VmTuple:
+		// TODO: build tuple arguments when tongo implements VmStkTuple marshaling
+		return ret, errors.Wrapf(ErrTupleArgument, "'%s' argument", v.Name)
+
 	default:
 		return ret, fmt.Errorf("unsupported '%s' type", v.StackType)
 	}
@@ -309,7 +326,7 @@ func vmParseValueInt(v *tlb.VmStackValue, d *VmValueDesc) (any, error) {
 	}
 }
 
-func vmParseCell(c *cell.Cell, desc *VmValueDesc) (any, error) {
+func vmParseCell(r *Registry, c *cell.Cell, desc *VmValueDesc) (any, error) {
 	switch desc.Format {
 	case TLBCell:
 		return c, nil
@@ -332,21 +349,21 @@ func vmParseCell(c *cell.Cell, desc *VmValueDesc) (any, error) {
 		return a, nil
 
 	case TLBContentCell:
-		content, err := nft.ContentFromCell(c)
+		content, err := TokenDataFromCell(c)
 		if err != nil {
 			return nil, errors.Wrap(err, "load content from cell")
 		}
 		return content, nil
 
 	case TLBStructCell:
-		parsed, err := desc.Fields.FromCell(c)
+		parsed, err := desc.Fields.FromCellIn(r, c)
 		if err != nil {
 			return nil, errors.Wrapf(err, "load struct from cell on %s value description schema", desc.Name)
 		}
 		return parsed, nil
 
 	default:
-		d, ok := registeredDefinitions[desc.Format]
+		d, ok := r.orGlobal().get(desc.Format)
 		if !ok {
 			t, ok := typeNameMap[desc.Format]
 			if !ok {
@@ -361,7 +378,7 @@ func vmParseCell(c *cell.Cell, desc *VmValueDesc) (any, error) {
 			}
 			return tv, nil
 		}
-		parsed, err := d.FromCell(c)
+		parsed, err := d.FromCellIn(r, c)
 		if err != nil {
 			return nil, errors.Wrapf(err, "'%s' definition from cell", desc.Format)
 		}
@@ -369,7 +386,7 @@ func vmParseCell(c *cell.Cell, desc *VmValueDesc) (any, error) {
 	}
 }
 
-func vmParseValueCell(v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
+func vmParseValueCell(r *Registry, v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
 	switch v.SumType {
 	case "VmStkNull":
 		switch desc.Format {
@@ -378,7 +395,7 @@ func vmParseValueCell(v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
 		case TLBString:
 			return "", nil
 		case TLBContentCell:
-			return nft.ContentAny(nil), nil
+			return (*TokenData)(nil), nil
 		default:
 			return nil, fmt.Errorf("unsupported '%s' format for '%s' type", desc.Format, desc.StackType)
 		}
@@ -405,10 +422,10 @@ func vmParseValueCell(v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
 		desc.Format = TLBCell
 	}
 
-	return vmParseCell(c, desc)
+	return vmParseCell(r, c, desc)
 }
 
-func vmParseValueSlice(v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
+func vmParseValueSlice(r *Registry, v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
 	switch v.SumType {
 	case "VmStkNull":
 		switch desc.Format {
@@ -444,26 +461,133 @@ func vmParseValueSlice(v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
 		desc.Format = TLBSlice
 	}
 
-	return vmParseCell(c, desc)
+	return vmParseCell(r, c, desc)
 }
 
-func vmParseValue(v *tlb.VmStackValue, d *VmValueDesc) (any, error) {
+func vmTupleItems(t *tlb.VmStkTuple) ([]tlb.VmStackValue, error) {
+	switch {
+	case t.Len == 0:
+		return nil, nil
+	case t.Data == nil:
+		return nil, fmt.Errorf("no data in tuple of length %d", t.Len)
+	case t.Len == 1:
+		return []tlb.VmStackValue{t.Data.Tail}, nil
+	default:
+		return t.Data.RecursiveToSlice(int(t.Len))
+	}
+}
+
+func vmParseValueTuple(r *Registry, v *tlb.VmStackValue, desc *VmValueDesc) (any, error) {
+	switch v.SumType {
+	case "VmStkNull":
+		if desc.Format == TupleList {
+			return []any{}, nil
+		}
+		return nil, nil
+
+	case "VmStkTuple":
+		// go further
+
+	default:
+		return nil, fmt.Errorf("wrong descriptor '%s' type as method returned '%s'", desc.StackType, v.SumType)
+	}
+
+	switch desc.Format {
+	case "":
+		items, err := vmTupleItems(&v.VmStkTuple)
+		if err != nil {
+			return nil, errors.Wrapf(err, "'%s' tuple items", desc.Name)
+		}
+		if len(items) != len(desc.Elements) {
+			return nil, fmt.Errorf("'%s' tuple has %d elements, but %d are described", desc.Name, len(items), len(desc.Elements))
+		}
+
+		ret := make(map[string]any, len(items))
+		for it := range items {
+			d := &desc.Elements[it]
+			el, err := vmParseValue(r, &items[it], d)
+			if err != nil {
+				return nil, errors.Wrapf(err, "'%s' tuple element %d", desc.Name, it)
+			}
+			name := d.Name
+			if name == "" {
+				name = strconv.Itoa(it)
+			}
+			ret[name] = el
+		}
+		return ret, nil
+
+	case TupleArray:
+		if len(desc.Elements) != 1 {
+			return nil, fmt.Errorf("'%s' %s must have exactly one element descriptor", desc.Name, desc.Format)
+		}
+
+		items, err := vmTupleItems(&v.VmStkTuple)
+		if err != nil {
+			return nil, errors.Wrapf(err, "'%s' tuple items", desc.Name)
+		}
+
+		ret := make([]any, 0, len(items))
+		for it := range items {
+			el, err := vmParseValue(r, &items[it], &desc.Elements[0])
+			if err != nil {
+				return nil, errors.Wrapf(err, "'%s' array element %d", desc.Name, it)
+			}
+			ret = append(ret, el)
+		}
+		return ret, nil
+
+	case TupleList:
+		if len(desc.Elements) != 1 {
+			return nil, fmt.Errorf("'%s' %s must have exactly one element descriptor", desc.Name, desc.Format)
+		}
+
+		ret := []any{}
+		for cur := v; cur.SumType != "VmStkNull"; {
+			if cur.SumType != "VmStkTuple" {
+				return nil, fmt.Errorf("'%s' list element %d is '%s', but tuple or null is expected", desc.Name, len(ret), cur.SumType)
+			}
+			pair, err := vmTupleItems(&cur.VmStkTuple)
+			if err != nil {
+				return nil, errors.Wrapf(err, "'%s' list element %d", desc.Name, len(ret))
+			}
+			if len(pair) != 2 {
+				return nil, fmt.Errorf("'%s' list element %d has length %d, but pair is expected", desc.Name, len(ret), len(pair))
+			}
+			el, err := vmParseValue(r, &pair[0], &desc.Elements[0])
+			if err != nil {
+				return nil, errors.Wrapf(err, "'%s' list element %d", desc.Name, len(ret))
+			}
+			ret = append(ret, el)
+			cur = &pair[1]
+		}
+		return ret, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported '%s' format for '%s' type", desc.Format, desc.StackType)
+	}
+}
+
+func vmParseValue(r *Registry, v *tlb.VmStackValue, d *VmValueDesc) (any, error) {
 	switch d.StackType {
 	case "int":
 		return vmParseValueInt(v, d)
 
 	case "cell":
-		return vmParseValueCell(v, d)
+		return vmParseValueCell(r, v, d)
 
 	case "slice":
-		return vmParseValueSlice(v, d)
+		return vmParseValueSlice(r, v, d)
+
+	case "tuple":
+		return vmParseValueTuple(r, v, d)
 
 	default:
 		return nil, fmt.Errorf("unsupported '%s' type", d.StackType)
 	}
 }
 
-func (e *Emulator) RunGetMethod(ctx context.Context, method string, args VmStack, retDesc []VmValueDesc) (ret VmStack, err error) {
+func (e *Emulator) runSmcMethod(ctx context.Context, method string, args VmStack) (tlb.VmStack, error) {
 	var params tlb.VmStack
 
 	for it := range args {
@@ -481,12 +605,21 @@ func (e *Emulator) RunGetMethod(ctx context.Context, method string, args VmStack
 	if exit != 0 && exit != 1 { // 1 - alternative success code
 		return nil, fmt.Errorf("tvm execution failed with code %d", exit)
 	}
+
+	return stk, nil
+}
+
+func (e *Emulator) RunGetMethod(ctx context.Context, method string, args VmStack, retDesc []VmValueDesc) (ret VmStack, err error) {
+	stk, err := e.runSmcMethod(ctx, method, args)
+	if err != nil {
+		return nil, err
+	}
 	if len(stk) < len(retDesc) {
 		return nil, fmt.Errorf("tvm execution returned stack with length %d, but expected length %d", len(stk), len(retDesc))
 	}
 
 	for i := range retDesc {
-		r, err := vmParseValue(&stk[i], &retDesc[i])
+		r, err := vmParseValue(e.registry, &stk[i], &retDesc[i])
 		if err != nil {
 			return nil, err
 		}
@@ -495,3 +628,67 @@ func (e *Emulator) RunGetMethod(ctx context.Context, method string, args VmStack
 
 	return ret, nil
 }
+
+func vmParseRawValue(v *tlb.VmStackValue) (VmValue, error) {
+	switch v.SumType {
+	case "VmStkNull":
+		return VmValue{}, nil
+
+	case "VmStkInt", "VmStkTinyInt":
+		d := VmValueDesc{StackType: VmInt}
+		p, err := vmParseValueInt(v, &d)
+		return VmValue{VmValueDesc: d, Payload: p}, err
+
+	case "VmStkCell":
+		d := VmValueDesc{StackType: VmCell}
+		p, err := vmParseValueCell(nil, v, &d)
+		return VmValue{VmValueDesc: d, Payload: p}, err
+
+	case "VmStkSlice":
+		// slice is returned as a cell, as slice cannot be marshaled to JSON
+		d := VmValueDesc{StackType: VmSlice, Format: TLBCell}
+		p, err := vmParseValueSlice(nil, v, &d)
+		return VmValue{VmValueDesc: d, Payload: p}, err
+
+	case "VmStkTuple":
+		items, err := vmTupleItems(&v.VmStkTuple)
+		if err != nil {
+			return VmValue{}, errors.Wrap(err, "tuple items")
+		}
+		d := VmValueDesc{StackType: VmTuple}
+		p := make([]any, 0, len(items))
+		for it := range items {
+			r, err := vmParseRawValue(&items[it])
+			if err != nil {
+				return VmValue{}, errors.Wrapf(err, "tuple element %d", it)
+			}
+			d.Elements = append(d.Elements, r.VmValueDesc)
+			p = append(p, r.Payload)
+		}
+		return VmValue{VmValueDesc: d, Payload: p}, nil
+
+	default:
+		return VmValue{}, fmt.Errorf("unsupported '%s' stack value", v.SumType)
+	}
+}
+
+// RunGetMethodRaw executes get-method without the description of return values.
+// Every value on the resulting stack is returned in the default format of its stack type:
+// integers as big numbers, cells and slices as cells, tuples as arrays of raw values.
+// Stack type of null values is left empty.
+func (e *Emulator) RunGetMethodRaw(ctx context.Context, method string, args VmStack) (ret VmStack, err error) {
+	stk, err := e.runSmcMethod(ctx, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stk {
+		r, err := vmParseRawValue(&stk[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "stack value %d", i)
+		}
+		ret = append(ret, r)
+	}
+
+	return ret, nil
+}
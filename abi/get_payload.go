@@ -0,0 +1,186 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/addr"
+)
+
+func jsonNumberString(raw json.RawMessage) (string, error) {
+	if len(raw) > 0 && raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+func jsonBytes(raw json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	if b, err := hex.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.StdEncoding.DecodeString(s)
+}
+
+func jsonCell(raw json.RawMessage) (*cell.Cell, error) {
+	b, err := jsonBytes(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode boc")
+	}
+	return cell.FromBOC(b)
+}
+
+func payloadFromJSONInt(d *VmValueDesc, raw json.RawMessage) (any, error) {
+	if d.Format == TLBBool {
+		var b bool
+		err := json.Unmarshal(raw, &b)
+		return b, err
+	}
+	if d.Format == TLBBytes {
+		return jsonBytes(raw)
+	}
+
+	s, err := jsonNumberString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	switch d.Format {
+	case "", TLBBigInt:
+		bi, ok := new(big.Int).SetString(s, 0)
+		if !ok {
+			return nil, fmt.Errorf("cannot parse integer %s", s)
+		}
+		return bi, nil
+	case "uint8", "uint16", "uint32", "uint64":
+		bits, _ := strconv.Atoi(string(d.Format[4:]))
+		u, err := strconv.ParseUint(s, 0, bits)
+		if err != nil {
+			return nil, err
+		}
+		switch bits {
+		case 8:
+			return uint8(u), nil
+		case 16:
+			return uint16(u), nil
+		case 32:
+			return uint32(u), nil
+		default:
+			return u, nil
+		}
+	case "int8", "int16", "int32", "int64":
+		bits, _ := strconv.Atoi(string(d.Format[3:]))
+		i, err := strconv.ParseInt(s, 0, bits)
+		if err != nil {
+			return nil, err
+		}
+		switch bits {
+		case 8:
+			return int8(i), nil
+		case 16:
+			return int16(i), nil
+		case 32:
+			return int32(i), nil
+		default:
+			return i, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported '%s' format for '%s' type", d.Format, d.StackType)
+	}
+}
+
+func payloadFromJSONCell(d *VmValueDesc, raw json.RawMessage) (any, error) {
+	format := d.Format
+	if format == "" && len(d.Fields) > 0 {
+		format = TLBStructCell
+	}
+
+	switch format {
+	case "", TLBCell, TLBType(VmSlice):
+		if bytes.Equal(raw, []byte("null")) {
+			if d.StackType == VmSlice {
+				return nil, fmt.Errorf("null is not supported for '%s' type", d.StackType)
+			}
+			return (*cell.Cell)(nil), nil
+		}
+		c, err := jsonCell(raw)
+		if err != nil {
+			return nil, err
+		}
+		if d.StackType == VmSlice {
+			return c.BeginParse(), nil
+		}
+		return c, nil
+
+	case TLBAddr:
+		var a addr.Address
+		if err := json.Unmarshal(raw, &a); err != nil {
+			return nil, err
+		}
+		return a.ToTonutils()
+
+	case TLBString:
+		var s string
+		err := json.Unmarshal(raw, &s)
+		return s, err
+
+	case TLBStructCell:
+		s, err := d.Fields.New()
+		if err != nil {
+			return nil, errors.Wrapf(err, "'%s' struct", d.Name)
+		}
+		if err := json.Unmarshal(raw, s); err != nil {
+			return nil, err
+		}
+		return s, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported '%s' format for '%s' type", d.Format, d.StackType)
+	}
+}
+
+// PayloadFromJSON converts JSON value to the payload of the described stack value,
+// so it can be passed to Emulator.RunGetMethod as an argument.
+// Integers are accepted as JSON numbers or decimal and 0x-prefixed strings,
+// cells and slices as hex or base64 BoC, addresses in any user-friendly or raw form.
+func PayloadFromJSON(d *VmValueDesc, raw json.RawMessage) (any, error) {
+	var (
+		ret any
+		err error
+	)
+
+	switch d.StackType {
+	case VmInt:
+		ret, err = payloadFromJSONInt(d, raw)
+	case VmCell, VmSlice:
+		ret, err = payloadFromJSONCell(d, raw)
+	case VmTuple:
+		return nil, errors.Wrapf(ErrTupleArgument, "'%s' value", d.Name)
+	default:
+		return nil, fmt.Errorf("unsupported '%s' type", d.StackType)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(ErrWrongValueFormat, "'%s' value of '%s' type with '%s' format: %s", d.Name, d.StackType, d.Format, err.Error())
+	}
+
+	return ret, nil
+}
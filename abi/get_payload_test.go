@@ -0,0 +1,109 @@
+package abi
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+func TestPayloadFromJSON(t *testing.T) {
+	c := cell.BeginCell().MustStoreUInt(42, 32).EndCell()
+	boc := `"` + hex.EncodeToString(c.ToBOC()) + `"`
+
+	var testCases = []struct {
+		desc VmValueDesc
+		json string
+		want any
+	}{
+		{
+			desc: VmValueDesc{StackType: VmInt},
+			json: `123456789012345678901234567890`,
+			want: func() *big.Int { bi, _ := new(big.Int).SetString("123456789012345678901234567890", 10); return bi }(),
+		}, {
+			desc: VmValueDesc{StackType: VmInt, Format: TLBBigInt},
+			json: `"0xff"`,
+			want: big.NewInt(255),
+		}, {
+			desc: VmValueDesc{StackType: VmInt, Format: "uint32"},
+			json: `42`,
+			want: uint32(42),
+		}, {
+			desc: VmValueDesc{StackType: VmInt, Format: "int8"},
+			json: `"-5"`,
+			want: int8(-5),
+		}, {
+			desc: VmValueDesc{StackType: VmInt, Format: TLBBool},
+			json: `true`,
+			want: true,
+		}, {
+			desc: VmValueDesc{StackType: VmInt, Format: TLBBytes},
+			json: `"0102"`,
+			want: []byte{1, 2},
+		}, {
+			desc: VmValueDesc{StackType: VmCell},
+			json: boc,
+			want: c,
+		}, {
+			desc: VmValueDesc{StackType: VmCell},
+			json: `null`,
+			want: (*cell.Cell)(nil),
+		}, {
+			desc: VmValueDesc{StackType: VmSlice, Format: TLBAddr},
+			json: `"EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg"`,
+			want: address.MustParseAddr("EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg"),
+		}, {
+			desc: VmValueDesc{StackType: VmCell, Format: TLBString},
+			json: `"hello"`,
+			want: "hello",
+		},
+	}
+
+	for _, tc := range testCases {
+		got, err := PayloadFromJSON(&tc.desc, json.RawMessage(tc.json))
+		require.Nil(t, err, tc.json)
+		if gc, ok := got.(*cell.Cell); ok && gc != nil {
+			require.Equal(t, c.Hash(), gc.Hash())
+			continue
+		}
+		if ga, ok := got.(*address.Address); ok {
+			require.Equal(t, tc.want.(*address.Address).String(), ga.String())
+			continue
+		}
+		require.Equal(t, tc.want, got, tc.json)
+
+		// every decoded payload must be accepted by the emulator
+		_, err = vmMakeValue(&VmValue{VmValueDesc: tc.desc, Payload: got})
+		require.Nil(t, err, tc.json)
+	}
+}
+
+func TestPayloadFromJSON_Errors(t *testing.T) {
+	var testCases = []struct {
+		desc VmValueDesc
+		json string
+	}{
+		{desc: VmValueDesc{StackType: VmInt}, json: `"abc"`},
+		{desc: VmValueDesc{StackType: VmInt, Format: "uint8"}, json: `256`},
+		{desc: VmValueDesc{StackType: VmInt, Format: "uint8"}, json: `-1`},
+		{desc: VmValueDesc{StackType: VmSlice}, json: `null`},
+		{desc: VmValueDesc{StackType: VmCell}, json: `"not a boc"`},
+		{desc: VmValueDesc{StackType: VmSlice, Format: TLBAddr}, json: `"not an address"`},
+		{desc: VmValueDesc{StackType: "unknown"}, json: `1`},
+	}
+
+	for _, tc := range testCases {
+		_, err := PayloadFromJSON(&tc.desc, json.RawMessage(tc.json))
+		require.NotNil(t, err, tc.json)
+		if tc.desc.StackType != "unknown" {
+			require.True(t, errors.Is(err, ErrWrongValueFormat), tc.json)
+		}
+	}
+	_, err := PayloadFromJSON(&VmValueDesc{StackType: VmTuple, Elements: []VmValueDesc{{StackType: VmInt}}}, json.RawMessage(`[1]`))
+	require.True(t, errors.Is(err, ErrTupleArgument))
+}
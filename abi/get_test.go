@@ -10,7 +10,6 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/xssnick/tonutils-go/address"
-	"github.com/xssnick/tonutils-go/ton/nft"
 	"github.com/xssnick/tonutils-go/tvm/cell"
 
 	"github.com/stepandra/anton/abi"
@@ -95,6 +94,22 @@ func TestEmulator_RunGetMethod(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "EQAQKmY9GTsEb6lREv-vxjT5sVHJyli40xGEYP3tKZSDuTBj", item.String())
 
+	// the same get-method with JSON argument and without return values description
+	indexDesc := abi.VmValueDesc{Name: "index", StackType: "int", Format: "uint64"}
+	index, err := abi.PayloadFromJSON(&indexDesc, json.RawMessage(`"100"`))
+	require.Nil(t, err)
+
+	ret, err = eCollection.RunGetMethodRaw(context.Background(), "get_nft_address_by_index",
+		[]abi.VmValue{{VmValueDesc: indexDesc, Payload: index}})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(ret))
+	require.Equal(t, abi.VmSlice, ret[0].StackType)
+	itemCell, ok := ret[0].Payload.(*cell.Cell)
+	require.True(t, ok)
+	itemRaw, err := itemCell.BeginParse().LoadAddr()
+	require.Nil(t, err)
+	require.Equal(t, item.String(), itemRaw.String())
+
 	// query nft item get_nft_data
 	itemCode, err := base64.StdEncoding.DecodeString("te6cckECDQEAAdAAART/APSkE/S88sgLAQIBYgIDAgLOBAUACaEfn+AFAgEgBgcCASALDALXDIhxwCSXwPg0NMDAXGwkl8D4PpA+kAx+gAxcdch+gAx+gAw8AIEs44UMGwiNFIyxwXy4ZUB+kDUMBAj8APgBtMf0z+CEF/MPRRSMLqOhzIQN14yQBPgMDQ0NTWCEC/LJqISuuMCXwSED/LwgCAkAET6RDBwuvLhTYAH2UTXHBfLhkfpAIfAB+kDSADH6AIIK+vCAG6EhlFMVoKHeItcLAcMAIJIGoZE24iDC//LhkiGOPoIQBRONkchQCc8WUAvPFnEkSRRURqBwgBDIywVQB88WUAX6AhXLahLLH8s/Im6zlFjPFwGRMuIByQH7ABBHlBAqN1viCgBycIIQi3cXNQXIy/9QBM8WECSAQHCAEMjLBVAHzxZQBfoCFctqEssfyz8ibrOUWM8XAZEy4gHJAfsAAIICjjUm8AGCENUydtsQN0QAbXFwgBDIywVQB88WUAX6AhXLahLLH8s/Im6zlFjPFwGRMuIByQH7AJMwMjTiVQLwAwA7O1E0NM/+kAg10nCAJp/AfpA1DAQJBAj4DBwWW1tgAB0A8jLP1jPFgHPFszJ7VSC/dQQb")
 	require.Nil(t, err)
@@ -169,8 +184,9 @@ func TestEmulator_RunGetMethod(t *testing.T) {
 	)
 	require.Nil(t, err)
 	require.Equal(t, 1, len(ret))
-	contentOffChain, ok := ret[0].Payload.(*nft.ContentOffchain)
+	contentOffChain, ok := ret[0].Payload.(*abi.TokenData)
 	require.True(t, ok)
+	require.False(t, contentOffChain.Onchain)
 	require.Equal(t, "https://loton.fun/nft/100.json", contentOffChain.URI)
 }
 
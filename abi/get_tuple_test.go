@@ -0,0 +1,256 @@
+package abi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	"github.com/tonkeeper/tongo/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+func tupleTestTinyInt(i int64) *cell.Cell {
+	return cell.BeginCell().MustStoreUInt(0x01, 8).MustStoreInt(i, 64).EndCell()
+}
+
+func tupleTestUnmarshal(t *testing.T, c *cell.Cell) *tlb.VmStackValue {
+	tgc, err := boc.DeserializeSinglRootBase64(base64.StdEncoding.EncodeToString(c.ToBOC()))
+	require.Nil(t, err)
+
+	var v tlb.VmStackValue
+	require.Nil(t, tlb.Unmarshal(tgc, &v))
+
+	return &v
+}
+
+func tupleTestTuple(values []tlb.VmStackValue) tlb.VmStackValue {
+	return tlb.VmStackValue{
+		SumType:    "VmStkTuple",
+		VmStkTuple: tlb.VmStkTuple{Len: uint16(len(values)), Data: tupleTestData(values)},
+	}
+}
+
+// tupleTestData builds tuple in the same way as it is unmarshalled by tongo:
+// vm_tuple_tcons$_ {n:#} head:(VmTupleRef n) tail:^VmStackValue = VmTuple (n + 1);
+func tupleTestData(values []tlb.VmStackValue) *tlb.VmTuple {
+	if len(values) == 0 {
+		return nil
+	}
+
+	t := &tlb.VmTuple{Tail: values[len(values)-1]}
+	switch head := values[:len(values)-1]; len(head) {
+	case 0:
+	case 1:
+		t.Head.Entry = &head[0]
+	default:
+		t.Head.Ref = tupleTestData(head)
+	}
+
+	return t
+}
+
+// tupleTestMake builds stack value of the described tuple,
+// as tuples cannot be passed to vmMakeValue.
+func tupleTestMake(t *testing.T, d *VmValueDesc, payload any) tlb.VmStackValue {
+	if d.StackType != VmTuple {
+		v, err := vmMakeValue(&VmValue{VmValueDesc: *d, Payload: payload})
+		require.Nil(t, err)
+		return v
+	}
+	if payload == nil {
+		return tlb.VmStackValue{SumType: "VmStkNull"}
+	}
+
+	var values []tlb.VmStackValue
+
+	switch d.Format {
+	case "":
+		var items []any
+		switch p := payload.(type) {
+		case []any:
+			items = p
+		case map[string]any:
+			for it := range d.Elements {
+				items = append(items, p[d.Elements[it].Name])
+			}
+		}
+		require.Equal(t, len(d.Elements), len(items))
+		for it := range items {
+			values = append(values, tupleTestMake(t, &d.Elements[it], items[it]))
+		}
+		return tupleTestTuple(values)
+
+	case TupleArray:
+		for _, item := range payload.([]any) {
+			values = append(values, tupleTestMake(t, &d.Elements[0], item))
+		}
+		return tupleTestTuple(values)
+
+	case TupleList:
+		// lisp-style list is built from the end: [a, [b, [c, null]]]
+		items, ret := payload.([]any), tlb.VmStackValue{SumType: "VmStkNull"}
+		for it := len(items) - 1; it >= 0; it-- {
+			ret = tupleTestTuple([]tlb.VmStackValue{tupleTestMake(t, &d.Elements[0], items[it]), ret})
+		}
+		return ret
+
+	default:
+		t.Fatalf("unknown '%s' tuple format", d.Format)
+		return tlb.VmStackValue{}
+	}
+}
+
+func TestVmParseValueTuple_FromBoc(t *testing.T) {
+	// vm_stk_tuple#07 len:(## 16) data:(VmTuple len) = VmStackValue;
+	pair := cell.BeginCell().MustStoreRef(tupleTestTinyInt(1)).MustStoreRef(tupleTestTinyInt(2)).EndCell()
+	triple := cell.BeginCell().MustStoreUInt(0x07, 8).MustStoreUInt(3, 16).
+		MustStoreRef(pair).MustStoreRef(tupleTestTinyInt(3)).EndCell()
+
+	v := tupleTestUnmarshal(t, triple)
+
+	ret, err := vmParseValue(nil, v, &VmValueDesc{
+		Name:      "triple",
+		StackType: VmTuple,
+		Elements: []VmValueDesc{
+			{Name: "a", StackType: VmInt, Format: "uint8"},
+			{Name: "b", StackType: VmInt, Format: "uint16"},
+			{Name: "c", StackType: VmInt},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, map[string]any{"a": uint8(1), "b": uint16(2), "c": big.NewInt(3)}, ret)
+
+	ret, err = vmParseValue(nil, v, &VmValueDesc{
+		Name:      "array",
+		StackType: VmTuple,
+		Format:    TupleArray,
+		Elements:  []VmValueDesc{{StackType: VmInt, Format: "int64"}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []any{int64(1), int64(2), int64(3)}, ret)
+
+	single := cell.BeginCell().MustStoreUInt(0x07, 8).MustStoreUInt(1, 16).
+		MustStoreRef(tupleTestTinyInt(42)).EndCell()
+
+	ret, err = vmParseValue(nil, tupleTestUnmarshal(t, single), &VmValueDesc{
+		Name:      "single",
+		StackType: VmTuple,
+		Elements:  []VmValueDesc{{StackType: VmInt, Format: "int64"}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, map[string]any{"0": int64(42)}, ret)
+}
+
+func TestVmParseValueTuple(t *testing.T) {
+	var testCases = []struct {
+		desc    VmValueDesc
+		payload any
+		parsed  any
+		json    string
+	}{
+		{
+			desc: VmValueDesc{
+				Name:      "reserves",
+				StackType: VmTuple,
+				Elements: []VmValueDesc{
+					{Name: "reserve0", StackType: VmInt},
+					{Name: "is_stable", StackType: VmInt, Format: TLBBool},
+					{Name: "fees", StackType: VmTuple, Elements: []VmValueDesc{
+						{Name: "lp", StackType: VmInt, Format: "uint16"},
+						{Name: "protocol", StackType: VmInt, Format: "uint16"},
+					}},
+				},
+			},
+			payload: []any{big.NewInt(1000), true, map[string]any{"lp": uint16(20), "protocol": uint16(10)}},
+			parsed: map[string]any{
+				"reserve0":  big.NewInt(1000),
+				"is_stable": true,
+				"fees":      map[string]any{"lp": uint16(20), "protocol": uint16(10)},
+			},
+			json: `{"fees":{"lp":20,"protocol":10},"is_stable":true,"reserve0":1000}`,
+		},
+		{
+			desc: VmValueDesc{
+				Name:      "signers",
+				StackType: VmTuple,
+				Format:    TupleList,
+				Elements:  []VmValueDesc{{StackType: VmInt, Format: "uint32"}},
+			},
+			payload: []any{uint32(1), uint32(2), uint32(3)},
+			parsed:  []any{uint32(1), uint32(2), uint32(3)},
+			json:    `[1,2,3]`,
+		},
+		{
+			desc: VmValueDesc{
+				Name:      "empty",
+				StackType: VmTuple,
+				Format:    TupleList,
+				Elements:  []VmValueDesc{{StackType: VmInt}},
+			},
+			payload: []any{},
+			parsed:  []any{},
+			json:    `[]`,
+		},
+		{
+			desc: VmValueDesc{
+				Name:      "amounts",
+				StackType: VmTuple,
+				Format:    TupleArray,
+				Elements: []VmValueDesc{{StackType: VmTuple, Elements: []VmValueDesc{
+					{Name: "id", StackType: VmInt, Format: "uint8"},
+					{Name: "amount", StackType: VmInt, Format: "uint64"},
+				}}},
+			},
+			payload: []any{
+				[]any{uint8(1), uint64(100)},
+				[]any{uint8(2), uint64(200)},
+				[]any{uint8(3), uint64(300)},
+			},
+			parsed: []any{
+				map[string]any{"id": uint8(1), "amount": uint64(100)},
+				map[string]any{"id": uint8(2), "amount": uint64(200)},
+				map[string]any{"id": uint8(3), "amount": uint64(300)},
+			},
+			json: `[{"amount":100,"id":1},{"amount":200,"id":2},{"amount":300,"id":3}]`,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.desc.Name, func(t *testing.T) {
+			v := tupleTestMake(t, &c.desc, c.payload)
+
+			ret, err := vmParseValue(nil, &v, &c.desc)
+			require.Nil(t, err)
+			require.Equal(t, c.parsed, ret)
+
+			j, err := json.Marshal(ret)
+			require.Nil(t, err)
+			require.Equal(t, c.json, string(j))
+		})
+	}
+}
+
+func TestVmMakeValueTuple(t *testing.T) {
+	desc := VmValueDesc{
+		Name:      "pair",
+		StackType: VmTuple,
+		Elements: []VmValueDesc{
+			{Name: "a", StackType: VmInt, Format: "uint8"},
+			{Name: "b", StackType: VmInt, Format: "uint8"},
+		},
+	}
+
+	_, err := vmMakeValue(&VmValue{VmValueDesc: desc, Payload: []any{uint8(1), uint8(2)}})
+	require.ErrorIs(t, err, ErrTupleArgument)
+
+	v := tupleTestMake(t, &desc, []any{uint8(1), uint8(2)})
+
+	list := desc
+	list.Format, list.Elements = TupleList, desc.Elements[:1]
+
+	_, err = vmParseValue(nil, &v, &list)
+	require.NotNil(t, err) // second element is not a tuple or null
+}
@@ -3,6 +3,10 @@ package known
 import "github.com/stepandra/anton/abi"
 
 var (
+	NFTCollection abi.ContractName = "nft_collection"
+	NFTItem       abi.ContractName = "nft_item"
+	NFTSale       abi.ContractName = "nft_sale"
+
 	JettonMinter abi.ContractName = "jetton_minter"
 	JettonWallet abi.ContractName = "jetton_wallet"
 
@@ -0,0 +1,86 @@
+package known_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+)
+
+func TestOperationDesc_NFTItem(t *testing.T) {
+	var (
+		interfaces []*abi.InterfaceDesc
+		i          *abi.InterfaceDesc
+	)
+
+	j, err := os.ReadFile("tep62_nft.json")
+	require.Nil(t, err)
+
+	err = json.Unmarshal(j, &interfaces)
+	require.Nil(t, err)
+
+	for _, i = range interfaces {
+		if i.Name == "nft_item" {
+			err := abi.RegisterDefinitions(i.Definitions)
+			require.Nil(t, err)
+			break
+		}
+	}
+
+	var testCases = []*struct {
+		name     string
+		boc      string
+		expected string
+	}{
+		{
+			name:     "nft_item_transfer",
+			boc:      `te6cckEBAQEAVQAApV/MPRQAAAAAAAAwOYARFC0nzM9tLCBCLbjRgmlcW3uSx4lrZfzWXfABOXZQsrABMy6CNgBk8PrT5LNjPELxCX/LXBaVSqtbzRToUHlG3txzEtAIIg01ZQ==`,
+			expected: `{"query_id":12345,"new_owner":"EQCIoWk-ZntpYQIRbcaME0ri29yWPEtbL-ay74AJy7KFlcfj","response_destination":"EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg","custom_payload":null,"forward_amount":"10000000","forward_payload":"te6cckEBAQEAAgAAAEysuc0="}`,
+		}, {
+			name:     "nft_item_ownership_assigned",
+			boc:      `te6cckEBAQEAMAAAWwUTjZEAAAAAAAAwOYAJmXQRsAMnh9afJZsZ4heIS/5a4LSqVVreaKdCg8o29ujchJJx`,
+			expected: `{"query_id":12345,"prev_owner":"EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg","forward_payload":"te6cckEBAQEAAgAAAEysuc0="}`,
+		},
+	}
+
+	for _, test := range testCases {
+		j := loadOperation(t, i, test.name, test.boc)
+		require.Equal(t, test.expected, j)
+	}
+}
+
+func TestGetMethodDesc_NFTItem(t *testing.T) {
+	var (
+		interfaces []*abi.InterfaceDesc
+		i          *abi.InterfaceDesc
+	)
+
+	j, err := os.ReadFile("tep62_nft.json")
+	require.Nil(t, err)
+
+	err = json.Unmarshal(j, &interfaces)
+	require.Nil(t, err)
+
+	for _, i = range interfaces {
+		if i.Name == "nft_item" {
+			break
+		}
+	}
+
+	var (
+		a    = addr.MustFromBase64("EQAQKmY9GTsEb6lREv-vxjT5sVHJyli40xGEYP3tKZSDuTBj").MustToTonutils()
+		code = `te6cckECDQEAAdAAART/APSkE/S88sgLAQIBYgIDAgLOBAUACaEfn+AFAgEgBgcCASALDALXDIhxwCSXwPg0NMDAXGwkl8D4PpA+kAx+gAxcdch+gAx+gAw8AIEs44UMGwiNFIyxwXy4ZUB+kDUMBAj8APgBtMf0z+CEF/MPRRSMLqOhzIQN14yQBPgMDQ0NTWCEC/LJqISuuMCXwSED/LwgCAkAET6RDBwuvLhTYAH2UTXHBfLhkfpAIfAB+kDSADH6AIIK+vCAG6EhlFMVoKHeItcLAcMAIJIGoZE24iDC//LhkiGOPoIQBRONkchQCc8WUAvPFnEkSRRURqBwgBDIywVQB88WUAX6AhXLahLLH8s/Im6zlFjPFwGRMuIByQH7ABBHlBAqN1viCgBycIIQi3cXNQXIy/9QBM8WECSAQHCAEMjLBVAHzxZQBfoCFctqEssfyz8ibrOUWM8XAZEy4gHJAfsAAIICjjUm8AGCENUydtsQN0QAbXFwgBDIywVQB88WUAX6AhXLahLLH8s/Im6zlFjPFwGRMuIByQH7AJMwMjTiVQLwAwA7O1E0NM/+kAg10nCAJp/AfpA1DAQJBAj4DBwWW1tgAB0A8jLP1jPFgHPFszJ7VSC/dQQb`
+		data = `te6cckEBAgEAWAABlQAAAAAAAABkgAmZdBGwAyeH1p8lmxniF4hL/lrgtKpVWt5op0KDyjb28AIihaT5me2lhAhFtxowTSuLb3JY8S1sv5rLvgAnLsoWVgEAEDEwMC5qc29u7rJBww==`
+	)
+
+	ret := execGetMethod(t, i, a, "get_nft_data", code, data)
+	require.Equal(t, 5, len(ret))
+
+	got, err := json.Marshal(ret[:4])
+	require.Nil(t, err)
+	require.Equal(t, `[true,"ZA==","EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg","EQCIoWk-ZntpYQIRbcaME0ri29yWPEtbL-ay74AJy7KFlcfj"]`, string(got))
+}
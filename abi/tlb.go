@@ -103,7 +103,7 @@ func tlbParseSettingsInt(settings []string) (reflect.Type, error) {
 	}
 }
 
-func tlbParseSettingsDict(settings []string) (reflect.Type, error) {
+func tlbParseSettingsDict(r *Registry, settings []string) (reflect.Type, error) {
 	if settings[0] != "dict" {
 		return nil, fmt.Errorf("wrong dict settings: %v", settings)
 	}
@@ -121,7 +121,7 @@ func tlbParseSettingsDict(settings []string) (reflect.Type, error) {
 		return reflect.TypeOf((*cell.Dictionary)(nil)), nil
 	}
 
-	mapVT, err := tlbParseSettings(strings.Join(settings[3:], " "))
+	mapVT, err := tlbParseSettings(r, strings.Join(settings[3:], " "))
 	if err != nil {
 		return nil, err
 	}
@@ -140,7 +140,7 @@ func tlbParseSettingsDict(settings []string) (reflect.Type, error) {
 // maybe - reads 1 bit, and loads rest if its 1, can be used in combination with others only
 // either X Y - reads 1 bit, if its 0 - loads X, if 1 - loads Y
 // Some tags can be combined, for example "dict 256", "maybe ^"
-func tlbParseSettings(tag string) (reflect.Type, error) {
+func tlbParseSettings(r *Registry, tag string) (reflect.Type, error) {
 	tag = strings.TrimSpace(tag)
 	if tag == "-" {
 		return nil, nil
@@ -155,7 +155,7 @@ func tlbParseSettings(tag string) (reflect.Type, error) {
 		for _, dn := range strings.Split(tag[1:len(tag)-1], ",") {
 			// iterate through union definitions
 			// check that all definitions are known
-			_, ok := registeredDefinitions[TLBType(dn)]
+			_, ok := r.get(TLBType(dn))
 			if !ok {
 				return nil, fmt.Errorf("cannot find definition for '%s' type inside union", dn)
 			}
@@ -190,17 +190,17 @@ func tlbParseSettings(tag string) (reflect.Type, error) {
 		if len(settings) == 1 {
 			return reflect.TypeOf((*cell.Cell)(nil)), nil
 		}
-		return tlbParseSettings(strings.Join(settings[1:], " "))
+		return tlbParseSettings(r, strings.Join(settings[1:], " "))
 
 	case "dict":
-		return tlbParseSettingsDict(settings)
+		return tlbParseSettingsDict(r, settings)
 
 	default:
 		return nil, fmt.Errorf("cannot deserialize field as tag '%s'", tag)
 	}
 }
 
-func tlbMapFormat(format TLBType, tag string) (reflect.Type, error) {
+func tlbMapFormat(r *Registry, format TLBType, tag string) (reflect.Type, error) {
 	t, ok := typeNameMap[format]
 	if ok {
 		return t, nil
@@ -209,19 +209,19 @@ func tlbMapFormat(format TLBType, tag string) (reflect.Type, error) {
 	switch format {
 	case "":
 		// parse tlb tag and get default type
-		t, err := tlbParseSettings(tag)
+		t, err := tlbParseSettings(r, tag)
 		if t == nil || err != nil {
 			return nil, fmt.Errorf("parse tlb settings with tag '%s': %w", tag, err)
 		}
 		return t, nil
 
 	default:
-		d, ok := registeredDefinitions[format]
+		d, ok := r.get(format)
 		if !ok {
 			return nil, fmt.Errorf("cannot find definition for '%s' format", format)
 		}
 
-		t, err := tlbParseDesc(nil, d)
+		t, err := tlbParseDesc(r, nil, d)
 		if err != nil {
 			return nil, errors.Wrap(err, "creating new type from definition")
 		}
@@ -234,7 +234,7 @@ func tlbMapFormat(format TLBType, tag string) (reflect.Type, error) {
 	}
 }
 
-func tlbParseDesc(fields []reflect.StructField, schema TLBFieldsDesc, skipOptional ...bool) (reflect.Type, error) {
+func tlbParseDesc(r *Registry, fields []reflect.StructField, schema TLBFieldsDesc, skipOptional ...bool) (reflect.Type, error) {
 	var err error
 
 	for i := range schema {
@@ -253,13 +253,13 @@ func tlbParseDesc(fields []reflect.StructField, schema TLBFieldsDesc, skipOption
 		}
 
 		if f.Format == TLBStructCell {
-			sf.Type, err = tlbParseDesc(nil, f.Fields, skipOptional...)
+			sf.Type, err = tlbParseDesc(r, nil, f.Fields, skipOptional...)
 			if err != nil {
 				return nil, fmt.Errorf("%s field with struct: %w", sf.Name, err)
 			}
 			sf.Type = reflect.PointerTo(sf.Type)
 		} else {
-			sf.Type, err = tlbMapFormat(f.Format, sf.Tag.Get("tlb"))
+			sf.Type, err = tlbMapFormat(r, f.Format, sf.Tag.Get("tlb"))
 			if err != nil {
 				return nil, errors.Wrapf(err, "%s field", f.Name)
 			}
@@ -280,7 +280,12 @@ func NewTLBDesc(x any) (TLBFieldsDesc, error) {
 }
 
 func (desc TLBFieldsDesc) New(skipOptional ...bool) (any, error) {
-	t, err := tlbParseDesc(nil, desc, skipOptional...)
+	return desc.NewIn(registry, skipOptional...)
+}
+
+// NewIn creates a new structure looking for definitions in the given registry, or in the global one if it is nil.
+func (desc TLBFieldsDesc) NewIn(r *Registry, skipOptional ...bool) (any, error) {
+	t, err := tlbParseDesc(r.orGlobal(), nil, desc, skipOptional...)
 	if err != nil {
 		return nil, err
 	}
@@ -288,7 +293,12 @@ func (desc TLBFieldsDesc) New(skipOptional ...bool) (any, error) {
 }
 
 func (desc TLBFieldsDesc) FromCell(c *cell.Cell) (any, error) {
-	parsed, err := desc.New()
+	return desc.FromCellIn(registry, c)
+}
+
+// FromCellIn parses the cell looking for definitions in the given registry.
+func (desc TLBFieldsDesc) FromCellIn(r *Registry, c *cell.Cell) (any, error) {
+	parsed, err := desc.NewIn(r)
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating struct")
 	}
@@ -300,7 +310,7 @@ func (desc TLBFieldsDesc) FromCell(c *cell.Cell) (any, error) {
 	}
 
 	// skipping optional fields
-	parsed, err = desc.New(true)
+	parsed, err = desc.NewIn(r, true)
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating struct (skip optional)")
 	}
@@ -357,6 +367,11 @@ func NewOperationDesc(x any) (*OperationDesc, error) {
 }
 
 func (desc *OperationDesc) New(skipOptional ...bool) (any, error) {
+	return desc.NewIn(registry, skipOptional...)
+}
+
+// NewIn creates a new operation structure looking for definitions in the given registry, or in the global one if it is nil.
+func (desc *OperationDesc) NewIn(r *Registry, skipOptional ...bool) (any, error) {
 	var fields = []reflect.StructField{
 		{
 			Name: "Op",
@@ -364,7 +379,7 @@ func (desc *OperationDesc) New(skipOptional ...bool) (any, error) {
 			Type: reflect.TypeOf(tlb.Magic{}),
 		},
 	}
-	t, err := tlbParseDesc(fields, desc.Body, skipOptional...)
+	t, err := tlbParseDesc(r.orGlobal(), fields, desc.Body, skipOptional...)
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +387,12 @@ func (desc *OperationDesc) New(skipOptional ...bool) (any, error) {
 }
 
 func (desc *OperationDesc) FromCell(c *cell.Cell) (any, error) {
-	parsed, err := desc.New()
+	return desc.FromCellIn(registry, c)
+}
+
+// FromCellIn parses the cell looking for definitions in the given registry.
+func (desc *OperationDesc) FromCellIn(r *Registry, c *cell.Cell) (any, error) {
+	parsed, err := desc.NewIn(r)
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating struct")
 	}
@@ -384,7 +404,7 @@ func (desc *OperationDesc) FromCell(c *cell.Cell) (any, error) {
 	}
 
 	// skipping optional fields
-	parsed, err = desc.New(true)
+	parsed, err = desc.NewIn(r, true)
 	if err != nil {
 		return nil, errors.Wrapf(err, "creating struct (skip optional)")
 	}
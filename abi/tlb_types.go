@@ -165,9 +165,8 @@ var (
 		TLBString:      reflect.TypeOf((*StringSnake)(nil)),
 		"telemintText": reflect.TypeOf((*TelemintText)(nil)),
 		"dedustAsset":  reflect.TypeOf((*DedustAsset)(nil)),
+		TLBContentCell: reflect.TypeOf((*TokenData)(nil)),
 	}
-
-	registeredDefinitions = map[TLBType]TLBFieldsDesc{}
 )
 
 func init() {
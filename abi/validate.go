@@ -0,0 +1,437 @@
+package abi
+
+import (
+	"bytes"
+	_ "embed" // embed abi json schema
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+)
+
+//go:embed abi.schema.json
+var schemaJSON string
+
+// ValidationError is the problem found in contract interfaces description.
+// Path points to the problem value in JSONPath notation, for example, $[0].in_messages[1].op_code.
+type ValidationError struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+	Warning bool   `json:"warning,omitempty"`
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+type jsonPath string
+
+var jsonPathIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func (p jsonPath) field(name string) jsonPath {
+	if jsonPathIdentifier.MatchString(name) {
+		return p + jsonPath("."+name)
+	}
+	return p + jsonPath("["+strconv.Quote(name)+"]")
+}
+
+func (p jsonPath) index(i int) jsonPath {
+	return p + jsonPath(fmt.Sprintf("[%d]", i))
+}
+
+// InterfacePath returns JSONPath of the it-th contract interface in the json document.
+func InterfacePath(it int) string {
+	return string(jsonPath("$").index(it))
+}
+
+// pointerToPath converts JSON pointer, like /0/in_messages/1, to JSONPath.
+func pointerToPath(ptr string) string {
+	p := jsonPath("$")
+	if ptr == "" {
+		return string(p)
+	}
+	for _, t := range strings.Split(strings.TrimPrefix(ptr, "/"), "/") {
+		t = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+		if i, err := strconv.Atoi(t); err == nil {
+			p = p.index(i)
+		} else {
+			p = p.field(t)
+		}
+	}
+	return string(p)
+}
+
+type validator struct {
+	definitions *Registry
+	errors      []*ValidationError
+}
+
+func (v *validator) errorf(p jsonPath, format string, args ...any) {
+	v.errors = append(v.errors, &ValidationError{Path: string(p), Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) warnf(p jsonPath, format string, args ...any) {
+	v.errors = append(v.errors, &ValidationError{Path: string(p), Message: fmt.Sprintf(format, args...), Warning: true})
+}
+
+// collectSchemaErrors flattens validation errors tree, reporting only the deepest causes.
+// Failed oneOf and anyOf keywords are reported as a whole, as their causes are alternatives.
+func collectSchemaErrors(ve *jsonschema.ValidationError, ret []*ValidationError) []*ValidationError {
+	if len(ve.Causes) == 0 {
+		return append(ret, &ValidationError{Path: pointerToPath(ve.InstanceLocation), Message: ve.Message})
+	}
+	if strings.HasSuffix(ve.KeywordLocation, "/oneOf") || strings.HasSuffix(ve.KeywordLocation, "/anyOf") {
+		var alternatives []string
+		for _, c := range ve.Causes {
+			alternatives = append(alternatives, c.Message)
+		}
+		return append(ret, &ValidationError{
+			Path:    pointerToPath(ve.InstanceLocation),
+			Message: fmt.Sprintf("%s (%s)", ve.Message, strings.Join(alternatives, "; ")),
+		})
+	}
+	for _, c := range ve.Causes {
+		ret = collectSchemaErrors(c, ret)
+	}
+	return ret
+}
+
+// schemaWithDefinitions adds names of the registered definitions and the ones declared in the document
+// to the allowed formats of values, as definitions can be used as formats.
+// Definitions themselves are checked by ValidateInterfaces.
+func schemaWithDefinitions(doc any) (string, error) {
+	var names []string
+	for _, dn := range registry.names() {
+		names = append(names, string(dn))
+	}
+	interfaces, _ := doc.([]any)
+	for _, i := range interfaces {
+		i, _ := i.(map[string]any)
+		definitions, _ := i["definitions"].(map[string]any)
+		for dn := range definitions {
+			names = append(names, dn)
+		}
+	}
+	if len(names) == 0 {
+		return schemaJSON, nil
+	}
+	sort.Strings(names)
+
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(schemaJSON), &schema); err != nil {
+		return "", errors.Wrap(err, "unmarshal abi schema")
+	}
+	defs, _ := schema["$defs"].(map[string]any)
+	for _, v := range []string{"vm_value", "tlb_value"} {
+		def, _ := defs[v].(map[string]any)
+		props, _ := def["properties"].(map[string]any)
+		format, _ := props["format"].(map[string]any)
+		enum, ok := format["enum"].([]any)
+		if !ok {
+			return "", fmt.Errorf("no format enum in %s schema", v)
+		}
+		for _, dn := range names {
+			enum = append(enum, dn)
+		}
+		format["enum"] = enum
+	}
+
+	ret, err := json.Marshal(schema)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal abi schema")
+	}
+	return string(ret), nil
+}
+
+// ValidateSchema checks json document with contract interfaces against abi.schema.json.
+func ValidateSchema(j []byte) ([]*ValidationError, error) {
+	var doc any
+	d := json.NewDecoder(bytes.NewReader(j))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json")
+	}
+
+	schemaDoc, err := schemaWithDefinitions(doc)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := jsonschema.CompileString("abi.schema.json", schemaDoc)
+	if err != nil {
+		return nil, errors.Wrap(err, "compile abi schema")
+	}
+
+	err = schema.Validate(doc)
+	if err == nil {
+		return nil, nil
+	}
+	var ve *jsonschema.ValidationError
+	if !errors.As(err, &ve) {
+		return nil, errors.Wrap(err, "validate json")
+	}
+
+	return collectSchemaErrors(ve, nil), nil
+}
+
+// validateFields builds every field type separately to find the exact field with wrong description.
+func (v *validator) validateFields(p jsonPath, fields TLBFieldsDesc) {
+	for it := range fields {
+		f := fields[it]
+		fp := p.index(it)
+
+		if f.Name == "" {
+			v.errorf(fp.field("name"), "field name is not set")
+		}
+		if f.Format == TLBStructCell || (f.Format == "" && len(f.Fields) > 0) {
+			v.validateFields(fp.field("struct_fields"), f.Fields)
+			continue
+		}
+		if _, err := tlbParseDesc(v.definitions, nil, TLBFieldsDesc{f}); err != nil {
+			v.errorf(fp, "%s", err)
+		}
+	}
+}
+
+func (v *validator) validateDefinitions(p jsonPath, definitions map[TLBType]TLBFieldsDesc) {
+	names := make([]string, 0, len(definitions))
+	for dn := range definitions {
+		names = append(names, string(dn))
+	}
+	sort.Strings(names)
+
+	for _, dn := range names {
+		d := definitions[TLBType(dn)]
+		if len(d) == 0 {
+			v.errorf(p.field(dn), "empty definition")
+			continue
+		}
+		v.validateFields(p.field(dn), d)
+	}
+}
+
+// ParseOperationCode parses operation code in the 0x-prefixed hex format.
+func ParseOperationCode(code string) (uint32, error) {
+	if !strings.HasPrefix(code, "0x") {
+		return 0, fmt.Errorf("operation code '%s' must be in hex format with 0x prefix", code)
+	}
+	if l := len(code) - 2; l < 1 || l > 8 {
+		return 0, fmt.Errorf("operation code '%s' must have from 1 to 8 hex digits", code)
+	}
+	id, err := strconv.ParseUint(code[2:], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("wrong hex operation code '%s'", code)
+	}
+	return uint32(id), nil
+}
+
+func (v *validator) validateOperations(p jsonPath, operations []OperationDesc) {
+	names := map[string]int{}
+	codes := map[uint32]int{}
+
+	for it := range operations {
+		op := &operations[it]
+		opp := p.index(it)
+
+		if op.Name == "" {
+			v.errorf(opp.field("op_name"), "operation name is not set")
+		} else if prev, ok := names[op.Name]; ok {
+			v.errorf(opp.field("op_name"), "'%s' operation is already described at %s", op.Name, p.index(prev))
+		} else {
+			names[op.Name] = it
+		}
+
+		switch strings.ToUpper(op.Type) {
+		case "", "INTERNAL", "EXTERNAL_IN", "EXTERNAL_OUT":
+		default:
+			v.errorf(opp.field("type"), "unknown '%s' message type", op.Type)
+		}
+
+		id, err := ParseOperationCode(op.Code)
+		if err != nil {
+			v.errorf(opp.field("op_code"), "%s", err)
+			continue
+		}
+		if prev, ok := codes[id]; ok {
+			// operations are stored by contract name, direction and operation id
+			v.errorf(opp.field("op_code"), "operation code %s is already used by '%s' operation at %s",
+				op.Code, operations[prev].Name, p.index(prev))
+		} else {
+			codes[id] = it
+		}
+
+		before := len(v.errors)
+		v.validateFields(opp.field("body"), op.Body)
+		if len(v.errors) > before {
+			continue
+		}
+		if _, err := op.New(); err != nil {
+			v.errorf(opp, "creating operation structure: %s", err)
+		}
+	}
+}
+
+var (
+	vmIntFormats = map[TLBType]bool{
+		"": true, TLBBigInt: true, TLBBool: true, TLBBytes: true,
+		"uint8": true, "uint16": true, "uint32": true, "uint64": true,
+		"int8": true, "int16": true, "int32": true, "int64": true,
+	}
+	vmCellArgumentFormats = map[TLBType]bool{
+		"": true, TLBCell: true, TLBAddr: true, TLBString: true, TLBStructCell: true,
+	}
+)
+
+// validateVmValue checks that the value format can be used with its stack type.
+func (v *validator) validateVmValue(p jsonPath, d *VmValueDesc, argument bool) {
+	if d.Column != "" && !d.Column.IsValid() {
+		v.errorf(p.field("column"), "unknown '%s' account column", d.Column)
+	}
+
+	switch d.StackType {
+	case VmInt:
+		if !vmIntFormats[d.Format] {
+			v.errorf(p.field("format"), "'%s' format cannot be used with '%s' stack type", d.Format, d.StackType)
+		}
+
+	case VmCell, VmSlice:
+		if d.Format == TLBStructCell || (d.Format == "" && len(d.Fields) > 0) {
+			if len(d.Fields) == 0 {
+				v.errorf(p.field("struct_fields"), "struct fields are not set")
+			}
+			v.validateFields(p.field("struct_fields"), d.Fields)
+			return
+		}
+		if argument && d.StackType == VmCell {
+			if !vmCellArgumentFormats[d.Format] {
+				v.errorf(p.field("format"), "'%s' format cannot be used with '%s' stack type in arguments", d.Format, d.StackType)
+			}
+			return
+		}
+		switch d.Format {
+		case "", TLBCell, TLBSlice, TLBString, TLBAddr, TLBContentCell:
+			return
+		}
+		// other formats are loaded with registered definitions or known types
+		if _, ok := v.definitions.get(d.Format); ok {
+			return
+		}
+		if _, ok := typeNameMap[d.Format]; !ok {
+			v.errorf(p.field("format"), "cannot find definition or type for '%s' format", d.Format)
+		}
+
+	case VmTuple:
+		if argument {
+			v.errorf(p.field("stack_type"), "'%s' stack type cannot be used in arguments", d.StackType)
+			return
+		}
+		switch d.Format {
+		case "":
+		case TupleArray, TupleList:
+			if len(d.Elements) != 1 {
+				v.errorf(p.field("tuple_elements"), "%s must have exactly one element descriptor", d.Format)
+			}
+		default:
+			v.errorf(p.field("format"), "'%s' format cannot be used with '%s' stack type", d.Format, d.StackType)
+		}
+		for it := range d.Elements {
+			v.validateVmValue(p.field("tuple_elements").index(it), &d.Elements[it], argument)
+		}
+
+	default:
+		v.errorf(p.field("stack_type"), "unknown '%s' stack type", d.StackType)
+	}
+}
+
+func (v *validator) validateGetMethods(p jsonPath, getMethods []GetMethodDesc) {
+	names := map[string]int{}
+
+	for it := range getMethods {
+		gm := &getMethods[it]
+		gp := p.index(it)
+
+		if gm.Name == "" {
+			v.errorf(gp.field("name"), "get-method name is not set")
+		} else if prev, ok := names[gm.Name]; ok {
+			v.errorf(gp.field("name"), "'%s' get-method is already described at %s", gm.Name, p.index(prev))
+		} else {
+			names[gm.Name] = it
+		}
+
+		for at := range gm.Arguments {
+			v.validateVmValue(gp.field("arguments").index(at), &gm.Arguments[at], true)
+		}
+
+		returns := map[string]bool{}
+		for rt := range gm.ReturnValues {
+			r := &gm.ReturnValues[rt]
+			if r.Name != "" && returns[r.Name] {
+				v.errorf(gp.field("return_values").index(rt).field("name"), "'%s' return value is already described", r.Name)
+			}
+			returns[r.Name] = true
+			v.validateVmValue(gp.field("return_values").index(rt), r, false)
+		}
+	}
+}
+
+func (v *validator) validateInterface(p jsonPath, i *InterfaceDesc) {
+	if i.Name == "" {
+		v.errorf(p.field("interface_name"), "interface name is not set")
+	}
+
+	if i.CodeBoc != "" {
+		code, err := base64.StdEncoding.DecodeString(i.CodeBoc)
+		if err != nil {
+			v.errorf(p.field("code_boc"), "decode code boc from base64: %s", err)
+		} else if _, err := cell.FromBOC(code); err != nil {
+			v.errorf(p.field("code_boc"), "code cell from boc: %s", err)
+		}
+	}
+	if len(i.Addresses) == 0 && i.CodeBoc == "" && len(i.GetMethods) == 0 {
+		v.warnf(p, "interface has neither addresses, nor code, nor get-methods, so it cannot be matched with any account")
+	}
+
+	v.validateDefinitions(p.field("definitions"), i.Definitions)
+	v.validateFields(p.field("contract_data"), i.ContractData)
+	v.validateOperations(p.field("in_messages"), i.InMessages)
+	v.validateOperations(p.field("out_messages"), i.OutMessages)
+	v.validateGetMethods(p.field("get_methods"), i.GetMethods)
+
+	if i.Verification != nil {
+		if err := i.Verification.Validate(i.GetMethods); err != nil {
+			v.errorf(p.field("verification"), "%s", err)
+		}
+	}
+}
+
+// ValidateInterfaces checks contract interfaces description by building every TL-B type
+// in the same way as it is done during messages and account states parsing.
+// Definitions are registered in a local registry in the same order as contract interfaces are added to the indexer.
+func ValidateInterfaces(interfaces []*InterfaceDesc) []*ValidationError {
+	v := &validator{definitions: NewRegistry()}
+	names := map[ContractName]int{}
+
+	for it, i := range interfaces {
+		if err := v.definitions.Register(i.Definitions); err != nil {
+			v.errorf(jsonPath(InterfacePath(it)).field("definitions"), "%s", err)
+		}
+	}
+
+	for it, i := range interfaces {
+		p := jsonPath(InterfacePath(it))
+		if prev, ok := names[i.Name]; ok && i.Name != "" {
+			v.errorf(p.field("interface_name"), "'%s' interface is already described at %s", i.Name, InterfacePath(prev))
+		} else {
+			names[i.Name] = it
+		}
+		v.validateInterface(p, i)
+	}
+
+	return v.errors
+}
@@ -0,0 +1,107 @@
+package abi_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/abi"
+)
+
+func TestValidateSchema_Known(t *testing.T) {
+	for _, fn := range []string{"tep62_nft.json", "tep74_jetton.json", "wallets.json", "stonfi.json", "dedust_v2.json"} {
+		j, err := os.ReadFile("known/" + fn)
+		require.Nil(t, err)
+
+		errs, err := abi.ValidateSchema(j)
+		require.Nil(t, err)
+		require.Empty(t, errs, fn)
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	errs, err := abi.ValidateSchema([]byte(`[{
+		"interface_name": "test",
+		"definitions": {"test_def": [{"name": "a", "tlb_type": "## 8"}]},
+		"in_messages": [{"op_name": "test_op", "op_code": "0x123456789", "body": [{"name": "x", "tlb_type": "int 8"}]}],
+		"get_methods": [{"name": "get_x", "arguments": [{"name": "ids", "stack_type": "tuple"}], "return_values": [{"name": "x", "stack_type": "tuple", "format": "list", "tuple_elements": [{"name": "item", "stack_type": "int"}]}]}]
+	}]`))
+	require.Nil(t, err)
+	require.Len(t, errs, 3)
+
+	paths := map[string]string{}
+	for _, e := range errs {
+		paths[e.Path] = e.Message
+	}
+	require.Contains(t, paths, "$[0].in_messages[0].op_code")
+	require.Contains(t, paths, "$[0].in_messages[0].body[0].tlb_type")
+	require.Contains(t, paths["$[0].in_messages[0].body[0].tlb_type"], "oneOf failed")
+	require.Contains(t, paths, "$[0].get_methods[0].arguments[0].stack_type")
+
+	_, err = abi.ValidateSchema([]byte(`[{`))
+	require.NotNil(t, err)
+}
+
+func TestValidateSchema_DefinitionFormats(t *testing.T) {
+	errs, err := abi.ValidateSchema([]byte(`[{
+		"interface_name": "test",
+		"definitions": {"test_def": [{"name": "a", "tlb_type": "## 8"}]},
+		"in_messages": [{"op_name": "test_op", "op_code": "0x1", "body": [
+			{"name": "x", "tlb_type": ".", "format": "test_def"},
+			{"name": "y", "tlb_type": ".", "format": "test_undefined"}
+		]}]
+	}]`))
+	require.Nil(t, err)
+	require.Len(t, errs, 1)
+	require.Equal(t, "$[0].in_messages[0].body[1].format", errs[0].Path)
+}
+
+func TestValidateInterfaces(t *testing.T) {
+	var interfaces []*abi.InterfaceDesc
+
+	err := json.Unmarshal([]byte(`[{
+		"interface_name": "validate_test",
+		"addresses": ["EQDj5AA8mQvM5wJEQsFFFof79y3ZsuX6wowktWQFhz_Anton"],
+		"definitions": {"validate_test_def": [{"name": "a", "tlb_type": "## 8", "format": "validate_test_undefined"}]},
+		"in_messages": [
+			{"op_name": "first", "op_code": "0x1", "body": [{"name": "x", "tlb_type": "## 300"}]},
+			{"op_name": "second", "op_code": "0x00000001"},
+			{"op_name": "third", "op_code": "0x123456789"},
+			{"op_name": "fourth", "op_code": "0x4", "body": [{"name": "s", "tlb_type": "^", "struct_fields": [{"name": "y", "tlb_type": ".", "format": "validate_test_unknown"}]}]}
+		],
+		"get_methods": [
+			{"name": "get_data", "return_values": [
+				{"name": "balance", "stack_type": "int", "format": "addr"},
+				{"name": "owner", "stack_type": "slice", "format": "addr", "column": "owner"},
+				{"name": "items", "stack_type": "tuple", "format": "array"}
+			]},
+			{"name": "get_data", "arguments": [{"name": "ids", "stack_type": "tuple", "format": "array"}], "return_values": []}
+		],
+		"verification": {"parent_interface": "validate_test_parent", "get_method": "get_address", "arguments": [{"get_method": "get_data", "return_value": "wallet"}], "return_value": "address"}
+	}]`), &interfaces)
+	require.Nil(t, err)
+
+	errs := abi.ValidateInterfaces(interfaces)
+
+	paths := map[string]string{}
+	for _, e := range errs {
+		require.False(t, e.Warning)
+		paths[e.Path] = e.Message
+	}
+	require.Equal(t, map[string]string{
+		"$[0].definitions":                                    "cannot register [validate_test_def] definitions",
+		"$[0].definitions.validate_test_def[0]":               "a field: cannot find definition for 'validate_test_undefined' format",
+		"$[0].in_messages[0].body[0]":                         "x field: parse tlb settings with tag '## 300': too much bits for ## tag: 300",
+		"$[0].in_messages[1].op_code":                         "operation code 0x00000001 is already used by 'first' operation at $[0].in_messages[0]",
+		"$[0].in_messages[2].op_code":                         "operation code '0x123456789' must have from 1 to 8 hex digits",
+		"$[0].in_messages[3].body[0].struct_fields[0]":        "y field: cannot find definition for 'validate_test_unknown' format",
+		"$[0].get_methods[0].return_values[0].format":         "'addr' format cannot be used with 'int' stack type",
+		"$[0].get_methods[0].return_values[1].column":         "unknown 'owner' account column",
+		"$[0].get_methods[0].return_values[2].tuple_elements": "array must have exactly one element descriptor",
+		"$[0].get_methods[1].name":                            "'get_data' get-method is already described at $[0].get_methods[0]",
+		"$[0].get_methods[1].arguments[0].stack_type":         "'tuple' stack type cannot be used in arguments",
+		"$[0].verification":                                   "argument 0: cannot find 'wallet' return value of 'get_data' get-method",
+	}, paths)
+}
@@ -0,0 +1,86 @@
+package abi
+
+import (
+	"fmt"
+)
+
+// GetMethodReturnRef points to the named return value of the contract get-method.
+type GetMethodReturnRef struct {
+	GetMethod   string `json:"get_method"`
+	ReturnValue string `json:"return_value"`
+}
+
+// VerificationDesc describes how to check that the contract was deployed by the trusted parent contract,
+// for example, that jetton wallet was deployed by jetton minter, or that DEX pool was created by DEX factory.
+// The parent get-method is executed with arguments taken from the contract get-method returns,
+// and the returned address must be equal to the contract address. Otherwise, the contract is marked as fake.
+type VerificationDesc struct {
+	// ParentInterface is the contract interface of the parent, which has the verification get-method.
+	ParentInterface ContractName `json:"parent_interface"`
+
+	// ParentAddress points to the contract get-method return value with the parent address.
+	// If it is not set, the address is taken from the parent interface description, which must have the only address.
+	ParentAddress *GetMethodReturnRef `json:"parent_address,omitempty"`
+
+	// GetMethod is the name of the parent get-method calculating the contract address.
+	GetMethod string `json:"get_method"`
+
+	// Arguments of the parent get-method taken from the contract get-method returns.
+	Arguments []GetMethodReturnRef `json:"arguments,omitempty"`
+
+	// ReturnValue is the name of the parent get-method return value, which must be equal to the contract address.
+	ReturnValue string `json:"return_value"`
+}
+
+// ReturnValueIndex finds the position of the named return value of the get-method.
+func ReturnValueIndex(getMethods []GetMethodDesc, ref GetMethodReturnRef) (int, error) {
+	for it := range getMethods {
+		if getMethods[it].Name != ref.GetMethod {
+			continue
+		}
+		if len(getMethods[it].Arguments) > 0 {
+			return -1, fmt.Errorf("get-method '%s' has arguments", ref.GetMethod)
+		}
+		for rt := range getMethods[it].ReturnValues {
+			if getMethods[it].ReturnValues[rt].Name == ref.ReturnValue {
+				return rt, nil
+			}
+		}
+		return -1, fmt.Errorf("cannot find '%s' return value of '%s' get-method", ref.ReturnValue, ref.GetMethod)
+	}
+	return -1, fmt.Errorf("cannot find '%s' get-method", ref.GetMethod)
+}
+
+// References returns true if the verification rule uses returns of the given get-method.
+func (v *VerificationDesc) References(getMethod string) bool {
+	if v.ParentAddress != nil && v.ParentAddress.GetMethod == getMethod {
+		return true
+	}
+	for it := range v.Arguments {
+		if v.Arguments[it].GetMethod == getMethod {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the rule references existing get-methods of the contract.
+func (v *VerificationDesc) Validate(getMethods []GetMethodDesc) error {
+	if v.ParentInterface == "" {
+		return fmt.Errorf("parent interface is not set")
+	}
+	if v.GetMethod == "" || v.ReturnValue == "" {
+		return fmt.Errorf("parent get-method or its return value is not set")
+	}
+	if v.ParentAddress != nil {
+		if _, err := ReturnValueIndex(getMethods, *v.ParentAddress); err != nil {
+			return fmt.Errorf("parent address: %w", err)
+		}
+	}
+	for it := range v.Arguments {
+		if _, err := ReturnValueIndex(getMethods, v.Arguments[it]); err != nil {
+			return fmt.Errorf("argument %d: %w", it, err)
+		}
+	}
+	return nil
+}
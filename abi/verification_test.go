@@ -0,0 +1,41 @@
+package abi_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/abi"
+)
+
+func TestVerificationDesc_Validate(t *testing.T) {
+	getMethods := []abi.GetMethodDesc{{
+		Name: "get_wallet_data",
+		ReturnValues: []abi.VmValueDesc{
+			{Name: "balance", StackType: "int"},
+			{Name: "owner_address", StackType: "slice", Format: "addr"},
+			{Name: "jetton_master_address", StackType: "slice", Format: "addr"},
+		},
+	}}
+
+	v := abi.VerificationDesc{
+		ParentInterface: "jetton_minter",
+		ParentAddress:   &abi.GetMethodReturnRef{GetMethod: "get_wallet_data", ReturnValue: "jetton_master_address"},
+		GetMethod:       "get_wallet_address",
+		Arguments:       []abi.GetMethodReturnRef{{GetMethod: "get_wallet_data", ReturnValue: "owner_address"}},
+		ReturnValue:     "wallet_address",
+	}
+	require.Nil(t, v.Validate(getMethods))
+	require.True(t, v.References("get_wallet_data"))
+	require.False(t, v.References("get_jetton_data"))
+
+	idx, err := abi.ReturnValueIndex(getMethods, v.Arguments[0])
+	require.Nil(t, err)
+	require.Equal(t, 1, idx)
+
+	v.Arguments[0].ReturnValue = "owner"
+	require.ErrorContains(t, v.Validate(getMethods), "cannot find 'owner' return value")
+
+	v.Arguments[0] = abi.GetMethodReturnRef{GetMethod: "get_data", ReturnValue: "owner_address"}
+	require.ErrorContains(t, v.Validate(getMethods), "cannot find 'get_data' get-method")
+}
@@ -72,6 +72,12 @@ const docTemplate = `{
                         "name": "minter_address",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "filter FT or NFT minters by TEP-64 token symbol",
+                        "name": "content_symbol",
+                        "in": "query"
+                    },
                     {
                         "enum": [
                             "ASC",
@@ -162,7 +168,7 @@ const docTemplate = `{
         },
         "/accounts/aggregated/history": {
             "get": {
-                "description": "Counts accounts",
+                "description": "Counts accounts, jetton holders or shows jetton balance history of the owner",
                 "consumes": [
                     "application/json"
                 ],
@@ -176,7 +182,9 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "enum": [
-                            "active_addresses"
+                            "active_addresses",
+                            "jetton_balance",
+                            "holder_count"
                         ],
                         "type": "string",
                         "description": "metric to show",
@@ -199,6 +207,12 @@ const docTemplate = `{
                         "name": "minter_address",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "jetton wallet owner address (for jetton_balance metric)",
+                        "name": "owner_address",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "from timestamp",
@@ -236,6 +250,200 @@ const docTemplate = `{
                 }
             }
         },
+        "/accounts/holders": {
+            "get": {
+                "description": "Returns jetton wallets with non-zero balance at the given masterchain block",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "jetton holders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "FT master address",
+                        "name": "minter_address",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "masterchain block seq_no (the latest state by default)",
+                        "name": "master_seq_no",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10000,
+                        "type": "integer",
+                        "default": 25,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/aggregate.HoldersRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{address}/emulate": {
+            "post": {
+                "description": "Emulates the transaction caused by the internal or external incoming message on the stored account state.\nMessages of the emulated transaction are decoded with known contract interfaces.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "emulate message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "account address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "message boc and account state",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.EmulateMessageReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/app.EmulateMessageRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/accounts/{address}/get-methods/{name}": {
+            "post": {
+                "description": "Emulates get-method on the stored account state.\nArguments and return values are described by the given contract interface or passed ad-hoc.\nIf the interface and return values are omitted, the raw stack is returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "execute get-method",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "account address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "get-method name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "account state, interface and arguments",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/app.GetMethodReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/abi.GetMethodExecution"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
         "/blocks": {
             "get": {
                 "description": "Returns filtered blocks",
@@ -363,11 +571,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/contracts/operations": {
-            "get": {
-                "description": "Returns known contract message payloads schema",
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Inserts new contract interfaces with their definitions and operations and adds rescan tasks for them.\nRequest body has the same format as abi/known json files.",
                 "consumes": [
                     "application/json"
                 ],
@@ -377,20 +588,57 @@ const docTemplate = `{
                 "tags": [
                     "contract"
                 ],
-                "summary": "contract operations",
+                "summary": "add contract interfaces",
+                "parameters": [
+                    {
+                        "description": "contract interfaces",
+                        "name": "interfaces",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/abi.InterfaceDesc"
+                            }
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/http.GetOperationsRes"
+                            "$ref": "#/definitions/app.ContractChangeRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
                         }
                     }
                 }
             }
         },
-        "/labels": {
-            "get": {
-                "description": "Search addresses by label name or category",
+        "/contracts/interfaces/{name}": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Updates contract interface and adds rescan tasks for the difference between old and new interfaces.\nRequest body has the same format as abi/known json files, it must contain the updated interface.",
                 "consumes": [
                     "application/json"
                 ],
@@ -398,53 +646,111 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "label"
+                    "contract"
                 ],
-                "summary": "address labels",
+                "summary": "update contract interface",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "filter labels by its name",
+                        "description": "contract interface name",
                         "name": "name",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "description": "filter by categories",
-                        "name": "category",
-                        "in": "query"
+                        "description": "contract interfaces",
+                        "name": "interfaces",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/abi.InterfaceDesc"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/app.ContractChangeRes"
+                        }
                     },
-                    {
-                        "type": "integer",
-                        "description": "offset",
-                        "name": "offset",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
                     {
-                        "maximum": 10000,
-                        "type": "integer",
-                        "default": 3,
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query"
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Deletes contract interface and adds rescan tasks removing its parsed data.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contract"
+                ],
+                "summary": "delete contract interface",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "contract interface name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/filter.LabelsRes"
+                            "$ref": "#/definitions/app.ContractChangeRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
                         }
                     }
                 }
             }
         },
-        "/labels/categories": {
+        "/contracts/operations": {
             "get": {
-                "description": "Returns all possible label categories",
+                "description": "Returns known contract message payloads schema",
                 "consumes": [
                     "application/json"
                 ],
@@ -452,22 +758,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "label"
+                    "contract"
                 ],
-                "summary": "address label categories",
+                "summary": "contract operations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/http.GetLabelCategoriesRes"
+                            "$ref": "#/definitions/http.GetOperationsRes"
                         }
                     }
                 }
             }
         },
-        "/messages": {
+        "/jettons/transfers": {
             "get": {
-                "description": "Returns filtered messages",
+                "description": "Returns jetton transfers between jetton wallets with their owners and minter",
                 "consumes": [
                     "application/json"
                 ],
@@ -477,24 +783,24 @@ const docTemplate = `{
                 "tags": [
                     "transaction"
                 ],
-                "summary": "transaction messages",
+                "summary": "jetton transfers",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "msg hash",
-                        "name": "hash",
+                        "description": "search by jetton_internal_transfer message hash",
+                        "name": "msg_hash",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "filter by source workchain",
-                        "name": "src_workchain",
+                        "type": "string",
+                        "description": "search by trace id",
+                        "name": "trace_id",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "filter by destination workchain",
-                        "name": "dst_workchain",
+                        "type": "string",
+                        "description": "jetton minter address",
+                        "name": "minter_address",
                         "in": "query"
                     },
                     {
@@ -502,8 +808,8 @@ const docTemplate = `{
                         "items": {
                             "type": "string"
                         },
-                        "description": "source address",
-                        "name": "src_address",
+                        "description": "sender address",
+                        "name": "src_owner",
                         "in": "query"
                     },
                     {
@@ -511,14 +817,8 @@ const docTemplate = `{
                         "items": {
                             "type": "string"
                         },
-                        "description": "destination address",
-                        "name": "dst_address",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "operation id in hex format or as int32",
-                        "name": "operation_id",
+                        "description": "receiver address",
+                        "name": "dst_owner",
                         "in": "query"
                     },
                     {
@@ -526,26 +826,24 @@ const docTemplate = `{
                         "items": {
                             "type": "string"
                         },
-                        "description": "source contract interface",
-                        "name": "src_contract",
+                        "description": "sender or receiver address",
+                        "name": "owner",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "description": "destination contract interface",
-                        "name": "dst_contract",
+                        "type": "integer",
+                        "description": "query id of the transfer",
+                        "name": "query_id",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "description": "filter by contract operation names",
-                        "name": "operation_name",
+                        "enum": [
+                            "success",
+                            "bounced"
+                        ],
+                        "type": "string",
+                        "description": "transfer status",
+                        "name": "status",
                         "in": "query"
                     },
                     {
@@ -585,15 +883,21 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/filter.MessagesRes"
+                            "$ref": "#/definitions/filter.JettonTransfersRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
                         }
                     }
                 }
             }
         },
-        "/messages/aggregated": {
+        "/jettons/transfers/aggregated/history": {
             "get": {
-                "description": "Aggregates receivers and senders",
+                "description": "Counts jetton transfers or sums transferred amount of the given jetton",
                 "consumes": [
                     "application/json"
                 ],
@@ -603,26 +907,52 @@ const docTemplate = `{
                 "tags": [
                     "transaction"
                 ],
-                "summary": "aggregated messages",
+                "summary": "aggregated jetton transfers grouped by timestamp",
                 "parameters": [
                     {
+                        "enum": [
+                            "transfer_count",
+                            "transfer_amount_sum"
+                        ],
                         "type": "string",
-                        "description": "address to aggregate by",
-                        "name": "address",
+                        "description": "metric to show",
+                        "name": "metric",
                         "in": "query",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "jetton minter address, required for the amount sum",
+                        "name": "minter_address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "sender address",
+                        "name": "src_owner",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "receiver address",
+                        "name": "dst_owner",
+                        "in": "query"
+                    },
                     {
                         "enum": [
-                            "amount",
-                            "count"
+                            "success",
+                            "bounced"
                         ],
                         "type": "string",
-                        "default": "amount",
-                        "description": "order aggregated by amount or message count",
-                        "name": "order_by",
-                        "in": "query",
-                        "required": true
+                        "description": "transfer status",
+                        "name": "status",
+                        "in": "query"
                     },
                     {
                         "type": "string",
@@ -637,9 +967,75 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "maximum": 1000000,
+                        "enum": [
+                            "24h",
+                            "8h",
+                            "4h",
+                            "1h",
+                            "15m"
+                        ],
+                        "type": "string",
+                        "description": "group interval",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/history.JettonTransfersRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/labels": {
+            "get": {
+                "description": "Search addresses by label name or category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "label"
+                ],
+                "summary": "address labels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter labels by its name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "filter by categories",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
                         "type": "integer",
-                        "default": 25,
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10000,
+                        "type": "integer",
+                        "default": 3,
                         "description": "limit",
                         "name": "limit",
                         "in": "query"
@@ -649,15 +1045,38 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/aggregate.MessagesRes"
+                            "$ref": "#/definitions/filter.LabelsRes"
                         }
                     }
                 }
             }
         },
-        "/messages/aggregated/history": {
+        "/labels/categories": {
             "get": {
-                "description": "Counts messages or sums amount",
+                "description": "Returns all possible label categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "label"
+                ],
+                "summary": "address label categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.GetLabelCategoriesRes"
+                        }
+                    }
+                }
+            }
+        },
+        "/messages": {
+            "get": {
+                "description": "Returns filtered messages",
                 "consumes": [
                     "application/json"
                 ],
@@ -667,18 +1086,25 @@ const docTemplate = `{
                 "tags": [
                     "transaction"
                 ],
-                "summary": "aggregated messages grouped by timestamp",
+                "summary": "transaction messages",
                 "parameters": [
                     {
-                        "enum": [
-                            "message_count",
-                            "message_amount_sum"
-                        ],
                         "type": "string",
-                        "description": "metric to show",
-                        "name": "metric",
-                        "in": "query",
-                        "required": true
+                        "description": "msg hash",
+                        "name": "hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter by source workchain",
+                        "name": "src_workchain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter by destination workchain",
+                        "name": "dst_workchain",
+                        "in": "query"
                     },
                     {
                         "type": "array",
@@ -699,15 +1125,9 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "source workchain",
-                        "name": "src_workchain",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "destination workchain",
-                        "name": "dst_workchain",
+                        "type": "string",
+                        "description": "operation id in hex format or as int32",
+                        "name": "operation_id",
                         "in": "query"
                     },
                     {
@@ -733,56 +1153,56 @@ const docTemplate = `{
                         "items": {
                             "type": "string"
                         },
-                        "description": "contract operation names",
+                        "description": "filter by contract operation names",
                         "name": "operation_name",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
                         "type": "string",
-                        "description": "filter FT or NFT operations by minter address",
-                        "name": "minter_address",
+                        "default": "DESC",
+                        "description": "order by created_lt",
+                        "name": "order",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "from timestamp",
-                        "name": "from",
+                        "type": "integer",
+                        "description": "start from this created_lt",
+                        "name": "after",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "to timestamp",
-                        "name": "to",
+                        "maximum": 10000,
+                        "type": "integer",
+                        "default": 3,
+                        "description": "limit",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "24h",
-                            "8h",
-                            "4h",
-                            "1h",
-                            "15m"
-                        ],
-                        "type": "string",
-                        "description": "group interval",
-                        "name": "interval",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "default": false,
+                        "description": "count total number of rows",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/history.MessagesRes"
+                            "$ref": "#/definitions/filter.MessagesRes"
                         }
                     }
                 }
             }
         },
-        "/statistics": {
+        "/messages/aggregated": {
             "get": {
-                "description": "Returns statistics on blocks, transactions, messages and accounts",
+                "description": "Aggregates receivers and senders",
                 "consumes": [
                     "application/json"
                 ],
@@ -790,22 +1210,63 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "statistics"
+                    "transaction"
+                ],
+                "summary": "aggregated messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "address to aggregate by",
+                        "name": "address",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "amount",
+                            "count"
+                        ],
+                        "type": "string",
+                        "default": "amount",
+                        "description": "order aggregated by amount or message count",
+                        "name": "order_by",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "from timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "to timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000000,
+                        "type": "integer",
+                        "default": 25,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "statistics on all tables",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/aggregate.Statistics"
+                            "$ref": "#/definitions/aggregate.MessagesRes"
                         }
                     }
                 }
             }
         },
-        "/transactions": {
+        "/messages/aggregated/history": {
             "get": {
-                "description": "Returns transactions, states and messages",
+                "description": "Counts messages or sums amount",
                 "consumes": [
                     "application/json"
                 ],
@@ -815,87 +1276,122 @@ const docTemplate = `{
                 "tags": [
                     "transaction"
                 ],
-                "summary": "transactions data",
+                "summary": "aggregated messages grouped by timestamp",
                 "parameters": [
+                    {
+                        "enum": [
+                            "message_count",
+                            "message_amount_sum"
+                        ],
+                        "type": "string",
+                        "description": "metric to show",
+                        "name": "metric",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "type": "array",
                         "items": {
                             "type": "string"
                         },
-                        "description": "only given addresses",
-                        "name": "address",
+                        "description": "source address",
+                        "name": "src_address",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "search by tx hash",
-                        "name": "hash",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "destination address",
+                        "name": "dst_address",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "search by incoming message hash",
-                        "name": "in_msg_hash",
+                        "type": "integer",
+                        "description": "source workchain",
+                        "name": "src_workchain",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "filter by workchain",
-                        "name": "workchain",
+                        "description": "destination workchain",
+                        "name": "dst_workchain",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "search by created_lt",
-                        "name": "created_lt",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "source contract interface",
+                        "name": "src_contract",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "ASC",
-                            "DESC"
-                        ],
-                        "type": "string",
-                        "default": "DESC",
-                        "description": "order by created_lt",
-                        "name": "order",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "destination contract interface",
+                        "name": "dst_contract",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "start from this created_lt",
-                        "name": "after",
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "contract operation names",
+                        "name": "operation_name",
                         "in": "query"
                     },
                     {
-                        "maximum": 10000,
-                        "type": "integer",
-                        "default": 3,
-                        "description": "limit",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "filter FT or NFT operations by minter address",
+                        "name": "minter_address",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "count total number of rows",
-                        "name": "count",
+                        "type": "string",
+                        "description": "from timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "to timestamp",
+                        "name": "to",
                         "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "24h",
+                            "8h",
+                            "4h",
+                            "1h",
+                            "15m"
+                        ],
+                        "type": "string",
+                        "description": "group interval",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/filter.TransactionsRes"
+                            "$ref": "#/definitions/history.MessagesRes"
                         }
                     }
                 }
             }
         },
-        "/transactions/aggregated/history": {
+        "/metadata": {
             "get": {
-                "description": "Counts transactions",
+                "description": "Returns fetched metadata document referenced by TEP-64 content URI",
                 "consumes": [
                     "application/json"
                 ],
@@ -903,34 +1399,72 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transaction"
+                    "account"
                 ],
-                "summary": "aggregated transactions grouped by timestamp",
+                "summary": "off-chain token metadata",
                 "parameters": [
                     {
-                        "enum": [
-                            "transaction_count"
-                        ],
                         "type": "string",
-                        "description": "metric to show",
-                        "name": "metric",
+                        "description": "content URI",
+                        "name": "uri",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "description": "tx address",
-                        "name": "address",
+                        "type": "string",
+                        "description": "sha256 of the document in hex or base64 (the latest document by default)",
+                        "name": "content_hash",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/core.TokenMetadata"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/pools/{address}/candles": {
+            "get": {
+                "description": "Returns OHLCV candles of DEX pool swaps.\nPrice is the amount of the first asset of the pair paid for one unit of the second asset, where assets are ordered by address and native TON goes first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "pool price candles",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "description": "filter by workchain",
-                        "name": "workchain",
-                        "in": "query"
+                        "type": "string",
+                        "description": "pool address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "string",
@@ -950,10 +1484,11 @@ const docTemplate = `{
                             "8h",
                             "4h",
                             "1h",
-                            "15m"
+                            "15m",
+                            "5m"
                         ],
                         "type": "string",
-                        "description": "group interval",
+                        "description": "candle interval",
                         "name": "interval",
                         "in": "query",
                         "required": true
@@ -963,171 +1498,1313 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/history.TransactionsRes"
+                            "$ref": "#/definitions/history.SwapCandlesRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
                         }
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "abi.GetMethodDesc": {
-            "type": "object",
-            "properties": {
-                "arguments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/abi.VmValueDesc"
-                    }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "return_values": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/abi.VmValueDesc"
                     }
                 }
             }
         },
-        "abi.GetMethodExecution": {
-            "type": "object",
-            "properties": {
-                "address": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "arguments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/abi.VmValueDesc"
-                    }
-                },
+        "/pools/{address}/history": {
+            "get": {
+                "description": "Returns reserves, LP supply and TVL in nanoTON of DEX liquidity pool over time.\nIf none of the pool assets is TON, TVL is priced through the TON-paired pool of one of the assets with the largest TON reserve.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "pool reserves history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pool address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "from timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "to timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "24h",
+                            "8h",
+                            "4h",
+                            "1h",
+                            "15m",
+                            "5m"
+                        ],
+                        "type": "string",
+                        "description": "interval",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/history.PoolRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/rescan/tasks": {
+            "get": {
+                "description": "Returns unfinished rescan tasks in the order they are run, with their progress and estimated time of finishing.\nProgress is the share of rows matched on the task start, which are already scanned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "contract"
+                ],
+                "summary": "rescan tasks",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "return finished and cancelled tasks too",
+                        "name": "all",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/http.GetRescanTasksRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/statistics": {
+            "get": {
+                "description": "Returns statistics on blocks, transactions, messages and accounts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "statistics"
+                ],
+                "summary": "statistics on all tables",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/aggregate.Statistics"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/messages": {
+            "get": {
+                "description": "Streams new parsed messages as server-sent events (` + "`" + `message` + "`" + ` event type) right after they are committed to the database",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "messages stream",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "msg hash",
+                        "name": "hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "source address",
+                        "name": "src_address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "destination address",
+                        "name": "dst_address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "source workchain",
+                        "name": "src_workchain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "destination workchain",
+                        "name": "dst_workchain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "source contract interface",
+                        "name": "src_contract",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "destination contract interface",
+                        "name": "dst_contract",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "operation id in hex or decimal",
+                        "name": "operation_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "filter by contract operation names",
+                        "name": "operation_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/core.Message"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream/transactions": {
+            "get": {
+                "description": "Streams new transactions as server-sent events (` + "`" + `transaction` + "`" + ` event type) right after they are committed to the database",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "transactions stream",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "only given addresses",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by tx hash",
+                        "name": "hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by incoming message hash",
+                        "name": "in_msg_hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter by workchain",
+                        "name": "workchain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/core.Transaction"
+                        }
+                    }
+                }
+            }
+        },
+        "/swaps": {
+            "get": {
+                "description": "Returns swaps executed by DeDust and STON.fi pools.\nEmpty asset address means native TON, jettons are identified by minter addresses. Swaps of fake pools are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "dex swaps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "search by pool transaction hash",
+                        "name": "tx_hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by trace id",
+                        "name": "trace_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "dedust_v2",
+                            "stonfi"
+                        ],
+                        "type": "string",
+                        "description": "dex name",
+                        "name": "dex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "pool address",
+                        "name": "pool_address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "trader address",
+                        "name": "trader",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "sold or bought asset",
+                        "name": "asset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "order by created_lt",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "start from this created_lt",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10000,
+                        "type": "integer",
+                        "default": 3,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "count total number of rows",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/filter.SwapsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/traces/{id}": {
+            "get": {
+                "description": "Returns the tree of transactions caused by the root transaction, for example, by the external message.\nTrace id is the hash of the root transaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "transaction trace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "trace id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/app.Trace"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/gin.H"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "description": "Returns transactions, states and messages",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "transactions data",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "only given addresses",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by tx hash",
+                        "name": "hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by incoming message hash",
+                        "name": "in_msg_hash",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "search by trace id",
+                        "name": "trace_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter by workchain",
+                        "name": "workchain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "search by created_lt",
+                        "name": "created_lt",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ASC",
+                            "DESC"
+                        ],
+                        "type": "string",
+                        "default": "DESC",
+                        "description": "order by created_lt",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "start from this created_lt",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 10000,
+                        "type": "integer",
+                        "default": 3,
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "count total number of rows",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/filter.TransactionsRes"
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/aggregated/history": {
+            "get": {
+                "description": "Counts transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transaction"
+                ],
+                "summary": "aggregated transactions grouped by timestamp",
+                "parameters": [
+                    {
+                        "enum": [
+                            "transaction_count"
+                        ],
+                        "type": "string",
+                        "description": "metric to show",
+                        "name": "metric",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "description": "tx address",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "filter by workchain",
+                        "name": "workchain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "from timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "to timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "24h",
+                            "8h",
+                            "4h",
+                            "1h",
+                            "15m"
+                        ],
+                        "type": "string",
+                        "description": "group interval",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/history.TransactionsRes"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "abi.AccountColumn": {
+            "type": "string",
+            "enum": [
+                "owner_address",
+                "minter_address",
+                "token_balance",
+                "content",
+                "token_supply",
+                "asset0_address",
+                "asset1_address",
+                "reserve0",
+                "reserve1"
+            ],
+            "x-enum-comments": {
+                "ColumnContent": "TEP-64 token data is mapped into content_* columns"
+            },
+            "x-enum-varnames": [
+                "ColumnOwnerAddress",
+                "ColumnMinterAddress",
+                "ColumnTokenBalance",
+                "ColumnContent",
+                "ColumnTokenSupply",
+                "ColumnAsset0Address",
+                "ColumnAsset1Address",
+                "ColumnReserve0",
+                "ColumnReserve1"
+            ]
+        },
+        "abi.GetMethodDesc": {
+            "type": "object",
+            "properties": {
+                "arguments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.VmValueDesc"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "return_values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.VmValueDesc"
+                    }
+                }
+            }
+        },
+        "abi.GetMethodExecution": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "arguments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.VmValueDesc"
+                    }
+                },
                 "error": {
                     "type": "string"
                 },
-                "name": {
+                "name": {
+                    "type": "string"
+                },
+                "receives": {
+                    "type": "array",
+                    "items": {}
+                },
+                "return_values": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.VmValueDesc"
+                    }
+                },
+                "returns": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "abi.GetMethodReturnRef": {
+            "type": "object",
+            "properties": {
+                "get_method": {
+                    "type": "string"
+                },
+                "return_value": {
+                    "type": "string"
+                }
+            }
+        },
+        "abi.InterfaceDesc": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "code_boc": {
+                    "type": "string"
+                },
+                "contract_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.TLBFieldDesc"
+                    }
+                },
+                "definitions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/abi.TLBFieldDesc"
+                        }
+                    }
+                },
+                "get_methods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.GetMethodDesc"
+                    }
+                },
+                "in_messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.OperationDesc"
+                    }
+                },
+                "interface_name": {
+                    "type": "string"
+                },
+                "out_messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.OperationDesc"
+                    }
+                },
+                "verification": {
+                    "$ref": "#/definitions/abi.VerificationDesc"
+                }
+            }
+        },
+        "abi.OperationDesc": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.TLBFieldDesc"
+                    }
+                },
+                "op_code": {
+                    "type": "string"
+                },
+                "op_name": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "abi.StackType": {
+            "type": "string",
+            "enum": [
+                "int",
+                "cell",
+                "slice",
+                "tuple"
+            ],
+            "x-enum-varnames": [
+                "VmInt",
+                "VmCell",
+                "VmSlice",
+                "VmTuple"
+            ]
+        },
+        "abi.TLBFieldDesc": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "$ref": "#/definitions/abi.TLBType"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                },
+                "struct_fields": {
+                    "description": "Format = \"struct\"",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/abi.TLBFieldsDesc"
+                        }
+                    ]
+                },
+                "tlb_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "abi.TLBFieldsDesc": {
+            "type": "array",
+            "items": {
+                "$ref": "#/definitions/abi.TLBFieldDesc"
+            }
+        },
+        "abi.TLBType": {
+            "type": "string",
+            "enum": [
+                "array",
+                "list",
+                "addr",
+                "bool",
+                "bigInt",
+                "string",
+                "bytes",
+                "cell",
+                "slice",
+                "content",
+                "struct",
+                "tag"
+            ],
+            "x-enum-varnames": [
+                "TupleArray",
+                "TupleList",
+                "TLBAddr",
+                "TLBBool",
+                "TLBBigInt",
+                "TLBString",
+                "TLBBytes",
+                "TLBCell",
+                "TLBSlice",
+                "TLBContentCell",
+                "TLBStructCell",
+                "TLBTag"
+            ]
+        },
+        "abi.VerificationDesc": {
+            "type": "object",
+            "properties": {
+                "arguments": {
+                    "description": "Arguments of the parent get-method taken from the contract get-method returns.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.GetMethodReturnRef"
+                    }
+                },
+                "get_method": {
+                    "description": "GetMethod is the name of the parent get-method calculating the contract address.",
+                    "type": "string"
+                },
+                "parent_address": {
+                    "description": "ParentAddress points to the contract get-method return value with the parent address.\nIf it is not set, the address is taken from the parent interface description, which must have the only address.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/abi.GetMethodReturnRef"
+                        }
+                    ]
+                },
+                "parent_interface": {
+                    "description": "ParentInterface is the contract interface of the parent, which has the verification get-method.",
+                    "type": "string"
+                },
+                "return_value": {
+                    "description": "ReturnValue is the name of the parent get-method return value, which must be equal to the contract address.",
+                    "type": "string"
+                }
+            }
+        },
+        "abi.VmValueDesc": {
+            "type": "object",
+            "properties": {
+                "column": {
+                    "description": "account state column filled with the return value",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/abi.AccountColumn"
+                        }
+                    ]
+                },
+                "format": {
+                    "$ref": "#/definitions/abi.TLBType"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stack_type": {
+                    "$ref": "#/definitions/abi.StackType"
+                },
+                "struct_fields": {
+                    "description": "Format = \"struct\"",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.TLBFieldDesc"
+                    }
+                },
+                "tuple_elements": {
+                    "description": "StackType = \"tuple\"",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.VmValueDesc"
+                    }
+                }
+            }
+        },
+        "aggregate.AccountsRes": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "NFT minter",
+                    "type": "integer"
+                },
+                "owned_balance": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "balance": {
+                                "$ref": "#/definitions/bunbig.Int"
+                            },
+                            "owner_address": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            },
+                            "wallet_address": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            }
+                        }
+                    }
+                },
+                "owned_items": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "items_count": {
+                                "type": "integer"
+                            },
+                            "owner_address": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            }
+                        }
+                    }
+                },
+                "owned_jetton_wallets": {
+                    "type": "integer"
+                },
+                "owned_nft_collections": {
+                    "type": "integer"
+                },
+                "owned_nft_items": {
+                    "type": "integer"
+                },
+                "owners_count": {
+                    "type": "integer"
+                },
+                "total_supply": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "transactions_count": {
+                    "description": "Address statistics",
+                    "type": "integer"
+                },
+                "unique_owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "item_address": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            },
+                            "owners_count": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                },
+                "wallets": {
+                    "description": "FT minter",
+                    "type": "integer"
+                }
+            }
+        },
+        "aggregate.AddressStatusCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "aggregate.AddressTypesCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "interfaces": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "aggregate.Holder": {
+            "type": "object",
+            "properties": {
+                "balance": {
                     "type": "string"
                 },
-                "receives": {
+                "owner_address": {
+                    "type": "string"
+                },
+                "wallet_address": {
+                    "type": "string"
+                }
+            }
+        },
+        "aggregate.HoldersRes": {
+            "type": "object",
+            "properties": {
+                "holders": {
                     "type": "array",
-                    "items": {}
+                    "items": {
+                        "$ref": "#/definitions/aggregate.Holder"
+                    }
                 },
-                "return_values": {
+                "master_seq_no": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_supply": {
+                    "type": "string"
+                }
+            }
+        },
+        "aggregate.MessagesRes": {
+            "type": "object",
+            "properties": {
+                "received_count": {
+                    "type": "integer"
+                },
+                "received_from_address": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/abi.VmValueDesc"
+                        "type": "object",
+                        "properties": {
+                            "amount": {
+                                "$ref": "#/definitions/bunbig.Int"
+                            },
+                            "count": {
+                                "type": "integer"
+                            },
+                            "sender": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            }
+                        }
                     }
                 },
-                "returns": {
+                "received_ton_amount": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "sent_count": {
+                    "type": "integer"
+                },
+                "sent_to_address": {
                     "type": "array",
-                    "items": {}
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "amount": {
+                                "$ref": "#/definitions/bunbig.Int"
+                            },
+                            "count": {
+                                "type": "integer"
+                            },
+                            "receiver": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            }
+                        }
+                    }
+                },
+                "sent_ton_amount": {
+                    "$ref": "#/definitions/bunbig.Int"
                 }
             }
         },
-        "abi.OperationDesc": {
+        "aggregate.Statistics": {
             "type": "object",
             "properties": {
-                "body": {
+                "account_count": {
+                    "type": "integer"
+                },
+                "address_count": {
+                    "type": "integer"
+                },
+                "address_count_by_interfaces": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/abi.TLBFieldDesc"
+                        "$ref": "#/definitions/aggregate.AddressTypesCount"
                     }
                 },
-                "op_code": {
-                    "type": "string"
+                "address_count_by_status": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/aggregate.AddressStatusCount"
+                    }
                 },
-                "op_name": {
-                    "type": "string"
+                "contract_interface_count": {
+                    "type": "integer"
                 },
-                "type": {
+                "contract_operation_count": {
+                    "type": "integer"
+                },
+                "first_masterchain_block": {
+                    "type": "integer"
+                },
+                "last_masterchain_block": {
+                    "type": "integer"
+                },
+                "masterchain_block_count": {
+                    "type": "integer"
+                },
+                "message_count": {
+                    "type": "integer"
+                },
+                "message_count_by_operation": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "count": {
+                                "type": "integer"
+                            },
+                            "operation": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "parsed_account_count": {
+                    "type": "integer"
+                },
+                "parsed_address_count": {
+                    "type": "integer"
+                },
+                "parsed_message_count": {
+                    "type": "integer"
+                },
+                "transaction_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "app.AccountStateChange": {
+            "type": "object",
+            "properties": {
+                "after": {
+                    "type": "object"
+                },
+                "before": {
+                    "type": "object"
+                },
+                "field": {
                     "type": "string"
                 }
             }
         },
-        "abi.StackType": {
-            "type": "string",
-            "enum": [
-                "int",
-                "cell",
-                "slice"
-            ],
-            "x-enum-varnames": [
-                "VmInt",
-                "VmCell",
-                "VmSlice"
-            ]
+        "app.ContractChangeRes": {
+            "type": "object",
+            "properties": {
+                "rescan_tasks": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
         },
-        "abi.TLBFieldDesc": {
+        "app.EmulateMessageReq": {
             "type": "object",
             "properties": {
-                "format": {
-                    "$ref": "#/definitions/abi.TLBType"
+                "ignore_signature": {
+                    "type": "boolean"
                 },
-                "name": {
+                "last_tx_lt": {
+                    "description": "LastTxLT chooses the account state, the latest state is used by default.",
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "Message is BoC of the internal or external incoming message.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "app.EmulateMessageRes": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
-                "optional": {
+                "exit_code": {
+                    "type": "integer"
+                },
+                "gas_used": {
+                    "type": "integer"
+                },
+                "state_diff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/app.AccountStateChange"
+                    }
+                },
+                "success": {
+                    "description": "Success is false if the external message was not accepted by the account.",
                     "type": "boolean"
                 },
-                "struct_fields": {
-                    "description": "Format = \"struct\"",
+                "transaction": {
+                    "description": "Transaction is the emulated transaction with decoded messages and the resulting account state.",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/abi.TLBFieldsDesc"
+                            "$ref": "#/definitions/core.Transaction"
                         }
                     ]
                 },
-                "tlb_type": {
+                "vm_log": {
                     "type": "string"
                 }
             }
         },
-        "abi.TLBFieldsDesc": {
-            "type": "array",
-            "items": {
-                "$ref": "#/definitions/abi.TLBFieldDesc"
-            }
-        },
-        "abi.TLBType": {
-            "type": "string",
-            "enum": [
-                "addr",
-                "bool",
-                "bigInt",
-                "string",
-                "bytes",
-                "cell",
-                "slice",
-                "content",
-                "struct",
-                "tag"
-            ],
-            "x-enum-varnames": [
-                "TLBAddr",
-                "TLBBool",
-                "TLBBigInt",
-                "TLBString",
-                "TLBBytes",
-                "TLBCell",
-                "TLBSlice",
-                "TLBContentCell",
-                "TLBStructCell",
-                "TLBTag"
-            ]
-        },
-        "abi.VmValueDesc": {
+        "app.GetMethodArgument": {
             "type": "object",
             "properties": {
+                "column": {
+                    "description": "account state column filled with the return value",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/abi.AccountColumn"
+                        }
+                    ]
+                },
                 "format": {
                     "$ref": "#/definitions/abi.TLBType"
                 },
                 "name": {
                     "type": "string"
                 },
+                "payload": {
+                    "type": "object"
+                },
                 "stack_type": {
                     "$ref": "#/definitions/abi.StackType"
                 },
@@ -1137,265 +2814,360 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/abi.TLBFieldDesc"
                     }
+                },
+                "tuple_elements": {
+                    "description": "StackType = \"tuple\"",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.VmValueDesc"
+                    }
                 }
             }
         },
-        "aggregate.AccountsRes": {
+        "app.GetMethodReq": {
             "type": "object",
             "properties": {
-                "items": {
-                    "description": "NFT minter",
+                "arguments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/app.GetMethodArgument"
+                    }
+                },
+                "interface": {
+                    "description": "Interface is the name of the contract interface describing the get-method.\nWithout it, get-method is executed with the ad-hoc typed arguments\nand the stack is decoded with the given return values\nor returned raw if they are omitted.",
+                    "type": "string"
+                },
+                "last_tx_lt": {
+                    "description": "LastTxLT chooses the account state, the latest state is used by default.",
                     "type": "integer"
                 },
-                "owned_balance": {
+                "return_values": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "balance": {
-                                "$ref": "#/definitions/bunbig.Int"
-                            },
-                            "owner_address": {
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            },
-                            "wallet_address": {
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            }
-                        }
+                        "$ref": "#/definitions/abi.VmValueDesc"
                     }
-                },
-                "owned_items": {
+                }
+            }
+        },
+        "app.Trace": {
+            "type": "object",
+            "properties": {
+                "id": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "items_count": {
-                                "type": "integer"
-                            },
-                            "owner_address": {
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            }
-                        }
+                        "type": "integer"
                     }
                 },
-                "owned_jetton_wallets": {
-                    "type": "integer"
+                "incomplete": {
+                    "description": "Incomplete is set if some internal messages of the trace have not been received yet.",
+                    "type": "boolean"
                 },
-                "owned_nft_collections": {
-                    "type": "integer"
+                "root": {
+                    "$ref": "#/definitions/app.TraceNode"
                 },
-                "owned_nft_items": {
+                "transactions_count": {
                     "type": "integer"
+                }
+            }
+        },
+        "app.TraceNode": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/app.TraceNode"
+                    }
                 },
-                "owners_count": {
-                    "type": "integer"
+                "transaction": {
+                    "$ref": "#/definitions/core.Transaction"
+                }
+            }
+        },
+        "bunbig.Int": {
+            "type": "object"
+        },
+        "core.AccountState": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "total_supply": {
+                "asset0_address": {
+                    "description": "liquidity pool assets and reserves",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "asset1_address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "balance": {
                     "$ref": "#/definitions/bunbig.Int"
                 },
-                "transactions_count": {
-                    "description": "Address statistics",
+                "block_seq_no": {
                     "type": "integer"
                 },
-                "unique_owners": {
+                "code": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "item_address": {
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            },
-                            "owners_count": {
-                                "type": "integer"
-                            }
+                        "type": "integer"
+                    }
+                },
+                "code_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "content_decimals": {
+                    "type": "integer"
+                },
+                "content_description": {
+                    "type": "string"
+                },
+                "content_image": {
+                    "type": "string"
+                },
+                "content_image_data": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "content_name": {
+                    "type": "string"
+                },
+                "content_symbol": {
+                    "type": "string"
+                },
+                "content_uri": {
+                    "type": "string"
+                },
+                "contract_data": {
+                    "description": "ContractData is the persistent data of the account decoded with the contract_data schemas of matched interfaces.",
+                    "type": "object"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "data_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "executed_get_methods": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/abi.GetMethodExecution"
                         }
                     }
                 },
-                "wallets": {
-                    "description": "FT minter",
-                    "type": "integer"
-                }
-            }
-        },
-        "aggregate.AddressStatusCount": {
-            "type": "object",
-            "properties": {
-                "count": {
+                "fake": {
+                    "type": "boolean"
+                },
+                "get_method_hashes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "jetton_balance": {
+                    "description": "fungible token balance, such as jetton wallet balance",
+                    "type": "string"
+                },
+                "label": {
+                    "$ref": "#/definitions/core.AddressLabel"
+                },
+                "last_tx_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "last_tx_lt": {
+                    "type": "integer"
+                },
+                "libraries": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minter_address": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "owner_address": {
+                    "description": "common fields for FT and NFT",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reserve0": {
+                    "type": "string"
+                },
+                "reserve1": {
+                    "type": "string"
+                },
+                "shard": {
                     "type": "integer"
                 },
+                "state_hash": {
+                    "description": "only if account is frozen",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "status": {
+                    "description": "TODO: ch enum",
                     "type": "string"
-                }
-            }
-        },
-        "aggregate.AddressTypesCount": {
-            "type": "object",
-            "properties": {
-                "count": {
-                    "type": "integer"
                 },
-                "interfaces": {
+                "token_metadata": {
+                    "description": "off-chain metadata fetched from content_uri",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/core.TokenMetadata"
+                        }
+                    ]
+                },
+                "token_supply": {
+                    "description": "fungible token total supply, such as jetton minter supply",
+                    "type": "string"
+                },
+                "types": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "workchain": {
+                    "type": "integer"
                 }
             }
         },
-        "aggregate.MessagesRes": {
+        "core.AddressLabel": {
             "type": "object",
             "properties": {
-                "received_count": {
-                    "type": "integer"
-                },
-                "received_from_address": {
+                "address": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "amount": {
-                                "$ref": "#/definitions/bunbig.Int"
-                            },
-                            "count": {
-                                "type": "integer"
-                            },
-                            "sender": {
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            }
-                        }
+                        "type": "integer"
                     }
                 },
-                "received_ton_amount": {
-                    "$ref": "#/definitions/bunbig.Int"
-                },
-                "sent_count": {
-                    "type": "integer"
-                },
-                "sent_to_address": {
+                "categories": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "amount": {
-                                "$ref": "#/definitions/bunbig.Int"
-                            },
-                            "count": {
-                                "type": "integer"
-                            },
-                            "receiver": {
-                                "type": "array",
-                                "items": {
-                                    "type": "integer"
-                                }
-                            }
-                        }
+                        "type": "string"
                     }
                 },
-                "sent_ton_amount": {
-                    "$ref": "#/definitions/bunbig.Int"
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "aggregate.Statistics": {
+        "core.Block": {
             "type": "object",
             "properties": {
-                "account_count": {
-                    "type": "integer"
-                },
-                "address_count": {
-                    "type": "integer"
-                },
-                "address_count_by_interfaces": {
+                "accounts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/aggregate.AddressTypesCount"
+                        "$ref": "#/definitions/core.AccountState"
                     }
                 },
-                "address_count_by_status": {
+                "file_hash": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/aggregate.AddressStatusCount"
+                        "type": "integer"
                     }
                 },
-                "contract_interface_count": {
-                    "type": "integer"
+                "master": {
+                    "$ref": "#/definitions/core.BlockID"
                 },
-                "contract_operation_count": {
-                    "type": "integer"
+                "root_hash": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "first_masterchain_block": {
-                    "type": "integer"
+                "scanned_at": {
+                    "type": "string"
                 },
-                "last_masterchain_block": {
+                "seq_no": {
                     "type": "integer"
                 },
-                "masterchain_block_count": {
+                "shard": {
                     "type": "integer"
                 },
-                "message_count": {
-                    "type": "integer"
+                "shards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.Block"
+                    }
                 },
-                "message_count_by_operation": {
+                "transactions": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "count": {
-                                "type": "integer"
-                            },
-                            "operation": {
-                                "type": "string"
-                            }
-                        }
+                        "$ref": "#/definitions/core.Transaction"
                     }
                 },
-                "parsed_account_count": {
+                "transactions_count": {
                     "type": "integer"
                 },
-                "parsed_address_count": {
+                "workchain": {
+                    "type": "integer"
+                }
+            }
+        },
+        "core.BlockID": {
+            "type": "object",
+            "properties": {
+                "seq_no": {
                     "type": "integer"
                 },
-                "parsed_message_count": {
+                "shard": {
                     "type": "integer"
                 },
-                "transaction_count": {
+                "workchain": {
                     "type": "integer"
                 }
             }
         },
-        "bunbig.Int": {
-            "type": "object"
-        },
-        "core.AccountState": {
+        "core.ContractInterface": {
             "type": "object",
             "properties": {
-                "address": {
+                "addresses": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
                     }
                 },
-                "balance": {
-                    "$ref": "#/definitions/bunbig.Int"
-                },
-                "block_seq_no": {
-                    "type": "integer"
-                },
                 "code": {
                     "type": "array",
                     "items": {
@@ -1408,301 +3180,507 @@ const docTemplate = `{
                         "type": "integer"
                     }
                 },
-                "content_description": {
-                    "type": "string"
-                },
-                "content_image": {
-                    "type": "string"
+                "contract_data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/abi.TLBFieldDesc"
+                    }
                 },
-                "content_image_data": {
+                "get_method_hashes": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "content_name": {
-                    "type": "string"
-                },
-                "content_uri": {
-                    "type": "string"
-                },
-                "data": {
+                "get_methods_descriptors": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/abi.GetMethodDesc"
                     }
                 },
-                "data_hash": {
+                "name": {
+                    "type": "string"
+                },
+                "operations": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/core.ContractOperation"
                     }
                 },
-                "executed_get_methods": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/abi.GetMethodExecution"
-                        }
-                    }
+                "verification": {
+                    "$ref": "#/definitions/abi.VerificationDesc"
+                }
+            }
+        },
+        "core.ContractOperation": {
+            "type": "object",
+            "properties": {
+                "contract_name": {
+                    "type": "string"
+                },
+                "message_type": {
+                    "description": "only internal is supported now",
+                    "type": "string"
+                },
+                "operation_id": {
+                    "type": "integer"
+                },
+                "operation_name": {
+                    "type": "string"
+                },
+                "outgoing": {
+                    "description": "if operation is going from contract",
+                    "type": "boolean"
+                },
+                "schema": {
+                    "$ref": "#/definitions/abi.OperationDesc"
+                }
+            }
+        },
+        "core.DEX": {
+            "type": "string",
+            "enum": [
+                "dedust_v2",
+                "stonfi"
+            ],
+            "x-enum-varnames": [
+                "DeDustV2",
+                "StonFi"
+            ]
+        },
+        "core.JettonTransfer": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "block_seq_no": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
                 },
-                "fake": {
-                    "type": "boolean"
+                "created_lt": {
+                    "type": "integer"
                 },
-                "get_method_hashes": {
+                "dst_owner": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "is_active": {
-                    "type": "boolean"
-                },
-                "jetton_balance": {
-                    "type": "string"
-                },
-                "label": {
-                    "$ref": "#/definitions/core.AddressLabel"
+                "dst_tx_lt": {
+                    "type": "integer"
                 },
-                "last_tx_hash": {
+                "dst_wallet": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "last_tx_lt": {
-                    "type": "integer"
-                },
-                "libraries": {
+                "forward_payload": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
+                "forward_ton_amount": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
                 "minter_address": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "owner_address": {
-                    "description": "common fields for FT and NFT",
+                "msg_hash": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
+                "query_id": {
+                    "type": "integer"
+                },
+                "received_at": {
+                    "type": "string"
+                },
                 "shard": {
                     "type": "integer"
                 },
-                "state_hash": {
-                    "description": "only if account is frozen",
+                "src_owner": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "src_wallet": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
                 "status": {
-                    "description": "TODO: ch enum",
-                    "type": "string"
+                    "$ref": "#/definitions/core.JettonTransferStatus"
                 },
-                "types": {
+                "trace_id": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     }
                 },
-                "updated_at": {
-                    "type": "string"
-                },
                 "workchain": {
+                    "description": "Workchain, Shard and BlockSeqNo identify the block of the destination transaction.",
                     "type": "integer"
                 }
             }
         },
-        "core.AddressLabel": {
+        "core.JettonTransferStatus": {
+            "type": "string",
+            "enum": [
+                "success",
+                "bounced"
+            ],
+            "x-enum-varnames": [
+                "JettonTransferSuccess",
+                "JettonTransferBounced"
+            ]
+        },
+        "core.Message": {
             "type": "object",
             "properties": {
-                "address": {
+                "amount": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "body": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "categories": {
+                "body_hash": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     }
                 },
-                "name": {
+                "bounce": {
+                    "type": "boolean"
+                },
+                "bounced": {
+                    "type": "boolean"
+                },
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "core.Block": {
-            "type": "object",
-            "properties": {
-                "accounts": {
+                },
+                "created_lt": {
+                    "type": "integer"
+                },
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/core.AccountState"
+                        "type": "integer"
                     }
                 },
-                "file_hash": {
+                "dst_address": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "master": {
-                    "$ref": "#/definitions/core.BlockID"
+                "dst_block_seq_no": {
+                    "type": "integer"
                 },
-                "root_hash": {
+                "dst_contract": {
+                    "type": "string"
+                },
+                "dst_shard": {
+                    "type": "integer"
+                },
+                "dst_state": {
+                    "$ref": "#/definitions/core.AccountState"
+                },
+                "dst_tx_hash": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "scanned_at": {
-                    "type": "string"
-                },
-                "seq_no": {
+                "dst_tx_lt": {
                     "type": "integer"
                 },
-                "shard": {
+                "dst_workchain": {
                     "type": "integer"
                 },
-                "shards": {
+                "error": {
+                    "type": "string"
+                },
+                "fwd_fee": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "hash": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/core.Block"
+                        "type": "integer"
                     }
                 },
-                "transactions": {
+                "ihr_disabled": {
+                    "type": "boolean"
+                },
+                "ihr_fee": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "operation_id": {
+                    "type": "integer"
+                },
+                "operation_name": {
+                    "type": "string"
+                },
+                "src_address": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/core.Transaction"
+                        "type": "integer"
                     }
                 },
-                "transactions_count": {
+                "src_block_seq_no": {
                     "type": "integer"
                 },
-                "workchain": {
-                    "type": "integer"
-                }
-            }
-        },
-        "core.BlockID": {
-            "type": "object",
-            "properties": {
-                "seq_no": {
-                    "type": "integer"
+                "src_contract": {
+                    "type": "string"
                 },
-                "shard": {
+                "src_shard": {
                     "type": "integer"
                 },
-                "workchain": {
-                    "type": "integer"
-                }
-            }
-        },
-        "core.ContractInterface": {
-            "type": "object",
-            "properties": {
-                "addresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        }
-                    }
+                "src_state": {
+                    "$ref": "#/definitions/core.AccountState"
                 },
-                "code": {
+                "src_tx_hash": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "code_hash": {
+                "src_tx_lt": {
+                    "type": "integer"
+                },
+                "src_workchain": {
+                    "type": "integer"
+                },
+                "state_init_code": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "get_method_hashes": {
+                "state_init_data": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "get_methods_descriptors": {
+                "trace_id": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/abi.GetMethodDesc"
+                        "type": "integer"
                     }
                 },
-                "name": {
+                "transfer_comment": {
                     "type": "string"
                 },
-                "operations": {
+                "type": {
+                    "description": "TODO: ch enum",
+                    "type": "string"
+                }
+            }
+        },
+        "core.RescanScope": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "description": "rescanned contract addresses",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/core.ContractOperation"
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
                     }
+                },
+                "from_master_seq_no": {
+                    "description": "masterchain blocks range, shard blocks are matched by the masterchain block they are committed in",
+                    "type": "integer"
+                },
+                "from_time": {
+                    "description": "account state update time or message creation time",
+                    "type": "string"
+                },
+                "to_master_seq_no": {
+                    "type": "integer"
+                },
+                "to_time": {
+                    "type": "string"
                 }
             }
         },
-        "core.ContractOperation": {
+        "core.RescanTask": {
             "type": "object",
             "properties": {
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "changed_get_methods": {
+                    "description": "for get-method update",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "contract_interface": {
+                    "$ref": "#/definitions/core.ContractInterface"
+                },
                 "contract_name": {
+                    "description": "contract being rescanned",
+                    "type": "string"
+                },
+                "contract_operation": {
+                    "$ref": "#/definitions/core.ContractOperation"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
+                    "description": "Error is the last error occurred while running the task, the task is retried after it",
+                    "type": "string"
+                },
+                "eta": {
                     "type": "string"
                 },
+                "finished": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_address": {
+                    "description": "checkpoint",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "last_tx_lt": {
+                    "type": "integer"
+                },
                 "message_type": {
-                    "description": "only internal is supported now",
+                    "description": "for operations",
                     "type": "string"
                 },
-                "operation_id": {
+                "operation_id": {
+                    "type": "integer"
+                },
+                "outgoing": {
+                    "description": "if operation is going from contract",
+                    "type": "boolean"
+                },
+                "priority": {
+                    "description": "tasks with higher priority are run first, tasks with the same priority are run in the order of creation",
+                    "type": "integer"
+                },
+                "progress": {
+                    "type": "number"
+                },
+                "scanned": {
+                    "description": "progress: Total is the number of rows matched on the task start, Scanned is the number of rows processed",
                     "type": "integer"
                 },
-                "operation_name": {
+                "scope": {
+                    "description": "optional limits of the rescanned rows",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/core.RescanScope"
+                        }
+                    ]
+                },
+                "started_at": {
                     "type": "string"
                 },
-                "outgoing": {
-                    "description": "if operation is going from contract",
-                    "type": "boolean"
+                "status": {
+                    "$ref": "#/definitions/core.RescanTaskStatus"
                 },
-                "schema": {
-                    "$ref": "#/definitions/abi.OperationDesc"
+                "total": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/core.RescanTaskType"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "core.Message": {
+        "core.RescanTaskStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "running",
+                "finished",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "RescanPending",
+                "RescanRunning",
+                "RescanFinished",
+                "RescanCancelled"
+            ]
+        },
+        "core.RescanTaskType": {
+            "type": "string",
+            "enum": [
+                "add_interface",
+                "upd_interface",
+                "del_interface",
+                "add_get_method",
+                "del_get_method",
+                "upd_get_method",
+                "upd_contract_data",
+                "upd_operation",
+                "del_operation"
+            ],
+            "x-enum-varnames": [
+                "AddInterface",
+                "UpdInterface",
+                "DelInterface",
+                "AddGetMethod",
+                "DelGetMethod",
+                "UpdGetMethod",
+                "UpdContractData",
+                "UpdOperation",
+                "DelOperation"
+            ]
+        },
+        "core.Swap": {
             "type": "object",
             "properties": {
-                "amount": {
+                "amount_in": {
                     "$ref": "#/definitions/bunbig.Int"
                 },
-                "body": {
+                "amount_out": {
+                    "$ref": "#/definitions/bunbig.Int"
+                },
+                "asset_in": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "body_hash": {
+                "asset_out": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "bounce": {
-                    "type": "boolean"
-                },
-                "bounced": {
-                    "type": "boolean"
+                "block_seq_no": {
+                    "type": "integer"
                 },
                 "created_at": {
                     "type": "string"
@@ -1710,113 +3688,79 @@ const docTemplate = `{
                 "created_lt": {
                     "type": "integer"
                 },
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "dex": {
+                    "$ref": "#/definitions/core.DEX"
                 },
-                "dst_address": {
+                "pool_address": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "dst_block_seq_no": {
-                    "type": "integer"
-                },
-                "dst_contract": {
-                    "type": "string"
+                "price": {
+                    "description": "Price is the amount of the first asset of the pair paid for one unit of the second asset.\nAssets are ordered by address bytes, so native TON always goes first.",
+                    "type": "number"
                 },
-                "dst_shard": {
+                "shard": {
                     "type": "integer"
                 },
-                "dst_state": {
-                    "$ref": "#/definitions/core.AccountState"
-                },
-                "dst_tx_hash": {
+                "trace_id": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "dst_tx_lt": {
-                    "type": "integer"
-                },
-                "dst_workchain": {
-                    "type": "integer"
-                },
-                "error": {
-                    "type": "string"
-                },
-                "fwd_fee": {
-                    "$ref": "#/definitions/bunbig.Int"
-                },
-                "hash": {
+                "trader": {
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "ihr_disabled": {
-                    "type": "boolean"
-                },
-                "ihr_fee": {
-                    "$ref": "#/definitions/bunbig.Int"
-                },
-                "operation_id": {
-                    "type": "integer"
-                },
-                "operation_name": {
-                    "type": "string"
-                },
-                "src_address": {
+                "tx_hash": {
+                    "description": "transaction of the pool",
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "src_block_seq_no": {
-                    "type": "integer"
-                },
-                "src_contract": {
-                    "type": "string"
-                },
-                "src_shard": {
+                "workchain": {
                     "type": "integer"
-                },
-                "src_state": {
-                    "$ref": "#/definitions/core.AccountState"
-                },
-                "src_tx_hash": {
+                }
+            }
+        },
+        "core.TokenMetadata": {
+            "type": "object",
+            "properties": {
+                "content_hash": {
+                    "description": "sha256 of the document",
                     "type": "array",
                     "items": {
                         "type": "integer"
                     }
                 },
-                "src_tx_lt": {
+                "decimals": {
                     "type": "integer"
                 },
-                "src_workchain": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "state_init_code": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "document": {
+                    "type": "object"
                 },
-                "state_init_data": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "fetched_at": {
+                    "type": "string"
                 },
-                "transfer_comment": {
+                "image": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "TODO: ch enum",
+                "name": {
+                    "description": "fields parsed from the document",
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "uri": {
                     "type": "string"
                 }
             }
@@ -1906,6 +3850,13 @@ const docTemplate = `{
                 "total_fees": {
                     "$ref": "#/definitions/bunbig.Int"
                 },
+                "trace_id": {
+                    "description": "TraceID is the hash of the transaction, which started the chain of messages.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "workchain": {
                     "type": "integer"
                 }
@@ -1939,6 +3890,20 @@ const docTemplate = `{
                 }
             }
         },
+        "filter.JettonTransfersRes": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.JettonTransfer"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "filter.LabelsRes": {
             "type": "object",
             "properties": {
@@ -1967,6 +3932,20 @@ const docTemplate = `{
                 }
             }
         },
+        "filter.SwapsRes": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.Swap"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "filter.TransactionsRes": {
             "type": "object",
             "properties": {
@@ -1997,6 +3976,31 @@ const docTemplate = `{
                             }
                         }
                     }
+                },
+                "sum_results": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "timestamp": {
+                                "type": "string"
+                            },
+                            "value": {
+                                "$ref": "#/definitions/bunbig.Int"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "history.JettonTransfersRes": {
+            "type": "object",
+            "properties": {
+                "count_results": {
+                    "type": "object"
+                },
+                "sum_results": {
+                    "type": "object"
                 }
             }
         },
@@ -2033,6 +4037,98 @@ const docTemplate = `{
                 }
             }
         },
+        "history.PoolRes": {
+            "type": "object",
+            "properties": {
+                "asset0": {
+                    "description": "Asset0 and Asset1 are jetton minters of the pool assets, empty for TON.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "asset1": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "pricing_pool": {
+                    "description": "PricingPool is TON-paired pool used to price the pool asset, if none of the pool assets is TON.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/history.PoolState"
+                    }
+                }
+            }
+        },
+        "history.PoolState": {
+            "type": "object",
+            "properties": {
+                "lp_supply": {
+                    "type": "string"
+                },
+                "reserve0": {
+                    "type": "string"
+                },
+                "reserve1": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "tvl": {
+                    "description": "in nanoTON",
+                    "type": "string"
+                }
+            }
+        },
+        "history.SwapCandle": {
+            "type": "object",
+            "properties": {
+                "close": {
+                    "type": "number"
+                },
+                "high": {
+                    "type": "number"
+                },
+                "low": {
+                    "type": "number"
+                },
+                "open": {
+                    "type": "number"
+                },
+                "swap_count": {
+                    "type": "integer"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "volume0": {
+                    "type": "string"
+                },
+                "volume1": {
+                    "type": "string"
+                }
+            }
+        },
+        "history.SwapCandlesRes": {
+            "type": "object",
+            "properties": {
+                "candles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/history.SwapCandle"
+                    }
+                }
+            }
+        },
         "history.TransactionsRes": {
             "type": "object",
             "properties": {
@@ -2110,6 +4206,20 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "http.GetRescanTasksRes": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/core.RescanTask"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
         }
     }
 }`
@@ -1,6 +1,7 @@
 package archive
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -18,6 +19,51 @@ func intToIP4(ipInt int64) string {
 	return b0 + "." + b1 + "." + b2 + "." + b3
 }
 
+// GetArchiveNodes returns liteservers from the global config, which store all blocks.
+// Nodes are returned in "host:port|key" format, which is used in LITESERVERS environment variable.
+func GetArchiveNodes(ctx context.Context, testnet bool) ([]string, error) {
+	var ret []string
+
+	url := "https://ton-blockchain.github.io/global.config.json"
+	if testnet {
+		url = "https://ton-blockchain.github.io/testnet-global.config.json"
+	}
+
+	cfg, err := liteclient.GetConfigFromUrl(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cfg.Liteservers {
+		ls := &cfg.Liteservers[i]
+
+		client := liteclient.NewConnectionPool()
+
+		addr := fmt.Sprintf("%s:%d", intToIP4(ls.IP), ls.Port)
+		if err := client.AddConnection(ctx, addr, ls.ID.Key); err != nil {
+			continue
+		}
+
+		api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
+
+		master, err := api.GetMasterchainInfo(ctx)
+		if err != nil {
+			continue
+		}
+
+		_, err = api.LookupBlock(ctx, master.Workchain, master.Shard, 3)
+		if err != nil {
+			continue
+		}
+
+		log.Info().Str("addr", addr).Str("key", ls.ID.Key).Msg("new archive liteserver")
+
+		ret = append(ret, addr+"|"+ls.ID.Key)
+	}
+
+	return ret, nil
+}
+
 var Command = &cli.Command{
 	Name:  "archive",
 	Usage: "Prints archive nodes found from config",
@@ -32,41 +78,7 @@ var Command = &cli.Command{
 	},
 
 	Action: func(ctx *cli.Context) error {
-		url := "https://ton-blockchain.github.io/global.config.json"
-		if ctx.Bool("testnet") {
-			url = "https://ton-blockchain.github.io/testnet-global.config.json"
-		}
-
-		cfg, err := liteclient.GetConfigFromUrl(ctx.Context, url)
-		if err != nil {
-			return err
-		}
-
-		for i := range cfg.Liteservers {
-			ls := &cfg.Liteservers[i]
-
-			client := liteclient.NewConnectionPool()
-
-			addr := fmt.Sprintf("%s:%d", intToIP4(ls.IP), ls.Port)
-			if err := client.AddConnection(ctx.Context, addr, ls.ID.Key); err != nil {
-				continue
-			}
-
-			api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
-
-			master, err := api.GetMasterchainInfo(ctx.Context)
-			if err != nil {
-				continue
-			}
-
-			_, err = api.LookupBlock(ctx.Context, master.Workchain, master.Shard, 3)
-			if err != nil {
-				continue
-			}
-
-			log.Info().Str("addr", addr).Str("key", ls.ID.Key).Msg("new archive liteserver")
-		}
-
-		return nil
+		_, err := GetArchiveNodes(ctx.Context, ctx.Bool("testnet"))
+		return err
 	},
 }
@@ -1,29 +1,26 @@
 package contract
 
 import (
-	"context"
 	"database/sql"
-	"encoding/base64"
 	"encoding/json"
-	"fmt"
 	"io"
-	"math/big"
 	"os"
-	"reflect"
-	"strconv"
-	"strings"
+	"time"
 
 	"github.com/allisson/go-env"
 	"github.com/pkg/errors"
-	"github.com/rs/zerolog/log"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 	"github.com/urfave/cli/v2"
 
 	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/contract"
 	"github.com/stepandra/anton/internal/core"
-	"github.com/stepandra/anton/internal/core/repository/contract"
+	"github.com/stepandra/anton/internal/core/repository"
+	contractRepository "github.com/stepandra/anton/internal/core/repository/contract"
 	"github.com/stepandra/anton/internal/core/repository/rescan"
 )
 
@@ -76,249 +73,67 @@ func readFiles(filenames []string) (ret []*abi.InterfaceDesc, err error) {
 	return
 }
 
-func ParseOperationDesc(t abi.ContractName, d *abi.OperationDesc) (*core.ContractOperation, error) {
-	var opId uint32
-
-	if c := d.Code; strings.HasPrefix(c, "0x") {
-		n := new(big.Int)
-		_, ok := n.SetString(c[2:], 16)
-		if !ok {
-			return nil, fmt.Errorf("wrong hex %s operation id format: %s", d.Name, d.Code)
-		}
-		opId = uint32(n.Uint64())
-	} else {
-		n, err := strconv.ParseUint(c, 10, 32)
-		if err != nil {
-			return nil, errors.Wrapf(err, "parse %s operation id", d.Name)
-		}
-		opId = uint32(n)
-	}
-
-	// this is needed to map interface definitions into schema
-	x, err := d.New()
-	if err != nil {
-		return nil, errors.Wrapf(err, "creating new operation structure")
-	}
-	_, err = abi.NewOperationDesc(x)
-	if err != nil {
-		return nil, errors.Wrapf(err, "creating new operation descriptor")
-	}
-
-	if d.Type == "" {
-		d.Type = string(core.Internal)
-	}
-
-	return &core.ContractOperation{
-		OperationName: d.Name,
-		ContractName:  t,
-		MessageType:   core.MessageType(strings.ToUpper(d.Type)),
-		Outgoing:      false,
-		OperationID:   opId,
-		Schema:        *d,
-	}, nil
-}
-
-func ParseInterfaceDesc(d *abi.InterfaceDesc) (*core.ContractInterface, []*core.ContractOperation, error) {
-	var operations []*core.ContractOperation
-
-	code, err := base64.StdEncoding.DecodeString(d.CodeBoc)
-	if err != nil {
-		return nil, nil, errors.Wrapf(err, "decode code boc from base64")
-	}
-
-	i := core.ContractInterface{
-		Name:           d.Name,
-		Addresses:      d.Addresses,
-		Code:           code,
-		GetMethodsDesc: d.GetMethods,
-	}
-	for it := range i.GetMethodsDesc {
-		i.GetMethodHashes = append(i.GetMethodHashes, abi.MethodNameHash(i.GetMethodsDesc[it].Name))
-	}
-	if len(i.Code) == 0 {
-		i.Code = nil
-	}
-
-	for it := range d.InMessages {
-		op, err := ParseOperationDesc(i.Name, &d.InMessages[it])
-		if err != nil {
-			return nil, nil, err
-		}
-		op.Outgoing = false
-		operations = append(operations, op)
+// ReadInterfacesDesc reads contract interfaces from stdin if the stdin flag is set,
+// or from json files given in arguments.
+func ReadInterfacesDesc(ctx *cli.Context) ([]*abi.InterfaceDesc, error) {
+	if ctx.Bool("stdin") {
+		return readStdin()
 	}
 
-	for it := range d.OutMessages {
-		op, err := ParseOperationDesc(i.Name, &d.OutMessages[it])
-		if err != nil {
-			return nil, nil, err
-		}
-		op.Outgoing = true
-		operations = append(operations, op)
+	filenames := ctx.Args().Slice()
+	if len(filenames) == 0 {
+		cli.ShowSubcommandHelpAndExit(ctx, 1)
 	}
-
-	i.Operations = operations
-
-	return &i, operations, nil
+	return readFiles(filenames)
 }
 
-func ParseInterfacesDesc(descriptors []*abi.InterfaceDesc) (retD map[abi.TLBType]abi.TLBFieldsDesc, retI []*core.ContractInterface, retOp []*core.ContractOperation, _ error) {
-	retD = map[abi.TLBType]abi.TLBFieldsDesc{}
-	for _, desc := range descriptors {
-		err := abi.RegisterDefinitions(desc.Definitions)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		for dn, d := range desc.Definitions {
-			retD[dn] = d
-		}
-	}
-	for _, desc := range descriptors {
-		i, operations, err := ParseInterfaceDesc(desc)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		retI = append(retI, i)
-		retOp = append(retOp, operations...)
+func parseTime(ctx *cli.Context, name string) (time.Time, error) {
+	v := ctx.String(name)
+	if v == "" {
+		return time.Time{}, nil
 	}
-	return
-}
-
-func diffDefinitions(ctx context.Context, contractRepo core.ContractRepository, current map[abi.TLBType]abi.TLBFieldsDesc) (added, changed map[abi.TLBType]abi.TLBFieldsDesc, err error) {
-	old, err := contractRepo.GetDefinitions(ctx)
+	t, err := time.Parse(time.RFC3339, v)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "get definitions")
+		return time.Time{}, errors.Wrapf(err, "parse %s", name)
 	}
-
-	added, changed = map[abi.TLBType]abi.TLBFieldsDesc{}, map[abi.TLBType]abi.TLBFieldsDesc{}
-	for dt, d := range current {
-		od, ok := old[dt]
-		if !ok {
-			added[dt] = d
-			continue
-		}
-		if !reflect.DeepEqual(od, d) {
-			changed[dt] = d
-		}
-	}
-
-	return added, changed, nil
+	return t.UTC(), nil
 }
 
-func diffSlices[V any](oldS, newS []V, getName func(v V) string) (added, changed, deleted []V) {
-	oldM, newM := map[string]V{}, map[string]V{}
-	for _, v := range oldS {
-		oldM[getName(v)] = v
-	}
-	for _, v := range newS {
-		newM[getName(v)] = v
+func parseScope(ctx *cli.Context) (scope *core.RescanScope, err error) {
+	scope = &core.RescanScope{
+		FromMasterSeqNo: uint32(ctx.Uint("from-master-seq-no")),
+		ToMasterSeqNo:   uint32(ctx.Uint("to-master-seq-no")),
 	}
 
-	for vn, v := range newM {
-		ov, ok := oldM[vn]
-		if !ok {
-			added = append(added, v)
-			continue
-		}
-		if !reflect.DeepEqual(ov, v) {
-			changed = append(changed, v)
-		}
+	if scope.FromTime, err = parseTime(ctx, "from-time"); err != nil {
+		return nil, err
 	}
-	for vn := range oldM {
-		_, ok := newM[vn]
-		if !ok {
-			deleted = append(deleted, oldM[vn])
-		}
+	if scope.ToTime, err = parseTime(ctx, "to-time"); err != nil {
+		return nil, err
 	}
 
-	return added, changed, deleted
-}
-
-func diffInterface(oldInterface, newInterface *core.ContractInterface) (interfaceChanged bool, added, changed, deleted []abi.GetMethodDesc) {
-	interfaceChanged = !reflect.DeepEqual(newInterface.Addresses, oldInterface.Addresses) ||
-		!reflect.DeepEqual(newInterface.Code, oldInterface.Code) ||
-		!reflect.DeepEqual(newInterface.GetMethodHashes, oldInterface.GetMethodHashes)
-
-	added, changed, deleted = diffSlices(oldInterface.GetMethodsDesc, newInterface.GetMethodsDesc, func(v abi.GetMethodDesc) string { return v.Name })
-
-	return interfaceChanged, added, changed, deleted
-}
-
-func diffOperations(oldOperations, newOperations []*core.ContractOperation) (added, changed, deleted []*core.ContractOperation) {
-	return diffSlices(oldOperations, newOperations, func(v *core.ContractOperation) string { return v.OperationName })
-}
-
-func getGetMethodNames(desc []abi.GetMethodDesc) (names []string) {
-	for i := range desc {
-		if len(desc[i].Arguments) > 0 {
-			continue
+	for _, a := range ctx.StringSlice("address") {
+		x := new(addr.Address)
+		if err := x.UnmarshalText([]byte(a)); err != nil {
+			return nil, errors.Wrapf(err, "parse %s address", a)
 		}
-		names = append(names, desc[i].Name)
+		scope.Addresses = append(scope.Addresses, x)
 	}
-	return
-}
 
-func rescanInterface(ctx context.Context, in abi.ContractName, repo core.RescanRepository, t core.RescanTaskType) error {
-	err := repo.AddRescanTask(ctx, &core.RescanTask{
-		Type:         t,
-		ContractName: in,
-	})
-	if err != nil {
-		return errors.Wrapf(err, "add rescan task for '%s' contract interface", in)
-	}
-
-	log.Info().
-		Str("rescan_type", string(t)).
-		Str("interface_name", string(in)).
-		Msg("added contract interface rescan task")
-
-	return nil
+	return scope, nil
 }
 
-func rescanGetMethod(ctx context.Context, in abi.ContractName, repo core.RescanRepository, t core.RescanTaskType, getMethods []string) error {
-	if len(getMethods) == 0 {
-		return nil
-	}
-
-	err := repo.AddRescanTask(ctx, &core.RescanTask{
-		Type:              t,
-		ContractName:      in,
-		ChangedGetMethods: getMethods,
-	})
+func newService() (*contract.Service, error) {
+	pg, err := dbConnect()
 	if err != nil {
-		return errors.Wrapf(err, "add rescan task for '%s' get-method", getMethods)
-	}
-
-	for _, gm := range getMethods {
-		log.Info().
-			Str("rescan_type", string(t)).
-			Str("interface_name", string(in)).
-			Str("get_method", gm).
-			Msg("added get-method rescan task")
+		return nil, err
 	}
 
-	return nil
-}
-
-func rescanOperation(ctx context.Context, repo core.RescanRepository, t core.RescanTaskType, op *core.ContractOperation) error {
-	err := repo.AddRescanTask(ctx, &core.RescanTask{
-		Type:         t,
-		ContractName: op.ContractName,
-		MessageType:  op.MessageType,
-		Outgoing:     op.Outgoing,
-		OperationID:  op.OperationID,
+	return contract.NewService(&app.ContractConfig{
+		DB:           &repository.DB{PG: pg},
+		ContractRepo: contractRepository.NewRepository(pg),
+		RescanRepo:   rescan.NewRepository(pg),
 	})
-	if err != nil {
-		return errors.Wrapf(err, "add rescan task for '%s' operation", op.OperationName)
-	}
-
-	log.Info().
-		Str("rescan_type", string(t)).
-		Str("interface_name", string(op.ContractName)).
-		Str("operation_name", op.OperationName).
-		Msg("added operation rescan task")
-
-	return nil
 }
 
 var Command = &cli.Command{
@@ -341,78 +156,18 @@ var Command = &cli.Command{
 			},
 
 			Action: func(ctx *cli.Context) (err error) {
-				var interfacesDesc []*abi.InterfaceDesc
-
-				if ctx.Bool("stdin") {
-					interfacesDesc, err = readStdin()
-				} else {
-					filenames := ctx.Args().Slice()
-					if len(filenames) == 0 {
-						cli.ShowSubcommandHelpAndExit(ctx, 1)
-					}
-					interfacesDesc, err = readFiles(filenames)
-				}
-				if err != nil {
-					return err
-				}
-
-				definitions, interfaces, operations, err := ParseInterfacesDesc(interfacesDesc)
-				if err != nil {
-					return err
-				}
-
-				pg, err := dbConnect()
+				interfacesDesc, err := ReadInterfacesDesc(ctx)
 				if err != nil {
 					return err
 				}
 
-				contractRepo := contract.NewRepository(pg)
-				rescanRepo := rescan.NewRepository(pg)
-
-				addedDef, changedDef, err := diffDefinitions(ctx.Context, contractRepo, definitions)
+				s, err := newService()
 				if err != nil {
 					return err
 				}
-				for dn, d := range changedDef {
-					if err := contractRepo.UpdateDefinition(ctx.Context, dn, d); err != nil {
-						return errors.Wrapf(err, "cannot update contract definition '%s'", dn)
-					}
-				}
-				for dn, d := range addedDef {
-					if err := contractRepo.AddDefinition(ctx.Context, dn, d); err != nil {
-						return errors.Wrapf(err, "cannot insert contract definition '%s'", dn)
-					}
-				}
-
-				for _, i := range interfaces {
-					if err := contractRepo.AddInterface(ctx.Context, i); err != nil {
-						log.Error().Err(err).Str("interface_name", string(i.Name)).Msg("cannot insert contract interface")
-						continue
-					}
-					err := rescanInterface(ctx.Context, i.Name, rescanRepo, core.AddInterface)
-					if err != nil {
-						log.Error().Err(err).Str("interface_name", string(i.Name)).Msg("cannot add interface rescan task")
-					}
-				}
-
-				for _, op := range operations {
-					if err := contractRepo.AddOperation(ctx.Context, op); err != nil {
-						log.Error().Err(err).
-							Str("interface_name", string(op.ContractName)).
-							Str("operation_name", op.OperationName).
-							Msg("cannot insert contract operation")
-						continue
-					}
-					err := rescanOperation(ctx.Context, rescanRepo, core.UpdOperation, op)
-					if err != nil {
-						log.Error().Err(err).
-							Str("interface_name", string(op.ContractName)).
-							Str("op_name", op.OperationName).
-							Msg("cannot add operation rescan task")
-					}
-				}
 
-				return nil
+				_, err = s.AddInterfaces(ctx.Context, interfacesDesc)
+				return err
 			},
 		},
 		{
@@ -433,125 +188,67 @@ var Command = &cli.Command{
 					Aliases:  []string{"c"},
 					Required: true,
 				},
+				&cli.UintFlag{
+					Name:  "from-master-seq-no",
+					Usage: "rescan only blocks committed in masterchain blocks starting from this seq_no",
+				},
+				&cli.UintFlag{
+					Name:  "to-master-seq-no",
+					Usage: "rescan only blocks committed in masterchain blocks up to this seq_no",
+				},
+				&cli.StringFlag{
+					Name:  "from-time",
+					Usage: "rescan only account states updated and messages created since this time (RFC3339)",
+				},
+				&cli.StringFlag{
+					Name:  "to-time",
+					Usage: "rescan only account states updated and messages created until this time (RFC3339)",
+				},
+				&cli.StringSliceFlag{
+					Name:  "address",
+					Usage: "rescan only the given contract addresses",
+				},
 			},
 
 			Action: func(ctx *cli.Context) (err error) {
-				var interfacesDesc []*abi.InterfaceDesc
-
-				if ctx.Bool("stdin") {
-					interfacesDesc, err = readStdin()
-				} else {
-					filenames := ctx.Args().Slice()
-					if len(filenames) == 0 {
-						cli.ShowSubcommandHelpAndExit(ctx, 1)
-					}
-					interfacesDesc, err = readFiles(filenames)
-				}
-				if err != nil {
-					return err
-				}
-
-				definitions, interfaces, _, err := ParseInterfacesDesc(interfacesDesc)
+				interfacesDesc, err := ReadInterfacesDesc(ctx)
 				if err != nil {
 					return err
 				}
 
-				contractName := abi.ContractName(ctx.String("contract-name"))
-				if contractName == "" {
-					return errors.Wrap(core.ErrInvalidArg, "contract interface name is not set")
-				}
-
-				var newInterface *core.ContractInterface
-				for _, i := range interfaces {
-					if i.Name == contractName {
-						newInterface = i
-					}
-				}
-				if newInterface == nil {
-					return errors.Wrapf(core.ErrInvalidArg, "contract interface '%s' is found in abi description", contractName)
-				}
-
-				pg, err := dbConnect()
+				scope, err := parseScope(ctx)
 				if err != nil {
 					return err
 				}
 
-				contractRepo := contract.NewRepository(pg)
-				rescanRepo := rescan.NewRepository(pg)
-
-				oldInterface, err := contractRepo.GetInterface(ctx.Context, contractName)
-				if err != nil {
-					return errors.Wrapf(err, "get '%s' interface", newInterface.Name)
-				}
-
-				addedDef, changedDef, err := diffDefinitions(ctx.Context, contractRepo, definitions)
+				s, err := newService()
 				if err != nil {
 					return err
 				}
-				for dn, d := range changedDef {
-					if err := contractRepo.UpdateDefinition(ctx.Context, dn, d); err != nil {
-						return errors.Wrapf(err, "cannot update contract definition '%s'", dn)
-					}
-				}
-				for dn, d := range addedDef {
-					if err := contractRepo.AddDefinition(ctx.Context, dn, d); err != nil {
-						return errors.Wrapf(err, "cannot insert contract definition '%s'", dn)
-					}
-				}
-
-				iChanged, addedGm, changedGm, deletedGm := diffInterface(oldInterface, newInterface)
-				if iChanged || len(addedGm) > 0 || len(changedGm) > 0 || len(deletedGm) > 0 {
-					if err := contractRepo.UpdateInterface(ctx.Context, newInterface); err != nil {
-						return errors.Wrapf(err, "cannot update contract interface '%s'", newInterface.Name)
-					}
-				}
 
-				addedOp, changedOp, deletedOp := diffOperations(oldInterface.Operations, newInterface.Operations)
-				for _, op := range deletedOp {
-					if err := contractRepo.DeleteOperation(ctx.Context, op.OperationName); err != nil {
-						return errors.Wrapf(err, "cannot delete contract operation '%s'", op.OperationName)
-					}
-				}
-				for _, op := range changedOp {
-					if err := contractRepo.UpdateOperation(ctx.Context, op); err != nil {
-						return errors.Wrapf(err, "cannot update contract operation '%s'", op.OperationName)
-					}
-				}
-				for _, op := range addedOp {
-					if err := contractRepo.AddOperation(ctx.Context, op); err != nil {
-						return errors.Wrapf(err, "cannot insert contract operation '%s'", op.OperationName)
-					}
-				}
-
-				if iChanged {
-					if err := rescanInterface(ctx.Context, contractName, rescanRepo, core.UpdInterface); err != nil {
-						return err
-					}
-				}
-
-				if err := rescanGetMethod(ctx.Context, contractName, rescanRepo, core.AddGetMethod, getGetMethodNames(addedGm)); err != nil {
-					return err
-				}
-				if err := rescanGetMethod(ctx.Context, contractName, rescanRepo, core.UpdGetMethod, getGetMethodNames(changedGm)); err != nil {
-					return err
-				}
-				if err := rescanGetMethod(ctx.Context, contractName, rescanRepo, core.DelGetMethod, getGetMethodNames(deletedGm)); err != nil {
-					return err
-				}
+				_, err = s.UpdateInterface(ctx.Context, abi.ContractName(ctx.String("contract-name")), interfacesDesc, scope)
+				return err
+			},
+		},
+		{
+			Name:  "validate",
+			Usage: "Checks contract interfaces against abi json schema, builds all TL-B types and finds operation codes collisions",
 
-				for _, op := range deletedOp {
-					if err := rescanOperation(ctx.Context, rescanRepo, core.DelOperation, op); err != nil {
-						return err
-					}
-				}
-				for _, op := range append(addedOp, changedOp...) {
-					if err := rescanOperation(ctx.Context, rescanRepo, core.UpdOperation, op); err != nil {
-						return err
-					}
-				}
+			ArgsUsage: "[file1.json] [file2.json]",
 
-				return nil
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    "stdin",
+					Usage:   "read from stdin instead of files",
+					Aliases: []string{"i"},
+				},
+				&cli.BoolFlag{
+					Name:  "offline",
+					Usage: "do not check operation codes collisions with contract interfaces in the database",
+				},
 			},
+
+			Action: validateDocs,
 		},
 		{
 			Name:  "deleteInterface",
@@ -567,40 +264,13 @@ var Command = &cli.Command{
 			},
 
 			Action: func(ctx *cli.Context) (err error) {
-				contractName := abi.ContractName(ctx.String("contract-name"))
-				if contractName == "" {
-					return errors.Wrap(core.ErrInvalidArg, "contract interface name is not set")
-				}
-
-				pg, err := dbConnect()
-				if err != nil {
-					return err
-				}
-
-				contractRepo := contract.NewRepository(pg)
-				rescanRepo := rescan.NewRepository(pg)
-
-				oldInterface, err := contractRepo.GetInterface(ctx.Context, contractName)
-				if err != nil {
-					return errors.Wrapf(err, "get '%s' interface", contractName)
-				}
-
-				if err := contractRepo.DeleteInterface(ctx.Context, contractName); err != nil {
-					return errors.Wrapf(err, "cannot delete '%s' interface", contractName)
-				}
-
-				for _, op := range oldInterface.Operations {
-					if err := rescanOperation(ctx.Context, rescanRepo, core.DelOperation, op); err != nil {
-						return err
-					}
-				}
-
-				err = rescanInterface(ctx.Context, contractName, rescanRepo, core.DelInterface)
+				s, err := newService()
 				if err != nil {
 					return err
 				}
 
-				return nil
+				_, err = s.DeleteInterface(ctx.Context, abi.ContractName(ctx.String("contract-name")))
+				return err
 			},
 		},
 	},
@@ -0,0 +1,142 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/app/contract"
+	"github.com/stepandra/anton/internal/core"
+	contractRepository "github.com/stepandra/anton/internal/core/repository/contract"
+)
+
+type interfacesDoc struct {
+	name       string
+	raw        []byte
+	interfaces []*abi.InterfaceDesc
+	problems   []*abi.ValidationError
+}
+
+func readDocs(ctx *cli.Context) ([]*interfacesDoc, error) {
+	if ctx.Bool("stdin") {
+		j, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		return []*interfacesDoc{{name: "stdin", raw: j}}, nil
+	}
+
+	filenames := ctx.Args().Slice()
+	if len(filenames) == 0 {
+		cli.ShowSubcommandHelpAndExit(ctx, 1)
+	}
+
+	var docs []*interfacesDoc
+	for _, fn := range filenames {
+		j, err := os.ReadFile(fn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", fn)
+		}
+		docs = append(docs, &interfacesDoc{name: fn, raw: j})
+	}
+	return docs, nil
+}
+
+// getStoredOperations returns operations of the contract interfaces in the database,
+// skipping interfaces which are described in the validated documents.
+func getStoredOperations(ctx *cli.Context, docs []*interfacesDoc) ([]*core.ContractOperation, error) {
+	pg, err := dbConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer pg.Close()
+
+	operations, err := contractRepository.NewRepository(pg).GetOperations(ctx.Context)
+	if err != nil {
+		return nil, errors.Wrap(err, "get contract operations")
+	}
+
+	described := map[abi.ContractName]bool{}
+	for _, d := range docs {
+		for _, i := range d.interfaces {
+			described[i.Name] = true
+		}
+	}
+
+	var ret []*core.ContractOperation
+	for _, op := range operations {
+		if !described[op.ContractName] {
+			ret = append(ret, op)
+		}
+	}
+	return ret, nil
+}
+
+func validateDocs(ctx *cli.Context) error {
+	docs, err := readDocs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range docs {
+		d.problems, err = abi.ValidateSchema(d.raw)
+		if err != nil {
+			return errors.Wrapf(err, "%s", d.name)
+		}
+		if err := json.Unmarshal(d.raw, &d.interfaces); err != nil {
+			d.problems = append(d.problems, &abi.ValidationError{Path: "$", Message: err.Error()})
+		}
+	}
+
+	var known []*core.ContractOperation
+	if !ctx.Bool("offline") {
+		known, err = getStoredOperations(ctx, docs)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		errorsCount, warningsCount int
+		names                      = map[abi.ContractName]string{}
+	)
+
+	for _, d := range docs {
+		d.problems = append(d.problems, abi.ValidateInterfaces(d.interfaces)...)
+
+		ops, collisions := contract.ValidateOperations(d.interfaces, known)
+		d.problems = append(d.problems, collisions...)
+		known = append(known, ops...)
+
+		for it, i := range d.interfaces {
+			if fn, ok := names[i.Name]; ok && fn != d.name {
+				d.problems = append(d.problems, &abi.ValidationError{
+					Path:    abi.InterfacePath(it) + ".interface_name",
+					Message: fmt.Sprintf("'%s' interface is already described in %s", i.Name, fn),
+				})
+			}
+			names[i.Name] = d.name
+		}
+
+		for _, p := range d.problems {
+			level := "error"
+			if p.Warning {
+				level = "warning"
+				warningsCount++
+			} else {
+				errorsCount++
+			}
+			fmt.Printf("%s: %s: %s: %s\n", d.name, level, p.Path, p.Message)
+		}
+	}
+
+	if errorsCount > 0 {
+		return fmt.Errorf("found %d errors and %d warnings", errorsCount, warningsCount)
+	}
+	return nil
+}
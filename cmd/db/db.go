@@ -18,6 +18,8 @@ import (
 	"github.com/uptrace/bun/migrate"
 	"github.com/uptrace/go-clickhouse/chmigrate"
 
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/indexer"
 	"github.com/stepandra/anton/internal/core"
 	"github.com/stepandra/anton/internal/core/filter"
 	"github.com/stepandra/anton/internal/core/repository"
@@ -58,8 +60,9 @@ func chUnlock(ctx context.Context, m *chmigrate.Migrator) {
 }
 
 var Command = &cli.Command{
-	Name:  "migrate",
-	Usage: "Migrates database",
+	Name:    "migrate",
+	Aliases: []string{"db"},
+	Usage:   "Migrates database",
 
 	Subcommands: []*cli.Command{
 		{
@@ -472,6 +475,31 @@ var Command = &cli.Command{
 				}
 			},
 		},
+		{
+			Name:  "rollback",
+			Usage: "Deletes blocks, transactions, messages and account states after the given masterchain block",
+			Flags: []cli.Flag{
+				&cli.UintFlag{
+					Name:     "to-master",
+					Usage:    "the last masterchain block seq_no left in the databases",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				chURL := env.GetString("DB_CH_URL", "")
+				pgURL := env.GetString("DB_PG_URL", "")
+
+				conn, err := repository.ConnectDB(c.Context, chURL, pgURL)
+				if err != nil {
+					return errors.Wrap(err, "cannot connect to the databases")
+				}
+				defer conn.Close()
+
+				i := indexer.NewService(&app.IndexerConfig{DB: conn})
+
+				return i.Rollback(c.Context, uint32(c.Uint("to-master")))
+			},
+		},
 		{
 			Name:  "fillMissedClickHouseData",
 			Usage: "Transfers missed blocks from PostgreSQL to ClickHouse",
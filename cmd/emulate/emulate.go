@@ -0,0 +1,130 @@
+package emulate
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/allisson/go-env"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"github.com/xssnick/tonutils-go/liteclient"
+	"github.com/xssnick/tonutils-go/ton"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/query"
+	"github.com/stepandra/anton/internal/core/repository"
+	"github.com/stepandra/anton/internal/core/repository/contract"
+)
+
+func decodeBoc(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	if b, err := hex.DecodeString(s); err == nil {
+		return b, nil
+	}
+	if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+		return b, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
+
+var Command = &cli.Command{
+	Name:      "emulate",
+	Usage:     "Emulates transaction caused by the message on the stored account state",
+	ArgsUsage: "[message boc in hex or base64]",
+
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "address",
+			Usage:    "destination account address",
+			Aliases:  []string{"a"},
+			Required: true,
+		},
+		&cli.Uint64Flag{
+			Name:  "lt",
+			Usage: "logical time of the last transaction of the account state (the latest state by default)",
+		},
+		&cli.BoolFlag{
+			Name:  "ignore-signature",
+			Usage: "ignore signature check of the external message",
+		},
+	},
+
+	Action: func(ctx *cli.Context) error {
+		if ctx.Args().Len() != 1 {
+			return cli.ShowSubcommandHelp(ctx)
+		}
+
+		a := new(addr.Address)
+		if err := a.UnmarshalText([]byte(ctx.String("address"))); err != nil {
+			return errors.Wrap(err, "parse address")
+		}
+
+		msg, err := decodeBoc(ctx.Args().First())
+		if err != nil {
+			return errors.Wrap(err, "decode message boc")
+		}
+
+		req := &app.EmulateMessageReq{
+			Address:         *a,
+			Message:         msg,
+			IgnoreSignature: ctx.Bool("ignore-signature"),
+		}
+		if ctx.IsSet("lt") {
+			lt := ctx.Uint64("lt")
+			req.LastTxLT = &lt
+		}
+
+		conn, err := repository.ConnectDB(ctx.Context,
+			env.GetString("DB_CH_URL", ""),
+			env.GetString("DB_PG_URL", ""))
+		if err != nil {
+			return errors.Wrap(err, "cannot connect to a database")
+		}
+		defer conn.Close()
+
+		def, err := contract.NewRepository(conn.PG).GetDefinitions(ctx.Context)
+		if err != nil {
+			return errors.Wrap(err, "get definitions")
+		}
+		err = abi.RegisterDefinitions(def)
+		if err != nil {
+			return errors.Wrap(err, "get definitions")
+		}
+
+		client := liteclient.NewConnectionPool()
+		api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
+		for _, addr := range strings.Split(env.GetString("LITESERVERS", ""), ",") {
+			split := strings.Split(addr, "|")
+			if len(split) != 2 {
+				return fmt.Errorf("wrong server address format '%s'", addr)
+			}
+			host, key := split[0], split[1]
+			if err := client.AddConnection(ctx.Context, host, key); err != nil {
+				return errors.Wrapf(err, "cannot add connection with %s host and %s key", host, key)
+			}
+		}
+
+		qs, err := query.NewService(ctx.Context, &app.QueryConfig{
+			DB:  conn,
+			API: api,
+		})
+		if err != nil {
+			return err
+		}
+
+		res, err := qs.EmulateMessage(ctx.Context, req)
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res)
+	},
+}
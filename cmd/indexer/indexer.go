@@ -18,15 +18,21 @@ import (
 	"github.com/xssnick/tonutils-go/ton"
 
 	"github.com/stepandra/anton/abi"
-	contractDesc "github.com/stepandra/anton/cmd/contract"
+	"github.com/stepandra/anton/cmd/archive"
+	"github.com/stepandra/anton/internal/api/http"
 	"github.com/stepandra/anton/internal/app"
+	contractDesc "github.com/stepandra/anton/internal/app/contract"
 	"github.com/stepandra/anton/internal/app/fetcher"
 	"github.com/stepandra/anton/internal/app/indexer"
 	"github.com/stepandra/anton/internal/app/parser"
+	"github.com/stepandra/anton/internal/app/stream"
+	"github.com/stepandra/anton/internal/app/webhook"
 	"github.com/stepandra/anton/internal/core"
 	"github.com/stepandra/anton/internal/core/repository"
 	"github.com/stepandra/anton/internal/core/repository/account"
+	"github.com/stepandra/anton/internal/core/repository/block"
 	"github.com/stepandra/anton/internal/core/repository/contract"
+	webhookRepository "github.com/stepandra/anton/internal/core/repository/webhook"
 )
 
 func getAllKnownContractFilenames(contractsDir string) (res []string, err error) {
@@ -71,7 +77,8 @@ func addKnownContracts(ctx context.Context, pg *bun.DB, contractsDir string) err
 			return errors.Wrapf(err, "unmarshal json")
 		}
 
-		definitions, interfaces, operations, err := contractDesc.ParseInterfacesDesc(descriptions)
+		// definitions are registered globally from the database after the transaction is committed
+		definitions, interfaces, operations, err := contractDesc.ParseInterfacesDesc(abi.NewRegistry(), descriptions)
 		if err != nil {
 			return err
 		}
@@ -109,6 +116,176 @@ func addKnownContracts(ctx context.Context, pg *bun.DB, contractsDir string) err
 	return nil
 }
 
+func registerDefinitions(ctx context.Context, contractRepo core.ContractRepository) error {
+	def, err := contractRepo.GetDefinitions(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get definitions")
+	}
+	err = abi.RegisterDefinitions(def)
+	if err != nil {
+		return errors.Wrap(err, "get definitions")
+	}
+	return nil
+}
+
+func connectLiteservers(ctx context.Context, servers []string) (ton.APIClientWrapped, error) {
+	client := liteclient.NewConnectionPool()
+	api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
+	for _, addr := range servers {
+		split := strings.Split(addr, "|")
+		if len(split) != 2 {
+			return nil, fmt.Errorf("wrong server address format '%s'", addr)
+		}
+		host, key := split[0], split[1]
+		if err := client.AddConnection(ctx, host, key); err != nil {
+			return nil, errors.Wrapf(err, "cannot add connection with %s host and %s key", host, key)
+		}
+	}
+	return api, nil
+}
+
+func newParserAndFetcher(ctx context.Context, api ton.APIClientWrapped, conn *repository.DB, contractRepo core.ContractRepository) (app.ParserService, app.FetcherService, error) {
+	bcConfig, err := app.GetBlockchainConfig(ctx, api)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot get blockchain config")
+	}
+
+	p := parser.NewService(&app.ParserConfig{
+		BlockchainConfig:         bcConfig,
+		ContractRepo:             contractRepo,
+		MaxAccountParsingWorkers: env.GetInt("MAX_ACCOUNT_PARSING_WORKERS", 96),
+	})
+	f := fetcher.NewService(&app.FetcherConfig{
+		API:         api,
+		AccountRepo: account.NewRepository(conn.CH, conn.PG),
+		Parser:      p,
+	})
+
+	return p, f, nil
+}
+
+// missedRanges groups sorted masterchain seq_no into continuous ranges.
+func missedRanges(seqNos []uint32) (ret [][2]uint32) {
+	for _, seq := range seqNos {
+		if len(ret) > 0 && ret[len(ret)-1][1]+1 == seq {
+			ret[len(ret)-1][1] = seq
+			continue
+		}
+		ret = append(ret, [2]uint32{seq, seq})
+	}
+	return ret
+}
+
+var backfillCommand = &cli.Command{
+	Name:  "backfill",
+	Usage: "Indexes historical masterchain blocks, which are missing in the databases",
+
+	Flags: []cli.Flag{
+		&cli.UintFlag{
+			Name:  "from",
+			Usage: "the first masterchain block seq_no (missed blocks are indexed by default)",
+		},
+		&cli.UintFlag{
+			Name:  "to",
+			Usage: "the last masterchain block seq_no",
+		},
+		&cli.BoolFlag{
+			Name:  "archive",
+			Usage: "use archive liteservers from the global config instead of LITESERVERS",
+		},
+		&cli.BoolFlag{
+			Name:    "testnet",
+			Aliases: []string{"t"},
+			Usage:   "use testnet global config",
+		},
+	},
+
+	Action: func(ctx *cli.Context) error {
+		if ctx.IsSet("from") != ctx.IsSet("to") {
+			return errors.Wrap(core.ErrInvalidArg, "both from and to flags must be set")
+		}
+		if ctx.Uint("from") > ctx.Uint("to") {
+			return errors.Wrap(core.ErrInvalidArg, "from block is greater than to block")
+		}
+
+		chURL := env.GetString("DB_CH_URL", "")
+		pgURL := env.GetString("DB_PG_URL", "")
+
+		conn, err := repository.ConnectDB(ctx.Context, chURL, pgURL)
+		if err != nil {
+			return errors.Wrap(err, "cannot connect to a database")
+		}
+		defer conn.Close()
+
+		contractRepo := contract.NewRepository(conn.PG)
+
+		interfaces, err := contractRepo.GetInterfaces(ctx.Context)
+		if err != nil {
+			return errors.Wrap(err, "get interfaces")
+		}
+		if len(interfaces) == 0 {
+			return errors.New("no contract interfaces")
+		}
+		if err := registerDefinitions(ctx.Context, contractRepo); err != nil {
+			return err
+		}
+
+		var ranges [][2]uint32
+		if ctx.IsSet("from") {
+			ranges = append(ranges, [2]uint32{uint32(ctx.Uint("from")), uint32(ctx.Uint("to"))})
+		} else {
+			missed, err := block.NewRepository(conn.CH, conn.PG).GetMissedMasterBlocks(ctx.Context)
+			if err != nil {
+				return errors.Wrap(err, "get missed masterchain blocks")
+			}
+			if len(missed) == 0 {
+				return errors.Wrap(core.ErrNotFound, "could not find any missed blocks")
+			}
+			ranges = missedRanges(missed)
+		}
+
+		servers := strings.Split(env.GetString("LITESERVERS", ""), ",")
+		if ctx.Bool("archive") {
+			servers, err = archive.GetArchiveNodes(ctx.Context, ctx.Bool("testnet"))
+			if err != nil {
+				return errors.Wrap(err, "get archive nodes")
+			}
+			if len(servers) == 0 {
+				return errors.Wrap(core.ErrNotFound, "could not find any archive nodes")
+			}
+		}
+
+		api, err := connectLiteservers(ctx.Context, servers)
+		if err != nil {
+			return err
+		}
+
+		p, f, err := newParserAndFetcher(ctx.Context, api, conn, contractRepo)
+		if err != nil {
+			return err
+		}
+
+		i := indexer.NewService(&app.IndexerConfig{
+			DB:      conn,
+			API:     api,
+			Parser:  p,
+			Fetcher: f,
+			Workers: env.GetInt("WORKERS", 4),
+		})
+
+		c, cancel := signal.NotifyContext(ctx.Context, syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+
+		for _, r := range ranges {
+			if err := i.Backfill(c, r[0], r[1]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
 var Command = &cli.Command{
 	Name:    "indexer",
 	Aliases: []string{"idx"},
@@ -123,6 +300,10 @@ var Command = &cli.Command{
 		},
 	},
 
+	Subcommands: cli.Commands{
+		backfillCommand,
+	},
+
 	Action: func(ctx *cli.Context) error {
 		chURL := env.GetString("DB_CH_URL", "")
 		pgURL := env.GetString("DB_PG_URL", "")
@@ -146,47 +327,53 @@ var Command = &cli.Command{
 			}
 		}
 
-		def, err := contractRepo.GetDefinitions(ctx.Context)
+		if err := registerDefinitions(ctx.Context, contractRepo); err != nil {
+			return err
+		}
+
+		api, err := connectLiteservers(ctx.Context, strings.Split(env.GetString("LITESERVERS", ""), ","))
 		if err != nil {
-			return errors.Wrap(err, "get definitions")
+			return err
 		}
-		err = abi.RegisterDefinitions(def)
+
+		p, f, err := newParserAndFetcher(ctx.Context, api, conn, contractRepo)
 		if err != nil {
-			return errors.Wrap(err, "get definitions")
+			return err
 		}
+		var streamSvc app.StreamService
+		if listen := env.GetString("STREAM_LISTEN", ""); listen != "" {
+			streamSvc = stream.NewService(&app.StreamConfig{
+				SubscriberBuffer: env.GetInt("STREAM_SUBSCRIBER_BUFFER", 1024),
+			})
 
-		client := liteclient.NewConnectionPool()
-		api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
-		for _, addr := range strings.Split(env.GetString("LITESERVERS", ""), ",") {
-			split := strings.Split(addr, "|")
-			if len(split) != 2 {
-				return fmt.Errorf("wrong server address format '%s'", addr)
-			}
-			host, key := split[0], split[1]
-			if err := client.AddConnection(ctx.Context, host, key); err != nil {
-				return errors.Wrapf(err, "cannot add connection with %s host and %s key", host, key)
-			}
+			srv := http.NewServer(listen)
+			srv.RegisterStreamRoutes(http.NewStreamer(streamSvc))
+			go func() {
+				if err := srv.Run(); err != nil {
+					log.Error().Err(err).Str("listen", listen).Msg("stream server")
+				}
+			}()
 		}
-		bcConfig, err := app.GetBlockchainConfig(ctx.Context, api)
-		if err != nil {
-			return errors.Wrap(err, "cannot get blockchain config")
+
+		var webhookSvc app.WebhookService
+		if env.GetBool("WEBHOOKS_ENABLED", false) {
+			webhookSvc = webhook.NewService(&app.WebhookConfig{
+				WebhookRepo: webhookRepository.NewRepository(conn.PG),
+				Workers:     env.GetInt("WEBHOOK_WORKERS", 4),
+				MaxRetries:  env.GetInt("WEBHOOK_MAX_RETRIES", 5),
+			})
+			if err = webhookSvc.Start(); err != nil {
+				return err
+			}
 		}
 
-		p := parser.NewService(&app.ParserConfig{
-			BlockchainConfig:         bcConfig,
-			ContractRepo:             contractRepo,
-			MaxAccountParsingWorkers: env.GetInt("MAX_ACCOUNT_PARSING_WORKERS", 96),
-		})
-		f := fetcher.NewService(&app.FetcherConfig{
-			API:         api,
-			AccountRepo: account.NewRepository(conn.CH, conn.PG),
-			Parser:      p,
-		})
 		i := indexer.NewService(&app.IndexerConfig{
 			DB:        conn,
 			API:       api,
 			Parser:    p,
 			Fetcher:   f,
+			Stream:    streamSvc,
+			Webhook:   webhookSvc,
 			FromBlock: uint32(env.GetInt32("FROM_BLOCK", 1)),
 			Workers:   env.GetInt("WORKERS", 4),
 		})
@@ -200,6 +387,9 @@ var Command = &cli.Command{
 		go func() {
 			<-c
 			i.Stop()
+			if webhookSvc != nil {
+				webhookSvc.Stop()
+			}
 			conn.Close()
 			done <- struct{}{}
 		}()
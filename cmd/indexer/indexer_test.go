@@ -0,0 +1,14 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissedRanges(t *testing.T) {
+	require.Equal(t, [][2]uint32(nil), missedRanges(nil))
+	require.Equal(t,
+		[][2]uint32{{10, 12}, {20, 20}, {30, 31}},
+		missedRanges([]uint32{10, 11, 12, 20, 30, 31}))
+}
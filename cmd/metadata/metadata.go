@@ -0,0 +1,67 @@
+package metadata
+
+import (
+	"database/sql"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/allisson/go-env"
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/urfave/cli/v2"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/metadata"
+	metadataRepository "github.com/stepandra/anton/internal/core/repository/metadata"
+)
+
+var Command = &cli.Command{
+	Name: "metadata",
+
+	Usage: "Fetches off-chain token metadata referenced by content URIs",
+
+	Action: func(ctx *cli.Context) error {
+		pg := bun.NewDB(
+			sql.OpenDB(
+				pgdriver.NewConnector(
+					pgdriver.WithDSN(env.GetString("DB_PG_URL", "")),
+				),
+			),
+			pgdialect.New(),
+		)
+		if err := pg.Ping(); err != nil {
+			return errors.Wrapf(err, "cannot ping postgresql")
+		}
+
+		s := metadata.NewService(&app.MetadataConfig{
+			MetadataRepo:    metadataRepository.NewRepository(pg),
+			IPFSGateway:     env.GetString("IPFS_GATEWAY", "https://ipfs.io/ipfs/"),
+			Workers:         env.GetInt("METADATA_WORKERS", 4),
+			RateLimit:       env.GetInt("METADATA_RATE_LIMIT", 10),
+			MaxSize:         env.GetInt64("METADATA_MAX_SIZE", 1<<20),
+			Timeout:         time.Duration(env.GetInt("METADATA_TIMEOUT", 10)) * time.Second,
+			RefetchInterval: time.Duration(env.GetInt("METADATA_REFETCH_INTERVAL", 86400)) * time.Second,
+		})
+		if err := s.Start(); err != nil {
+			return err
+		}
+
+		c := make(chan os.Signal, 1)
+		done := make(chan struct{}, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			<-c
+			s.Stop()
+			_ = pg.Close()
+			done <- struct{}{}
+		}()
+
+		<-done
+
+		return nil
+	},
+}
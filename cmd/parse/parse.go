@@ -0,0 +1,326 @@
+package parse
+
+import (
+	"context"
+	_ "embed"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli/v2"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/contract"
+	"github.com/stepandra/anton/internal/app/fetcher"
+	"github.com/stepandra/anton/internal/app/parser"
+	"github.com/stepandra/anton/internal/core"
+)
+
+// blockchainConfig is used to emulate get-methods without a lite server.
+//
+//go:embed blockchain_config.boc
+var blockchainConfig []byte
+
+type operationRes struct {
+	ContractName  abi.ContractName `json:"contract_name"`
+	OperationName string           `json:"operation_name"`
+	Outgoing      bool             `json:"outgoing"`
+	Data          json.RawMessage  `json:"data,omitempty"`
+	Error         string           `json:"error,omitempty"`
+}
+
+type messageRes struct {
+	Type            core.MessageType `json:"type"`
+	SrcAddress      *addr.Address    `json:"src_address,omitempty"`
+	DstAddress      *addr.Address    `json:"dst_address,omitempty"`
+	OperationID     uint32           `json:"operation_id"`
+	TransferComment string           `json:"transfer_comment,omitempty"`
+
+	// Operations are all operations with the message operation id decoded from the body
+	Operations []*operationRes `json:"operations"`
+}
+
+type parseRes struct {
+	Account     *core.AccountState `json:"account,omitempty"`
+	Transaction *core.Transaction  `json:"transaction,omitempty"`
+	Messages    []*messageRes      `json:"messages,omitempty"`
+}
+
+// readBoc reads BoC from the file with the given name or decodes it from hex or base64 string.
+func readBoc(s string) (*cell.Cell, error) {
+	raw := []byte(strings.TrimSpace(s))
+	if f, err := os.ReadFile(s); err == nil {
+		if c, err := cell.FromBOC(f); err == nil {
+			return c, nil
+		}
+		raw = []byte(strings.TrimSpace(string(f)))
+	}
+
+	for _, decode := range []func(string) ([]byte, error){
+		hex.DecodeString,
+		base64.StdEncoding.DecodeString,
+		base64.URLEncoding.DecodeString,
+	} {
+		if b, err := decode(string(raw)); err == nil {
+			return cell.FromBOC(b)
+		}
+	}
+
+	return nil, errors.Wrap(core.ErrInvalidArg, "boc is neither a file, nor hex or base64 string")
+}
+
+func loadInterfaces(ctx *cli.Context) (*interfacesRepo, error) {
+	var desc []*abi.InterfaceDesc
+
+	for _, fn := range ctx.StringSlice("interfaces") {
+		var interfaces []*abi.InterfaceDesc
+
+		j, err := os.ReadFile(fn)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", fn)
+		}
+		if err := json.Unmarshal(j, &interfaces); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal %s", fn)
+		}
+
+		desc = append(desc, interfaces...)
+	}
+
+	reg := abi.NewRegistry()
+	definitions, interfaces, operations, err := contract.ParseInterfacesDesc(reg, desc)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse interfaces")
+	}
+	reg.Commit()
+
+	// code hashes are set by the contract repository in the same way
+	for _, i := range interfaces {
+		if len(i.Code) == 0 {
+			continue
+		}
+		code, err := cell.FromBOC(i.Code)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s interface code from boc", i.Name)
+		}
+		i.CodeHash = code.Hash()
+	}
+
+	return &interfacesRepo{definitions: definitions, interfaces: interfaces, operations: operations}, nil
+}
+
+func newParser(ctx *cli.Context, repo core.ContractRepository) (app.ParserService, error) {
+	config := blockchainConfig
+	if fn := ctx.String("config"); fn != "" {
+		c, err := readBoc(fn)
+		if err != nil {
+			return nil, errors.Wrap(err, "read blockchain config")
+		}
+		config = c.ToBOC()
+	}
+
+	bcConfig, err := cell.FromBOC(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "blockchain config from boc")
+	}
+
+	return parser.NewService(&app.ParserConfig{
+		BlockchainConfig:         bcConfig,
+		ContractRepo:             repo,
+		MaxAccountParsingWorkers: 1,
+	}), nil
+}
+
+func operationID(body []byte) (uint32, string) {
+	c, err := cell.FromBOC(body)
+	if err != nil {
+		return 0, ""
+	}
+	slice := c.BeginParse()
+
+	op, err := slice.LoadUInt(32)
+	if err != nil || op != 0 {
+		return uint32(op), ""
+	}
+
+	comment, _ := slice.LoadStringSnake()
+	return 0, comment
+}
+
+// parseMessage tries all loaded operations with the message operation id in both directions,
+// as interfaces of the source and destination accounts are unknown.
+func parseMessage(p app.ParserService, repo *interfacesRepo, msg *core.Message) *messageRes {
+	res := &messageRes{
+		Type:            msg.Type,
+		OperationID:     msg.OperationID,
+		TransferComment: msg.TransferComment,
+		Operations:      []*operationRes{},
+	}
+	if msg.SrcAddress != (addr.Address{}) {
+		res.SrcAddress = &msg.SrcAddress
+	}
+	if msg.DstAddress != (addr.Address{}) {
+		res.DstAddress = &msg.DstAddress
+	}
+
+	for _, op := range repo.operations {
+		if op.MessageType != msg.Type || op.OperationID != msg.OperationID {
+			continue
+		}
+
+		parsed := *msg
+		opRes := &operationRes{ContractName: op.ContractName, OperationName: op.OperationName, Outgoing: op.Outgoing}
+		if err := p.ParseMessageOperation(&parsed, op); err != nil {
+			opRes.Error = err.Error()
+		} else {
+			opRes.Data = parsed.DataJSON
+		}
+		res.Operations = append(res.Operations, opRes)
+	}
+
+	return res
+}
+
+func parseAccount(ctx context.Context, p app.ParserService, c *cell.Cell) (*core.AccountState, error) {
+	var st tlb.AccountState
+	if err := st.LoadFromCell(c.BeginParse()); err != nil {
+		return nil, errors.Wrap(err, "load account state")
+	}
+	if !st.IsValid {
+		return fetcher.MapAccount(nil, &tlb.Account{IsActive: false}), nil
+	}
+
+	raw := &tlb.Account{IsActive: true, State: &st, LastTxLT: st.LastTransactionLT}
+	if st.Status == tlb.AccountStatusActive {
+		raw.Code = st.StateInit.Code
+		raw.Data = st.StateInit.Data
+	}
+	acc := fetcher.MapAccount(nil, raw)
+	if raw.Code != nil {
+		acc.GetMethodHashes, _ = abi.GetMethodHashes(raw.Code)
+	}
+
+	// other accounts are not known offline, so get-methods depending on them are skipped
+	others := func(context.Context, addr.Address) (*core.AccountState, error) {
+		return nil, errors.Wrap(core.ErrNotFound, "no other accounts in offline mode")
+	}
+	if err := p.ParseAccountData(ctx, acc, others); err != nil && !errors.Is(err, app.ErrImpossibleParsing) {
+		return nil, errors.Wrap(err, "parse account data")
+	}
+
+	acc.Code, acc.Data = nil, nil
+	return acc, nil
+}
+
+var Command = &cli.Command{
+	Name:      "parse",
+	Usage:     "Decodes message body, message, account state or transaction with contract interfaces from json files without a database",
+	ArgsUsage: "[boc file, hex or base64]",
+
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:     "interfaces",
+			Usage:    "json files with contract interfaces in the same format as abi/known",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "type",
+			Usage: "boc type: body, message, account or transaction",
+			Value: "body",
+		},
+		&cli.StringFlag{
+			Name:  "msg-type",
+			Usage: "message type of the body: INTERNAL, EXTERNAL_IN or EXTERNAL_OUT",
+			Value: string(core.Internal),
+		},
+		&cli.IntFlag{
+			Name:  "workchain",
+			Usage: "workchain of the transaction account",
+		},
+		&cli.StringFlag{
+			Name:  "config",
+			Usage: "blockchain config boc used to emulate get-methods (bundled config by default)",
+		},
+	},
+
+	Action: func(ctx *cli.Context) error {
+		if ctx.Args().Len() != 1 {
+			return cli.ShowSubcommandHelp(ctx)
+		}
+
+		c, err := readBoc(ctx.Args().First())
+		if err != nil {
+			return errors.Wrap(err, "read boc")
+		}
+
+		repo, err := loadInterfaces(ctx)
+		if err != nil {
+			return err
+		}
+
+		p, err := newParser(ctx, repo)
+		if err != nil {
+			return err
+		}
+
+		var res parseRes
+
+		switch t := ctx.String("type"); t {
+		case "body":
+			msg := &core.Message{Type: core.MessageType(strings.ToUpper(ctx.String("msg-type"))), Body: c.ToBOC()}
+			msg.OperationID, msg.TransferComment = operationID(msg.Body)
+			res.Messages = append(res.Messages, parseMessage(p, repo, msg))
+
+		case "message":
+			var raw tlb.Message
+			if err := tlb.LoadFromCell(&raw, c.BeginParse()); err != nil {
+				return errors.Wrap(err, "load message")
+			}
+			msg, err := fetcher.MapMessage(&tlb.Transaction{}, raw)
+			if err != nil {
+				return errors.Wrap(err, "map message")
+			}
+			res.Messages = append(res.Messages, parseMessage(p, repo, msg))
+
+		case "account":
+			res.Account, err = parseAccount(ctx.Context, p, c)
+			if err != nil {
+				return err
+			}
+
+		case "transaction":
+			var raw tlb.Transaction
+			if err := tlb.LoadFromCell(&raw, c.BeginParse()); err != nil {
+				return errors.Wrap(err, "load transaction")
+			}
+			raw.Hash = c.Hash()
+
+			tx, err := fetcher.MapTransaction(&ton.BlockIDExt{Workchain: int32(ctx.Int("workchain"))}, &raw)
+			if err != nil {
+				return errors.Wrap(err, "map transaction")
+			}
+			if tx.InMsg != nil {
+				res.Messages = append(res.Messages, parseMessage(p, repo, tx.InMsg))
+			}
+			for _, out := range tx.OutMsg {
+				res.Messages = append(res.Messages, parseMessage(p, repo, out))
+			}
+			tx.InMsg, tx.OutMsg = nil, nil
+			res.Transaction = tx
+
+		default:
+			return errors.Wrapf(core.ErrInvalidArg, "unknown boc type '%s'", t)
+		}
+
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(res)
+	},
+}
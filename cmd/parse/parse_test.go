@@ -0,0 +1,135 @@
+package parse
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func TestReadBoc(t *testing.T) {
+	c := cell.BeginCell().MustStoreUInt(0x12345678, 32).MustStoreStringSnake("boc").EndCell()
+	boc := c.ToBOC()
+
+	dir := t.TempDir()
+	binFile, hexFile := filepath.Join(dir, "cell.boc"), filepath.Join(dir, "cell.hex")
+	require.Nil(t, os.WriteFile(binFile, boc, 0o600))
+	require.Nil(t, os.WriteFile(hexFile, []byte(hex.EncodeToString(boc)+"\n"), 0o600))
+
+	for _, tc := range []struct {
+		name  string
+		input string
+		err   bool
+	}{
+		{name: "hex", input: hex.EncodeToString(boc)},
+		{name: "base64", input: base64.StdEncoding.EncodeToString(boc)},
+		{name: "base64 url", input: base64.URLEncoding.EncodeToString(boc)},
+		{name: "trailing spaces", input: " " + hex.EncodeToString(boc) + "\n"},
+		{name: "binary file", input: binFile},
+		{name: "hex file", input: hexFile},
+		{name: "not a boc", input: "not a boc", err: true},
+		{name: "missing file", input: filepath.Join(dir, "missing.boc"), err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := readBoc(tc.input)
+			if tc.err {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, c.Hash(), got.Hash())
+		})
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		body    []byte
+		op      uint32
+		comment string
+	}{
+		{name: "operation", body: cell.BeginCell().MustStoreUInt(0x0f8a7ea5, 32).MustStoreUInt(1, 64).EndCell().ToBOC(), op: 0x0f8a7ea5},
+		{name: "comment", body: cell.BeginCell().MustStoreUInt(0, 32).MustStoreStringSnake("hello").EndCell().ToBOC(), comment: "hello"},
+		{name: "empty body", body: cell.BeginCell().EndCell().ToBOC()},
+		{name: "invalid boc", body: []byte("invalid")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			op, comment := operationID(tc.body)
+			require.Equal(t, tc.op, op)
+			require.Equal(t, tc.comment, comment)
+		})
+	}
+}
+
+func TestParseMessage(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "interfaces.json")
+	require.Nil(t, os.WriteFile(fn, []byte(`[
+  {
+    "interface_name": "parse_test",
+    "in_messages": [
+      {
+        "op_name": "parse_test_transfer",
+        "op_code": "0x7362d09c",
+        "body": [
+          {"name": "query_id", "tlb_type": "## 64", "format": "uint64"},
+          {"name": "amount", "tlb_type": ".", "format": "coins"},
+          {"name": "sender", "tlb_type": "addr", "format": "addr"}
+        ]
+      }
+    ]
+  }
+]`), 0o600))
+
+	set := flag.NewFlagSet("parse", flag.ContinueOnError)
+	set.Var(cli.NewStringSlice(fn), "interfaces", "")
+	ctx := cli.NewContext(cli.NewApp(), set, nil)
+
+	repo, err := loadInterfaces(ctx)
+	require.Nil(t, err)
+
+	p, err := newParser(ctx, repo)
+	require.Nil(t, err)
+
+	sender := rndm.Address()
+	body := cell.BeginCell().
+		MustStoreUInt(0x7362d09c, 32).
+		MustStoreUInt(42, 64).
+		MustStoreCoins(tlb.MustFromTON("1.5").Nano().Uint64()).
+		MustStoreAddr(sender.MustToTonutils()).
+		EndCell().ToBOC()
+
+	msg := &core.Message{Type: core.Internal, Body: body}
+	msg.OperationID, msg.TransferComment = operationID(msg.Body)
+
+	res := parseMessage(p, repo, msg)
+	require.Equal(t, uint32(0x7362d09c), res.OperationID)
+	require.Len(t, res.Operations, 1)
+
+	op := res.Operations[0]
+	require.Equal(t, "parse_test", string(op.ContractName))
+	require.Equal(t, "parse_test_transfer", op.OperationName)
+	require.False(t, op.Outgoing)
+	require.Empty(t, op.Error)
+
+	var data map[string]any
+	require.Nil(t, json.Unmarshal(op.Data, &data))
+	require.Equal(t, float64(42), data["query_id"])
+	require.Equal(t, "1500000000", data["amount"])
+	require.Equal(t, sender.Base64(), data["sender"])
+
+	// operations of other message types are not tried
+	msg.Type = core.ExternalIn
+	require.Len(t, parseMessage(p, repo, msg).Operations, 0)
+}
@@ -0,0 +1,115 @@
+package parse
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ core.ContractRepository = (*interfacesRepo)(nil)
+
+var errReadOnly = errors.New("contract interfaces loaded from files are read-only")
+
+// interfacesRepo keeps contract interfaces loaded from json files in memory,
+// so the parser can be used without a database.
+type interfacesRepo struct {
+	definitions map[abi.TLBType]abi.TLBFieldsDesc
+	interfaces  []*core.ContractInterface
+	operations  []*core.ContractOperation
+}
+
+func (r *interfacesRepo) AddDefinition(context.Context, bun.Tx, abi.TLBType, abi.TLBFieldsDesc) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) UpdateDefinition(context.Context, bun.Tx, abi.TLBType, abi.TLBFieldsDesc) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) DeleteDefinition(context.Context, bun.Tx, abi.TLBType) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) GetDefinitions(context.Context) (map[abi.TLBType]abi.TLBFieldsDesc, error) {
+	return r.definitions, nil
+}
+
+func (r *interfacesRepo) AddInterface(context.Context, bun.Tx, *core.ContractInterface) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) UpdateInterface(context.Context, bun.Tx, *core.ContractInterface) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) DeleteInterface(context.Context, bun.Tx, abi.ContractName) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) GetInterface(_ context.Context, name abi.ContractName) (*core.ContractInterface, error) {
+	for _, i := range r.interfaces {
+		if i.Name == name {
+			return i, nil
+		}
+	}
+	return nil, core.ErrNotFound
+}
+
+func (r *interfacesRepo) GetInterfaces(context.Context) ([]*core.ContractInterface, error) {
+	return r.interfaces, nil
+}
+
+func (r *interfacesRepo) GetMethodDescription(ctx context.Context, name abi.ContractName, method string) (abi.GetMethodDesc, error) {
+	i, err := r.GetInterface(ctx, name)
+	if err != nil {
+		return abi.GetMethodDesc{}, err
+	}
+
+	for it := range i.GetMethodsDesc {
+		if i.GetMethodsDesc[it].Name == method {
+			return i.GetMethodsDesc[it], nil
+		}
+	}
+
+	return abi.GetMethodDesc{}, core.ErrNotFound
+}
+
+func (r *interfacesRepo) AddOperation(context.Context, bun.Tx, *core.ContractOperation) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) UpdateOperation(context.Context, bun.Tx, *core.ContractOperation) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) DeleteOperation(context.Context, bun.Tx, string) error {
+	return errReadOnly
+}
+
+func (r *interfacesRepo) GetOperations(context.Context) ([]*core.ContractOperation, error) {
+	return r.operations, nil
+}
+
+func (r *interfacesRepo) GetOperationsByID(_ context.Context, t core.MessageType, interfaces []abi.ContractName, outgoing bool, id uint32) (ret []*core.ContractOperation, _ error) {
+	if len(interfaces) == 0 {
+		return nil, errors.Wrap(core.ErrNotFound, "no contract interfaces")
+	}
+
+	for _, op := range r.operations {
+		if op.MessageType != t || op.Outgoing != outgoing || op.OperationID != id {
+			continue
+		}
+		for _, i := range interfaces {
+			if op.ContractName == i {
+				ret = append(ret, op)
+				break
+			}
+		}
+	}
+
+	return ret, nil
+}
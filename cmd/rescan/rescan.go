@@ -1,22 +1,32 @@
 package rescan
 
 import (
+	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 
 	"github.com/allisson/go-env"
 	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
 	"github.com/urfave/cli/v2"
 	"github.com/xssnick/tonutils-go/liteclient"
 	"github.com/xssnick/tonutils-go/ton"
 
 	"github.com/stepandra/anton/abi"
+	contractCmd "github.com/stepandra/anton/cmd/contract"
 	"github.com/stepandra/anton/internal/app"
 	"github.com/stepandra/anton/internal/app/parser"
 	"github.com/stepandra/anton/internal/app/rescan"
+	"github.com/stepandra/anton/internal/core"
 	"github.com/stepandra/anton/internal/core/repository"
 	"github.com/stepandra/anton/internal/core/repository/account"
 	"github.com/stepandra/anton/internal/core/repository/block"
@@ -25,71 +35,130 @@ import (
 	rescanRepository "github.com/stepandra/anton/internal/core/repository/rescan"
 )
 
-var Command = &cli.Command{
-	Name: "rescan",
-
-	Usage: "Updates account states and messages data",
-
-	Action: func(ctx *cli.Context) error {
-		chURL := env.GetString("DB_CH_URL", "")
-		pgURL := env.GetString("DB_PG_URL", "")
+func dbConnect() (*bun.DB, error) {
+	pg := bun.NewDB(
+		sql.OpenDB(
+			pgdriver.NewConnector(
+				pgdriver.WithDSN(env.GetString("DB_PG_URL", "")),
+			),
+		),
+		pgdialect.New(),
+	)
+	if err := pg.Ping(); err != nil {
+		return nil, errors.Wrapf(err, "cannot ping postgresql")
+	}
+	return pg, nil
+}
 
-		conn, err := repository.ConnectDB(ctx.Context, chURL, pgURL)
+func withRepository(f func(ctx context.Context, repo core.RescanRepository) error) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		pg, err := dbConnect()
 		if err != nil {
-			return errors.Wrap(err, "cannot connect to a database")
+			return err
 		}
+		defer pg.Close()
+
+		return f(ctx.Context, rescanRepository.NewRepository(pg))
+	}
+}
 
-		contractRepo := contract.NewRepository(conn.PG)
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
 
-		interfaces, err := contractRepo.GetInterfaces(ctx.Context)
+// taskAction runs the function on the task with id taken from the first argument.
+func taskAction(f func(ctx context.Context, repo core.RescanRepository, id int) error) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		id, err := strconv.Atoi(ctx.Args().First())
 		if err != nil {
-			return errors.Wrap(err, "get interfaces")
-		}
-		if len(interfaces) == 0 {
-			return errors.New("no contract interfaces")
+			cli.ShowSubcommandHelpAndExit(ctx, 1)
 		}
 
-		def, err := contractRepo.GetDefinitions(ctx.Context)
-		if err != nil {
-			return errors.Wrap(err, "get definitions")
+		return withRepository(func(c context.Context, repo core.RescanRepository) error {
+			return f(c, repo, id)
+		})(ctx)
+	}
+}
+
+// newService connects to the databases and liteservers and creates rescan service.
+func newService(ctx *cli.Context) (*rescan.Service, *repository.DB, error) {
+	chURL := env.GetString("DB_CH_URL", "")
+	pgURL := env.GetString("DB_PG_URL", "")
+
+	conn, err := repository.ConnectDB(ctx.Context, chURL, pgURL)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot connect to a database")
+	}
+
+	contractRepo := contract.NewRepository(conn.PG)
+
+	interfaces, err := contractRepo.GetInterfaces(ctx.Context)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get interfaces")
+	}
+	if len(interfaces) == 0 {
+		return nil, nil, errors.New("no contract interfaces")
+	}
+
+	def, err := contractRepo.GetDefinitions(ctx.Context)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get definitions")
+	}
+	err = abi.RegisterDefinitions(def)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "get definitions")
+	}
+
+	client := liteclient.NewConnectionPool()
+	api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
+	for _, addr := range strings.Split(env.GetString("LITESERVERS", ""), ",") {
+		split := strings.Split(addr, "|")
+		if len(split) != 2 {
+			return nil, nil, fmt.Errorf("wrong server address format '%s'", addr)
 		}
-		err = abi.RegisterDefinitions(def)
-		if err != nil {
-			return errors.Wrap(err, "get definitions")
+		host, key := split[0], split[1]
+		if err := client.AddConnection(ctx.Context, host, key); err != nil {
+			return nil, nil, errors.Wrapf(err, "cannot add connection with %s host and %s key", host, key)
 		}
+	}
+	bcConfig, err := app.GetBlockchainConfig(ctx.Context, api)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot get blockchain config")
+	}
 
-		client := liteclient.NewConnectionPool()
-		api := ton.NewAPIClient(client, ton.ProofCheckPolicyUnsafe).WithRetry()
-		for _, addr := range strings.Split(env.GetString("LITESERVERS", ""), ",") {
-			split := strings.Split(addr, "|")
-			if len(split) != 2 {
-				return fmt.Errorf("wrong server address format '%s'", addr)
-			}
-			host, key := split[0], split[1]
-			if err := client.AddConnection(ctx.Context, host, key); err != nil {
-				return errors.Wrapf(err, "cannot add connection with %s host and %s key", host, key)
-			}
-		}
-		bcConfig, err := app.GetBlockchainConfig(ctx.Context, api)
+	p := parser.NewService(&app.ParserConfig{
+		BlockchainConfig:         bcConfig,
+		ContractRepo:             contractRepo,
+		MaxAccountParsingWorkers: env.GetInt("MAX_ACCOUNT_PARSING_WORKERS", 96),
+	})
+	s := rescan.NewService(&app.RescanConfig{
+		ContractRepo: contractRepo,
+		RescanRepo:   rescanRepository.NewRepository(conn.PG),
+		AccountRepo:  account.NewRepository(conn.CH, conn.PG),
+		BlockRepo:    block.NewRepository(conn.CH, conn.PG),
+		MessageRepo:  msg.NewRepository(conn.CH, conn.PG),
+		Parser:       p,
+		Tasks:        env.GetInt("RESCAN_TASKS", 1),
+		Workers:      env.GetInt("RESCAN_WORKERS", 4),
+		SelectLimit:  env.GetInt("RESCAN_SELECT_LIMIT", 1000),
+	})
+
+	return s, conn, nil
+}
+
+var Command = &cli.Command{
+	Name: "rescan",
+
+	Usage: "Updates account states and messages data",
+
+	Action: func(ctx *cli.Context) error {
+		i, conn, err := newService(ctx)
 		if err != nil {
-			return errors.Wrap(err, "cannot get blockchain config")
+			return err
 		}
 
-		p := parser.NewService(&app.ParserConfig{
-			BlockchainConfig:         bcConfig,
-			ContractRepo:             contractRepo,
-			MaxAccountParsingWorkers: env.GetInt("MAX_ACCOUNT_PARSING_WORKERS", 96),
-		})
-		i := rescan.NewService(&app.RescanConfig{
-			ContractRepo: contractRepo,
-			RescanRepo:   rescanRepository.NewRepository(conn.PG),
-			AccountRepo:  account.NewRepository(conn.CH, conn.PG),
-			BlockRepo:    block.NewRepository(conn.CH, conn.PG),
-			MessageRepo:  msg.NewRepository(conn.CH, conn.PG),
-			Parser:       p,
-			Workers:      env.GetInt("RESCAN_WORKERS", 4),
-			SelectLimit:  env.GetInt("RESCAN_SELECT_LIMIT", 1000),
-		})
 		if err = i.Start(); err != nil {
 			return err
 		}
@@ -108,4 +177,135 @@ var Command = &cli.Command{
 
 		return nil
 	},
+	Subcommands: cli.Commands{
+		{
+			Name:  "dryRun",
+			Usage: "Reparses a sample of account states and messages with the proposed contract interface and prints the difference without saving it",
+
+			ArgsUsage: "[file1.json] [file2.json]",
+
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    "stdin",
+					Usage:   "read from stdin instead of files",
+					Aliases: []string{"i"},
+				},
+				&cli.StringFlag{
+					Name:     "contract-name",
+					Usage:    "proposed contract interface",
+					Aliases:  []string{"c"},
+					Required: true,
+				},
+				&cli.IntFlag{
+					Name:  "limit",
+					Usage: "number of sampled account states and messages of each operation",
+					Value: 100,
+				},
+				&cli.IntFlag{
+					Name:  "examples",
+					Usage: "maximum number of account states and messages diffs in the report",
+					Value: 10,
+				},
+			},
+
+			Action: func(ctx *cli.Context) error {
+				desc, err := contractCmd.ReadInterfacesDesc(ctx)
+				if err != nil {
+					return err
+				}
+
+				s, conn, err := newService(ctx)
+				if err != nil {
+					return err
+				}
+				defer conn.Close()
+
+				report, err := s.DryRun(ctx.Context, &app.DryRunReq{
+					ContractName: abi.ContractName(ctx.String("contract-name")),
+					Desc:         desc,
+					Limit:        ctx.Int("limit"),
+					Examples:     ctx.Int("examples"),
+				})
+				if err != nil {
+					return errors.Wrap(err, "dry run")
+				}
+				return printJSON(report)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "Prints unfinished rescan tasks in the order they are run",
+
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "all",
+					Usage: "print finished and cancelled tasks too",
+				},
+			},
+
+			Action: func(ctx *cli.Context) error {
+				return withRepository(func(c context.Context, repo core.RescanRepository) error {
+					tasks, err := repo.GetRescanTasks(c, ctx.Bool("all"))
+					if err != nil {
+						return errors.Wrap(err, "get rescan tasks")
+					}
+					return printJSON(tasks)
+				})(ctx)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Cancels unfinished rescan task, the running batch is completed",
+
+			ArgsUsage: "id",
+
+			Action: taskAction(func(ctx context.Context, repo core.RescanRepository, id int) error {
+				if err := repo.CancelRescanTask(ctx, id); err != nil {
+					return errors.Wrapf(err, "cancel %d rescan task", id)
+				}
+				log.Info().Int("id", id).Msg("cancelled rescan task")
+				return nil
+			}),
+		},
+		{
+			Name:  "retry",
+			Usage: "Restarts finished or cancelled rescan task from the beginning",
+
+			ArgsUsage: "id",
+
+			Action: taskAction(func(ctx context.Context, repo core.RescanRepository, id int) error {
+				if err := repo.RetryRescanTask(ctx, id); err != nil {
+					return errors.Wrapf(err, "retry %d rescan task", id)
+				}
+				log.Info().Int("id", id).Msg("restarted rescan task")
+				return nil
+			}),
+		},
+		{
+			Name:  "priority",
+			Usage: "Sets rescan task priority, tasks with higher priority are run first",
+
+			ArgsUsage: "id",
+
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     "priority",
+					Usage:    "task priority",
+					Aliases:  []string{"p"},
+					Required: true,
+				},
+			},
+
+			Action: func(ctx *cli.Context) error {
+				priority := ctx.Int("priority")
+				return taskAction(func(c context.Context, repo core.RescanRepository, id int) error {
+					if err := repo.SetRescanTaskPriority(c, id, priority); err != nil {
+						return errors.Wrapf(err, "set %d rescan task priority", id)
+					}
+					log.Info().Int("id", id).Int("priority", priority).Msg("set rescan task priority")
+					return nil
+				})(ctx)
+			},
+		},
+	},
 }
@@ -6,24 +6,29 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/allisson/go-env"
+	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 	"github.com/xssnick/tonutils-go/liteclient"
 	"github.com/xssnick/tonutils-go/ton"
 
 	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/api/graphql"
 	"github.com/stepandra/anton/internal/api/http"
 	"github.com/stepandra/anton/internal/app"
+	contractApp "github.com/stepandra/anton/internal/app/contract"
 	"github.com/stepandra/anton/internal/app/query"
 	"github.com/stepandra/anton/internal/core/repository"
 	"github.com/stepandra/anton/internal/core/repository/contract"
+	"github.com/stepandra/anton/internal/core/repository/rescan"
 )
 
 var Command = &cli.Command{
 	Name:  "web",
-	Usage: "HTTP JSON API",
+	Usage: "HTTP JSON and GraphQL API",
 
 	Action: func(ctx *cli.Context) error {
 		chURL := env.GetString("DB_CH_URL", "")
@@ -58,8 +63,10 @@ var Command = &cli.Command{
 		}
 
 		qs, err := query.NewService(ctx.Context, &app.QueryConfig{
-			DB:  conn,
-			API: api,
+			DB:                  conn,
+			API:                 api,
+			BlockchainConfigTTL: time.Duration(env.GetInt("BLOCKCHAIN_CONFIG_TTL", 3600)) * time.Second,
+			GetMethodGasLimit:   env.GetInt64("GET_METHOD_GAS_LIMIT", 1_000_000),
 		})
 		if err != nil {
 			return err
@@ -68,7 +75,26 @@ var Command = &cli.Command{
 		srv := http.NewServer(
 			env.GetString("LISTEN", "0.0.0.0:80"),
 		)
+		srv.SetEmulationLimits(http.EmulationLimits{
+			MaxBodySize: env.GetInt64("EMULATION_MAX_BODY_SIZE", 64<<10),
+			RateLimit:   env.GetFloat64("EMULATION_RATE_LIMIT", 5),
+			Burst:       env.GetInt("EMULATION_RATE_BURST", 10),
+		})
 		srv.RegisterRoutes(http.NewController(qs))
+		if password := env.GetString("ADMIN_PASSWORD", ""); password != "" {
+			cs, err := contractApp.NewService(&app.ContractConfig{
+				DB:           conn,
+				ContractRepo: contract.NewRepository(conn.PG),
+				RescanRepo:   rescan.NewRepository(conn.PG),
+			})
+			if err != nil {
+				return err
+			}
+			srv.RegisterAdminRoutes(http.NewAdmin(cs), gin.Accounts{
+				env.GetString("ADMIN_USERNAME", "admin"): password,
+			})
+		}
+		srv.RegisterGraphQL(graphql.NewHandler(qs), graphql.NewPlaygroundHandler("/graphql"))
 
 		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
@@ -0,0 +1,206 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/allisson/go-env"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"github.com/urfave/cli/v2"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/repository/webhook"
+)
+
+func dbConnect() (*bun.DB, error) {
+	pg := bun.NewDB(
+		sql.OpenDB(
+			pgdriver.NewConnector(
+				pgdriver.WithDSN(env.GetString("DB_PG_URL", "")),
+			),
+		),
+		pgdialect.New(),
+	)
+	if err := pg.Ping(); err != nil {
+		return nil, errors.Wrapf(err, "cannot ping postgresql")
+	}
+	return pg, nil
+}
+
+func withRepository(f func(ctx context.Context, repo core.WebhookRepository) error) cli.ActionFunc {
+	return func(ctx *cli.Context) error {
+		pg, err := dbConnect()
+		if err != nil {
+			return err
+		}
+		defer pg.Close()
+
+		return f(ctx.Context, webhook.NewRepository(pg))
+	}
+}
+
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func parseWebhook(ctx *cli.Context) (*core.Webhook, error) {
+	w := &core.Webhook{
+		URL:            ctx.String("url"),
+		Secret:         ctx.String("secret"),
+		OperationNames: ctx.StringSlice("operation-name"),
+	}
+
+	for _, e := range ctx.StringSlice("event") {
+		switch core.WebhookEvent(e) {
+		case core.WebhookMessage, core.WebhookTransaction:
+			w.Events = append(w.Events, core.WebhookEvent(e))
+		default:
+			return nil, errors.Wrapf(core.ErrInvalidArg, "unknown event '%s'", e)
+		}
+	}
+
+	for _, a := range ctx.StringSlice("address") {
+		x := new(addr.Address)
+		if err := x.UnmarshalText([]byte(a)); err != nil {
+			return nil, errors.Wrapf(err, "parse %s address", a)
+		}
+		w.Addresses = append(w.Addresses, x)
+	}
+
+	for _, c := range ctx.StringSlice("dst-contract") {
+		w.DstContracts = append(w.DstContracts, abi.ContractName(c))
+	}
+
+	return w, nil
+}
+
+var Command = &cli.Command{
+	Name:  "webhook",
+	Usage: "Manages webhooks notified about new messages and transactions",
+
+	Subcommands: cli.Commands{
+		{
+			Name:  "add",
+			Usage: "Registers a new webhook",
+
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "url",
+					Usage:    "endpoint receiving POST requests",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "secret",
+					Usage:    "secret used to sign payloads with HMAC-SHA256",
+					Required: true,
+				},
+				&cli.StringSliceFlag{
+					Name:  "event",
+					Usage: "sent events: message, transaction (all by default)",
+				},
+				&cli.StringSliceFlag{
+					Name:  "address",
+					Usage: "account address, message source or destination address",
+				},
+				&cli.StringSliceFlag{
+					Name:  "dst-contract",
+					Usage: "message destination contract name",
+				},
+				&cli.StringSliceFlag{
+					Name:  "operation-name",
+					Usage: "message operation name",
+				},
+			},
+
+			Action: func(ctx *cli.Context) error {
+				w, err := parseWebhook(ctx)
+				if err != nil {
+					return err
+				}
+
+				return withRepository(func(c context.Context, repo core.WebhookRepository) error {
+					if err := repo.AddWebhook(c, w); err != nil {
+						return errors.Wrap(err, "add webhook")
+					}
+					log.Info().Int("id", w.ID).Str("url", w.URL).Msg("added webhook")
+					return nil
+				})(ctx)
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "Prints registered webhooks",
+
+			Action: withRepository(func(ctx context.Context, repo core.WebhookRepository) error {
+				webhooks, err := repo.GetWebhooks(ctx)
+				if err != nil {
+					return errors.Wrap(err, "get webhooks")
+				}
+				return printJSON(webhooks)
+			}),
+		},
+		{
+			Name:  "delete",
+			Usage: "Deletes webhook with its dead letters",
+
+			ArgsUsage: "id",
+
+			Action: func(ctx *cli.Context) error {
+				id, err := strconv.Atoi(ctx.Args().First())
+				if err != nil {
+					cli.ShowSubcommandHelpAndExit(ctx, 1)
+				}
+
+				return withRepository(func(c context.Context, repo core.WebhookRepository) error {
+					if err := repo.DeleteWebhook(c, id); err != nil {
+						return errors.Wrapf(err, "delete %d webhook", id)
+					}
+					log.Info().Int("id", id).Msg("deleted webhook")
+					return nil
+				})(ctx)
+			},
+		},
+		{
+			Name:  "deadLetters",
+			Usage: "Prints payloads which were not delivered",
+
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  "id",
+					Usage: "webhook id (all webhooks by default)",
+				},
+				&cli.IntFlag{
+					Name:  "limit",
+					Usage: "the maximum number of printed dead letters",
+					Value: 10,
+				},
+			},
+
+			Action: func(ctx *cli.Context) error {
+				return withRepository(func(c context.Context, repo core.WebhookRepository) error {
+					letters, err := repo.GetDeadLetters(c, ctx.Int("id"), ctx.Int("limit"))
+					if err != nil {
+						return errors.Wrap(err, "get dead letters")
+					}
+					if len(letters) == 0 {
+						fmt.Println("no dead letters")
+						return nil
+					}
+					return printJSON(letters)
+				})(ctx)
+			},
+		},
+	},
+}
@@ -0,0 +1,239 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package generated
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+	"github.com/uptrace/bun/extra/bunbig"
+)
+
+type AccountAggregationFilter struct {
+	Address       *addr.Address `json:"address,omitempty"`
+	MinterAddress *addr.Address `json:"minterAddress,omitempty"`
+	Limit         *int          `json:"limit,omitempty"`
+}
+
+type AccountFilter struct {
+	Addresses     []addr.Address `json:"addresses,omitempty"`
+	Latest        *bool          `json:"latest,omitempty"`
+	Workchain     *int           `json:"workchain,omitempty"`
+	Shard         *int           `json:"shard,omitempty"`
+	BlockSeqNoLeq *uint32        `json:"blockSeqNoLeq,omitempty"`
+	BlockSeqNoBeq *uint32        `json:"blockSeqNoBeq,omitempty"`
+	Interfaces    []string       `json:"interfaces,omitempty"`
+	OwnerAddress  *addr.Address  `json:"ownerAddress,omitempty"`
+	MinterAddress *addr.Address  `json:"minterAddress,omitempty"`
+	ContentSymbol *string        `json:"contentSymbol,omitempty"`
+}
+
+type AccountHistoryFilter struct {
+	Metric        history.AccountMetric `json:"metric"`
+	Interfaces    []string              `json:"interfaces,omitempty"`
+	MinterAddress *addr.Address         `json:"minterAddress,omitempty"`
+	OwnerAddress  *addr.Address         `json:"ownerAddress,omitempty"`
+	Params        *HistoryParams        `json:"params"`
+}
+
+type AddressAmount struct {
+	Address *addr.Address `json:"address,omitempty"`
+	Amount  *bunbig.Int   `json:"amount,omitempty"`
+	Count   int           `json:"count"`
+}
+
+type BigIntPoint struct {
+	Timestamp time.Time   `json:"timestamp"`
+	Value     *bunbig.Int `json:"value,omitempty"`
+}
+
+type BlockFilter struct {
+	Workchain *int    `json:"workchain,omitempty"`
+	Shard     *int    `json:"shard,omitempty"`
+	SeqNo     *uint32 `json:"seqNo,omitempty"`
+	FileHash  []byte  `json:"fileHash,omitempty"`
+}
+
+type BlockIDFilter struct {
+	Workchain int    `json:"workchain"`
+	Shard     int    `json:"shard"`
+	SeqNo     uint32 `json:"seqNo"`
+}
+
+type CountPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     int       `json:"value"`
+}
+
+type History struct {
+	CountResults []*CountPoint  `json:"countResults,omitempty"`
+	SumResults   []*BigIntPoint `json:"sumResults,omitempty"`
+}
+
+type HistoryParams struct {
+	From     *time.Time    `json:"from,omitempty"`
+	To       *time.Time    `json:"to,omitempty"`
+	Interval time.Duration `json:"interval"`
+}
+
+type JettonHoldersFilter struct {
+	MinterAddress addr.Address `json:"minterAddress"`
+	MasterSeqNo   *uint32      `json:"masterSeqNo,omitempty"`
+	Offset        *int         `json:"offset,omitempty"`
+	Limit         *int         `json:"limit,omitempty"`
+}
+
+type LabelFilter struct {
+	Name       *string              `json:"name,omitempty"`
+	Categories []core.LabelCategory `json:"categories,omitempty"`
+}
+
+type MessageAggregation struct {
+	ReceivedCount       int              `json:"receivedCount"`
+	ReceivedTonAmount   *bunbig.Int      `json:"receivedTonAmount,omitempty"`
+	SentCount           int              `json:"sentCount"`
+	SentTonAmount       *bunbig.Int      `json:"sentTonAmount,omitempty"`
+	ReceivedFromAddress []*AddressAmount `json:"receivedFromAddress"`
+	SentToAddress       []*AddressAmount `json:"sentToAddress"`
+}
+
+type MessageAggregationFilter struct {
+	Address addr.Address             `json:"address"`
+	From    *time.Time               `json:"from,omitempty"`
+	To      *time.Time               `json:"to,omitempty"`
+	OrderBy *MessageAggregationOrder `json:"orderBy,omitempty"`
+	Limit   *int                     `json:"limit,omitempty"`
+}
+
+type MessageFilter struct {
+	Hash           []byte         `json:"hash,omitempty"`
+	SrcAddresses   []addr.Address `json:"srcAddresses,omitempty"`
+	DstAddresses   []addr.Address `json:"dstAddresses,omitempty"`
+	OperationID    *uint32        `json:"operationID,omitempty"`
+	SrcWorkchain   *int           `json:"srcWorkchain,omitempty"`
+	DstWorkchain   *int           `json:"dstWorkchain,omitempty"`
+	SrcContracts   []string       `json:"srcContracts,omitempty"`
+	DstContracts   []string       `json:"dstContracts,omitempty"`
+	OperationNames []string       `json:"operationNames,omitempty"`
+}
+
+type MessageHistoryFilter struct {
+	Metric         history.MessageMetric `json:"metric"`
+	SrcAddresses   []addr.Address        `json:"srcAddresses,omitempty"`
+	DstAddresses   []addr.Address        `json:"dstAddresses,omitempty"`
+	SrcWorkchain   *int                  `json:"srcWorkchain,omitempty"`
+	DstWorkchain   *int                  `json:"dstWorkchain,omitempty"`
+	SrcContracts   []string              `json:"srcContracts,omitempty"`
+	DstContracts   []string              `json:"dstContracts,omitempty"`
+	OperationNames []string              `json:"operationNames,omitempty"`
+	MinterAddress  *addr.Address         `json:"minterAddress,omitempty"`
+	Params         *HistoryParams        `json:"params"`
+}
+
+type OperationCount struct {
+	Operation string `json:"operation"`
+	Count     int    `json:"count"`
+}
+
+type TransactionFilter struct {
+	Hash      []byte         `json:"hash,omitempty"`
+	InMsgHash []byte         `json:"inMsgHash,omitempty"`
+	TraceID   []byte         `json:"traceID,omitempty"`
+	Addresses []addr.Address `json:"addresses,omitempty"`
+	Workchain *int           `json:"workchain,omitempty"`
+	Block     *BlockIDFilter `json:"block,omitempty"`
+}
+
+type TransactionHistoryFilter struct {
+	Metric    history.TransactionMetric `json:"metric"`
+	Addresses []addr.Address            `json:"addresses,omitempty"`
+	Workchain *int                      `json:"workchain,omitempty"`
+	Params    *HistoryParams            `json:"params"`
+}
+
+type MessageAggregationOrder string
+
+const (
+	MessageAggregationOrderAmount MessageAggregationOrder = "amount"
+	MessageAggregationOrderCount  MessageAggregationOrder = "count"
+)
+
+var AllMessageAggregationOrder = []MessageAggregationOrder{
+	MessageAggregationOrderAmount,
+	MessageAggregationOrderCount,
+}
+
+func (e MessageAggregationOrder) IsValid() bool {
+	switch e {
+	case MessageAggregationOrderAmount, MessageAggregationOrderCount:
+		return true
+	}
+	return false
+}
+
+func (e MessageAggregationOrder) String() string {
+	return string(e)
+}
+
+func (e *MessageAggregationOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MessageAggregationOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MessageAggregationOrder", str)
+	}
+	return nil
+}
+
+func (e MessageAggregationOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Order string
+
+const (
+	OrderAsc  Order = "ASC"
+	OrderDesc Order = "DESC"
+)
+
+var AllOrder = []Order{
+	OrderAsc,
+	OrderDesc,
+}
+
+func (e Order) IsValid() bool {
+	switch e {
+	case OrderAsc, OrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e Order) String() string {
+	return string(e)
+}
+
+func (e *Order) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Order(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Order", str)
+	}
+	return nil
+}
+
+func (e Order) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
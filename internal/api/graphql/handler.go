@@ -0,0 +1,40 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/stepandra/anton/internal/api/graphql/generated"
+	"github.com/stepandra/anton/internal/api/graphql/resolver"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	var gqlErr *gqlerror.Error
+	if !errors.Is(err, core.ErrInvalidArg) && !errors.As(err, &gqlErr) {
+		log.Error().Str("path", graphql.GetPath(ctx).String()).Err(err).Msg("internal server error")
+	}
+	return graphql.DefaultErrorPresenter(ctx, err)
+}
+
+// NewHandler returns GraphQL query handler on top of the query service.
+func NewHandler(svc app.QueryService) http.Handler {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers: resolver.New(svc),
+	}))
+	srv.SetErrorPresenter(presentError)
+	return srv
+}
+
+// NewPlaygroundHandler returns GraphQL playground page sending queries to the given endpoint.
+func NewPlaygroundHandler(endpoint string) http.Handler {
+	return playground.Handler("Anton GraphQL", endpoint)
+}
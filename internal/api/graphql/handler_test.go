@@ -0,0 +1,113 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+type queryServiceMock struct {
+	app.QueryService
+
+	blocksReq *filter.BlocksReq
+}
+
+func (m *queryServiceMock) FilterBlocks(_ context.Context, req *filter.BlocksReq) (*filter.BlocksRes, error) {
+	m.blocksReq = req
+
+	a := addr.MustFromBase64("EQDk2VTvn04SUKJrW7rXahzdF8_Qi6utb0wj43InCu9vdjrR")
+
+	return &filter.BlocksRes{Rows: []*core.Block{{
+		Workchain: -1,
+		Shard:     -9223372036854775808,
+		SeqNo:     1000,
+		Transactions: []*core.Transaction{{
+			Address:   *a,
+			Hash:      []byte{1, 2, 3},
+			CreatedLT: 42,
+			InMsg: &core.Message{
+				Type:   core.Internal,
+				Amount: bunbig.FromInt64(100),
+			},
+			Account: &core.AccountState{
+				Address: *a,
+				Status:  core.Active,
+				Balance: bunbig.FromInt64(1e9),
+				NFTContentData: core.NFTContentData{
+					ContentDecimals: new(uint8), // zero decimals must not be omitted
+				},
+			},
+		}},
+	}}}, nil
+}
+
+func query(t *testing.T, h http.Handler, q string) map[string]any {
+	body, err := json.Marshal(map[string]string{"query": q})
+	require.Nil(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res map[string]any
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	return res
+}
+
+func TestHandler_Blocks(t *testing.T) {
+	svc := new(queryServiceMock)
+	h := NewHandler(svc)
+
+	res := query(t, h, `{
+		blocks(limit: 1) {
+			rows {
+				seqNo
+				transactions {
+					hash
+					createdLT
+					inMsg { type amount }
+					account { status balance contentDecimals }
+				}
+			}
+		}
+	}`)
+	require.Nil(t, res["errors"])
+
+	require.NotNil(t, svc.blocksReq)
+	require.Equal(t, int32(-1), *svc.blocksReq.Workchain)
+	require.Equal(t, 1, svc.blocksReq.Limit)
+	require.Equal(t, "DESC", svc.blocksReq.Order)
+	require.True(t, svc.blocksReq.WithTransactions)
+	require.True(t, svc.blocksReq.WithTransactionMessages)
+	require.True(t, svc.blocksReq.WithTransactionAccountState)
+	require.False(t, svc.blocksReq.WithAccountStates)
+
+	tx := res["data"].(map[string]any)["blocks"].(map[string]any)["rows"].([]any)[0].(map[string]any)["transactions"].([]any)[0].(map[string]any)
+	require.Equal(t, "AQID", tx["hash"])
+	require.Equal(t, float64(42), tx["createdLT"])
+	require.Equal(t, map[string]any{"type": "INTERNAL", "amount": "100"}, tx["inMsg"])
+	require.Equal(t, map[string]any{"status": "ACTIVE", "balance": "1000000000", "contentDecimals": float64(0)}, tx["account"])
+}
+
+func TestHandler_LimitTooBig(t *testing.T) {
+	svc := new(queryServiceMock)
+	h := NewHandler(svc)
+
+	res := query(t, h, `{ blocks(limit: 1000) { total } }`)
+	require.NotNil(t, res["errors"])
+	require.Nil(t, svc.blocksReq)
+}
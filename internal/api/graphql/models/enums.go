@@ -0,0 +1,67 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+)
+
+// Enum values in the schema are equal to the values stored in the database,
+// so these types are bound to the domain types without any conversion.
+
+func marshalString[T ~string](v T) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(string(v)))
+	})
+}
+
+func unmarshalString[T ~string](v any) (T, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%T is not a string", v)
+	}
+	return T(s), nil
+}
+
+func MarshalAccountStatus(v core.AccountStatus) graphql.Marshaler { return marshalString(v) }
+
+func UnmarshalAccountStatus(v any) (core.AccountStatus, error) {
+	return unmarshalString[core.AccountStatus](v)
+}
+
+func MarshalMessageType(v core.MessageType) graphql.Marshaler { return marshalString(v) }
+
+func UnmarshalMessageType(v any) (core.MessageType, error) {
+	return unmarshalString[core.MessageType](v)
+}
+
+func MarshalLabelCategory(v core.LabelCategory) graphql.Marshaler { return marshalString(v) }
+
+func UnmarshalLabelCategory(v any) (core.LabelCategory, error) {
+	return unmarshalString[core.LabelCategory](v)
+}
+
+func MarshalAccountMetric(v history.AccountMetric) graphql.Marshaler { return marshalString(v) }
+
+func UnmarshalAccountMetric(v any) (history.AccountMetric, error) {
+	return unmarshalString[history.AccountMetric](v)
+}
+
+func MarshalTransactionMetric(v history.TransactionMetric) graphql.Marshaler {
+	return marshalString(v)
+}
+
+func UnmarshalTransactionMetric(v any) (history.TransactionMetric, error) {
+	return unmarshalString[history.TransactionMetric](v)
+}
+
+func MarshalMessageMetric(v history.MessageMetric) graphql.Marshaler { return marshalString(v) }
+
+func UnmarshalMessageMetric(v any) (history.MessageMetric, error) {
+	return unmarshalString[history.MessageMetric](v)
+}
@@ -0,0 +1,118 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/addr"
+)
+
+// MarshalAddress returns address in the same form as JSON API does.
+func MarshalAddress(a addr.Address) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		raw, err := a.MarshalJSON()
+		if err != nil {
+			_, _ = io.WriteString(w, "null")
+			return
+		}
+		_, _ = w.Write(raw)
+	})
+}
+
+// UnmarshalAddress accepts both raw (hex) and user-friendly (base64) addresses.
+func UnmarshalAddress(v any) (addr.Address, error) {
+	var a addr.Address
+
+	s, ok := v.(string)
+	if !ok {
+		return a, fmt.Errorf("%T is not an address string", v)
+	}
+	if err := a.UnmarshalText([]byte(s)); err != nil {
+		return a, err
+	}
+
+	return a, nil
+}
+
+func MarshalBytes(b []byte) graphql.Marshaler {
+	if b == nil {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(base64.StdEncoding.EncodeToString(b)))
+	})
+}
+
+func UnmarshalBytes(v any) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a base64 string", v)
+	}
+	return base64.StdEncoding.DecodeString(s)
+}
+
+// MarshalBigInt writes big integer as a string, as it does not fit into JSON number.
+func MarshalBigInt(i bunbig.Int) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(i.String()))
+	})
+}
+
+func UnmarshalBigInt(v any) (bunbig.Int, error) {
+	var s string
+
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case int:
+		s = strconv.Itoa(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	default:
+		return bunbig.Int{}, fmt.Errorf("%T is not a big integer", v)
+	}
+
+	i, err := new(bunbig.Int).FromString(s)
+	if err != nil {
+		return bunbig.Int{}, err
+	}
+
+	return *i, nil
+}
+
+func MarshalJSON(raw json.RawMessage) graphql.Marshaler {
+	if len(raw) == 0 {
+		return graphql.Null
+	}
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = w.Write(raw)
+	})
+}
+
+func UnmarshalJSON(v any) (json.RawMessage, error) {
+	return json.Marshal(v)
+}
+
+// MarshalDuration writes duration in Go format, for example, "1h30m".
+func MarshalDuration(d time.Duration) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		_, _ = io.WriteString(w, strconv.Quote(d.String()))
+	})
+}
+
+func UnmarshalDuration(v any) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("%T is not a duration string", v)
+	}
+	return time.ParseDuration(s)
+}
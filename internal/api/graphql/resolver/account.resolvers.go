@@ -0,0 +1,58 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stepandra/anton/internal/api/graphql/generated"
+	"github.com/stepandra/anton/internal/core"
+)
+
+// Types is the resolver for the types field.
+func (r *accountResolver) Types(ctx context.Context, obj *core.AccountState) ([]string, error) {
+	return getContractNameStrings(obj.Types), nil
+}
+
+// ExecutedGetMethods is the resolver for the executedGetMethods field.
+func (r *accountResolver) ExecutedGetMethods(ctx context.Context, obj *core.AccountState) (*json.RawMessage, error) {
+	if len(obj.ExecutedGetMethods) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(obj.ExecutedGetMethods)
+	if err != nil {
+		return nil, err
+	}
+	ret := json.RawMessage(raw)
+	return &ret, nil
+}
+
+// ContractData is the resolver for the contractData field.
+func (r *accountResolver) ContractData(ctx context.Context, obj *core.AccountState) (*json.RawMessage, error) {
+	if len(obj.ContractData) == 0 {
+		return nil, nil
+	}
+	raw, err := json.Marshal(obj.ContractData)
+	if err != nil {
+		return nil, err
+	}
+	ret := json.RawMessage(raw)
+	return &ret, nil
+}
+
+// ContentDecimals is the resolver for the contentDecimals field.
+func (r *accountResolver) ContentDecimals(ctx context.Context, obj *core.AccountState) (*int, error) {
+	if obj.ContentDecimals == nil {
+		return nil, nil
+	}
+	d := int(*obj.ContentDecimals)
+	return &d, nil
+}
+
+// Account returns generated.AccountResolver implementation.
+func (r *Resolver) Account() generated.AccountResolver { return &accountResolver{r} }
+
+type accountResolver struct{ *Resolver }
@@ -0,0 +1,393 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/api/graphql/generated"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+func checkLimit(limit *int, max int) (int, error) {
+	if limit == nil {
+		return 0, nil
+	}
+	if *limit < 0 {
+		return 0, errors.Wrapf(core.ErrInvalidArg, "limit cannot be negative")
+	}
+	if *limit > max {
+		return 0, errors.Wrapf(core.ErrInvalidArg, "limit is too big")
+	}
+	return *limit, nil
+}
+
+func getOrder(o *generated.Order) string {
+	if o == nil {
+		return "DESC"
+	}
+	return o.String()
+}
+
+func getBool(b *bool) bool {
+	return b != nil && *b
+}
+
+func getInt32(i *int) *int32 {
+	if i == nil {
+		return nil
+	}
+	ret := int32(*i)
+	return &ret
+}
+
+func getInt64(i *int) *int64 {
+	if i == nil {
+		return nil
+	}
+	ret := int64(*i)
+	return &ret
+}
+
+func getAddresses(in []addr.Address) (ret []*addr.Address) {
+	for i := range in {
+		ret = append(ret, &in[i])
+	}
+	return ret
+}
+
+func getContractNames(in []string) (ret []abi.ContractName) {
+	for _, n := range in {
+		ret = append(ret, abi.ContractName(n))
+	}
+	return ret
+}
+
+func getContractNameStrings(in []abi.ContractName) (ret []string) {
+	for _, n := range in {
+		ret = append(ret, string(n))
+	}
+	return ret
+}
+
+func getHistoryParams(p *generated.HistoryParams) (ret history.ReqParams) {
+	if p == nil {
+		return ret
+	}
+	if p.From != nil {
+		ret.From = *p.From
+	}
+	if p.To != nil {
+		ret.To = *p.To
+	}
+	ret.Interval = p.Interval
+	return ret
+}
+
+func getHistory(count history.CountRes, sum history.BigIntRes) *generated.History {
+	ret := new(generated.History)
+	for _, r := range count {
+		ret.CountResults = append(ret.CountResults, &generated.CountPoint{Timestamp: r.Timestamp, Value: r.Value})
+	}
+	for _, r := range sum {
+		ret.SumResults = append(ret.SumResults, &generated.BigIntPoint{Timestamp: r.Timestamp, Value: r.Value})
+	}
+	return ret
+}
+
+func (r *Resolver) filterBlocks(ctx context.Context, f *generated.BlockFilter, order *generated.Order, after *uint32, limit *int, count *bool) (*filter.BlocksRes, error) {
+	var (
+		req = filter.BlocksReq{Order: getOrder(order), AfterSeqNo: after, Count: getBool(count)}
+		err error
+	)
+
+	req.Limit, err = checkLimit(limit, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	if f != nil {
+		req.Workchain = getInt32(f.Workchain)
+		req.Shard = getInt64(f.Shard)
+		req.SeqNo = f.SeqNo
+		req.FileHash = f.FileHash
+	}
+	if mw := int32(-1); req.Workchain == nil {
+		req.Workchain = &mw
+	}
+
+	// load only relations requested by the query
+	for _, prefix := range [][]string{{"rows"}, {"rows", "shards"}} {
+		at := func(p ...string) []string { return append(append([]string{}, prefix...), p...) }
+
+		if fieldRequested(ctx, at("shards")...) {
+			req.WithShards = true
+		}
+		if fieldRequested(ctx, at("accounts")...) {
+			req.WithAccountStates = true
+		}
+		if fieldRequested(ctx, at("transactions")...) {
+			req.WithTransactions = true
+		}
+		if fieldRequested(ctx, at("transactions", "account")...) {
+			req.WithTransactionAccountState = true
+		}
+		if fieldRequested(ctx, at("transactions", "inMsg")...) || fieldRequested(ctx, at("transactions", "outMsg")...) {
+			req.WithTransactionMessages = true
+		}
+	}
+
+	return r.svc.FilterBlocks(ctx, &req)
+}
+
+func (r *Resolver) filterLabels(ctx context.Context, f *generated.LabelFilter, offset, limit *int) (*filter.LabelsRes, error) {
+	var (
+		req filter.LabelsReq
+		err error
+	)
+
+	req.Limit, err = checkLimit(limit, 10000)
+	if err != nil {
+		return nil, err
+	}
+	if offset != nil {
+		req.Offset = *offset
+	}
+
+	if f != nil {
+		if f.Name != nil {
+			req.Name = *f.Name
+		}
+		req.Categories = f.Categories
+	}
+
+	return r.svc.FilterLabels(ctx, &req)
+}
+
+func (r *Resolver) filterAccounts(ctx context.Context, f *generated.AccountFilter, order *generated.Order, after *uint64, limit *int, count *bool) (*filter.AccountsRes, error) {
+	var (
+		req = filter.AccountsReq{Order: getOrder(order), AfterTxLT: after, Count: getBool(count)}
+		err error
+	)
+
+	req.Limit, err = checkLimit(limit, 10000)
+	if err != nil {
+		return nil, err
+	}
+
+	if f != nil {
+		req.Addresses = getAddresses(f.Addresses)
+		req.LatestState = getBool(f.Latest)
+		req.Workchain = getInt32(f.Workchain)
+		req.Shard = getInt64(f.Shard)
+		req.BlockSeqNoLeq = f.BlockSeqNoLeq
+		req.BlockSeqNoBeq = f.BlockSeqNoBeq
+		req.ContractTypes = getContractNames(f.Interfaces)
+		req.OwnerAddress = f.OwnerAddress
+		req.MinterAddress = f.MinterAddress
+		if f.ContentSymbol != nil {
+			req.ContentSymbol = *f.ContentSymbol
+		}
+	}
+
+	req.WithCodeData = fieldRequested(ctx, "rows", "code") || fieldRequested(ctx, "rows", "data")
+
+	return r.svc.FilterAccounts(ctx, &req)
+}
+
+func (r *Resolver) aggregateAccounts(ctx context.Context, f *generated.AccountAggregationFilter) (*aggregate.AccountsRes, error) {
+	var req aggregate.AccountsReq
+
+	limit, err := checkLimit(f.Limit, 1000000)
+	if err != nil {
+		return nil, err
+	}
+	req.Limit = uint64(limit)
+
+	req.Address = f.Address
+	req.MinterAddress = f.MinterAddress
+
+	return r.svc.AggregateAccounts(ctx, &req)
+}
+
+func (r *Resolver) accountsHistory(ctx context.Context, f *generated.AccountHistoryFilter) (*generated.History, error) {
+	req := history.AccountsReq{
+		Metric:        f.Metric,
+		ContractTypes: getContractNames(f.Interfaces),
+		MinterAddress: f.MinterAddress,
+		OwnerAddress:  f.OwnerAddress,
+		ReqParams:     getHistoryParams(f.Params),
+	}
+
+	ret, err := r.svc.AggregateAccountsHistory(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return getHistory(ret.CountRes, ret.BigIntRes), nil
+}
+
+func (r *Resolver) jettonHolders(ctx context.Context, f *generated.JettonHoldersFilter) (*aggregate.HoldersRes, error) {
+	req := aggregate.HoldersReq{MinterAddress: &f.MinterAddress}
+
+	limit, err := checkLimit(f.Limit, 10000)
+	if err != nil {
+		return nil, err
+	}
+	req.Limit = limit
+
+	if f.MasterSeqNo != nil {
+		req.MasterSeqNo = *f.MasterSeqNo
+	}
+	if f.Offset != nil {
+		req.Offset = *f.Offset
+	}
+
+	return r.svc.AggregateHolders(ctx, &req)
+}
+
+func (r *Resolver) filterTransactions(ctx context.Context, f *generated.TransactionFilter, order *generated.Order, after *uint64, limit *int, count *bool) (*filter.TransactionsRes, error) {
+	var (
+		req = filter.TransactionsReq{Order: getOrder(order), AfterTxLT: after, Count: getBool(count)}
+		err error
+	)
+
+	req.Limit, err = checkLimit(limit, 10000)
+	if err != nil {
+		return nil, err
+	}
+
+	if f != nil {
+		req.Hash = f.Hash
+		req.InMsgHash = f.InMsgHash
+		req.TraceID = f.TraceID
+		req.Addresses = getAddresses(f.Addresses)
+		req.Workchain = getInt32(f.Workchain)
+		if f.Block != nil {
+			req.BlockID = &core.BlockID{
+				Workchain: int32(f.Block.Workchain),
+				Shard:     int64(f.Block.Shard),
+				SeqNo:     f.Block.SeqNo,
+			}
+		}
+	}
+
+	req.WithAccountState = fieldRequested(ctx, "rows", "account")
+	req.WithMessages = fieldRequested(ctx, "rows", "inMsg") || fieldRequested(ctx, "rows", "outMsg")
+
+	return r.svc.FilterTransactions(ctx, &req)
+}
+
+func (r *Resolver) transactionsHistory(ctx context.Context, f *generated.TransactionHistoryFilter) (*generated.History, error) {
+	req := history.TransactionsReq{
+		Metric:    f.Metric,
+		Addresses: getAddresses(f.Addresses),
+		Workchain: getInt32(f.Workchain),
+		ReqParams: getHistoryParams(f.Params),
+	}
+
+	ret, err := r.svc.AggregateTransactionsHistory(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return getHistory(ret.CountRes, nil), nil
+}
+
+func (r *Resolver) filterMessages(ctx context.Context, f *generated.MessageFilter, order *generated.Order, after *uint64, limit *int, count *bool) (*filter.MessagesRes, error) {
+	var (
+		req = filter.MessagesReq{Order: getOrder(order), AfterTxLT: after, Count: getBool(count)}
+		err error
+	)
+
+	req.Limit, err = checkLimit(limit, 10000)
+	if err != nil {
+		return nil, err
+	}
+
+	if f != nil {
+		req.Hash = f.Hash
+		req.SrcAddresses = getAddresses(f.SrcAddresses)
+		req.DstAddresses = getAddresses(f.DstAddresses)
+		req.OperationID = f.OperationID
+		req.SrcWorkchain = getInt32(f.SrcWorkchain)
+		req.DstWorkchain = getInt32(f.DstWorkchain)
+		req.SrcContracts = f.SrcContracts
+		req.DstContracts = f.DstContracts
+		req.OperationNames = f.OperationNames
+	}
+
+	return r.svc.FilterMessages(ctx, &req)
+}
+
+func (r *Resolver) aggregateMessages(ctx context.Context, f *generated.MessageAggregationFilter) (*generated.MessageAggregation, error) {
+	var (
+		req = aggregate.MessagesReq{Address: &f.Address, OrderBy: "amount"}
+		err error
+	)
+
+	req.Limit, err = checkLimit(f.Limit, 1000000)
+	if err != nil {
+		return nil, err
+	}
+	if f.From != nil {
+		req.From = *f.From
+	}
+	if f.To != nil {
+		req.To = *f.To
+	}
+	if f.OrderBy != nil {
+		req.OrderBy = f.OrderBy.String()
+	}
+
+	res, err := r.svc.AggregateMessages(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &generated.MessageAggregation{
+		ReceivedCount:       res.RecvCount,
+		ReceivedTonAmount:   res.RecvAmount,
+		SentCount:           res.SentCount,
+		SentTonAmount:       res.SentAmount,
+		ReceivedFromAddress: []*generated.AddressAmount{},
+		SentToAddress:       []*generated.AddressAmount{},
+	}
+	for _, r := range res.RecvByAddress {
+		ret.ReceivedFromAddress = append(ret.ReceivedFromAddress,
+			&generated.AddressAmount{Address: r.Sender, Amount: r.Amount, Count: r.Count})
+	}
+	for _, r := range res.SentByAddress {
+		ret.SentToAddress = append(ret.SentToAddress,
+			&generated.AddressAmount{Address: r.Receiver, Amount: r.Amount, Count: r.Count})
+	}
+
+	return ret, nil
+}
+
+func (r *Resolver) messagesHistory(ctx context.Context, f *generated.MessageHistoryFilter) (*generated.History, error) {
+	req := history.MessagesReq{
+		Metric:         f.Metric,
+		SrcAddresses:   getAddresses(f.SrcAddresses),
+		DstAddresses:   getAddresses(f.DstAddresses),
+		SrcWorkchain:   getInt32(f.SrcWorkchain),
+		DstWorkchain:   getInt32(f.DstWorkchain),
+		SrcContracts:   f.SrcContracts,
+		DstContracts:   f.DstContracts,
+		OperationNames: f.OperationNames,
+		MinterAddress:  f.MinterAddress,
+		ReqParams:      getHistoryParams(f.Params),
+	}
+
+	ret, err := r.svc.AggregateMessagesHistory(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return getHistory(ret.CountRes, ret.BigIntRes), nil
+}
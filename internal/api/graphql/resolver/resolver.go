@@ -0,0 +1,48 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/stepandra/anton/internal/app"
+)
+
+// This file will not be regenerated automatically.
+//
+// It serves as dependency injection for your app, add any dependencies you require here.
+
+type Resolver struct {
+	svc app.QueryService
+}
+
+func New(svc app.QueryService) *Resolver {
+	return &Resolver{svc: svc}
+}
+
+// fieldRequested checks if the field under the given path is selected in the current query.
+// It is used to decide which relations should be loaded from the database.
+func fieldRequested(ctx context.Context, path ...string) bool {
+	opCtx := graphql.GetOperationContext(ctx)
+
+	fields := graphql.CollectFieldsCtx(ctx, nil)
+	for _, name := range path {
+		var (
+			found bool
+			next  []graphql.CollectedField
+		)
+		for _, f := range fields {
+			if f.Name != name {
+				continue
+			}
+			found = true
+			next = append(next, graphql.CollectFields(opCtx, f.Selections, nil)...)
+		}
+		if !found {
+			return false
+		}
+		fields = next
+	}
+
+	return true
+}
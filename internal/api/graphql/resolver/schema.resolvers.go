@@ -0,0 +1,108 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+
+	"github.com/stepandra/anton/internal/api/graphql/generated"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+// Interfaces is the resolver for the interfaces field.
+func (r *addressTypesCountResolver) Interfaces(ctx context.Context, obj *aggregate.AddressTypesCount) ([]string, error) {
+	return getContractNameStrings(obj.Interfaces), nil
+}
+
+// Statistics is the resolver for the statistics field.
+func (r *queryResolver) Statistics(ctx context.Context) (*aggregate.Statistics, error) {
+	return r.svc.GetStatistics(ctx)
+}
+
+// Blocks is the resolver for the blocks field.
+func (r *queryResolver) Blocks(ctx context.Context, filter *generated.BlockFilter, order *generated.Order, after *uint32, limit *int, count *bool) (*filter.BlocksRes, error) {
+	return r.filterBlocks(ctx, filter, order, after, limit, count)
+}
+
+// Labels is the resolver for the labels field.
+func (r *queryResolver) Labels(ctx context.Context, filter *generated.LabelFilter, offset *int, limit *int) (*filter.LabelsRes, error) {
+	return r.filterLabels(ctx, filter, offset, limit)
+}
+
+// LabelCategories is the resolver for the labelCategories field.
+func (r *queryResolver) LabelCategories(ctx context.Context) ([]core.LabelCategory, error) {
+	return r.svc.GetLabelCategories(ctx)
+}
+
+// Accounts is the resolver for the accounts field.
+func (r *queryResolver) Accounts(ctx context.Context, filter *generated.AccountFilter, order *generated.Order, after *uint64, limit *int, count *bool) (*filter.AccountsRes, error) {
+	return r.filterAccounts(ctx, filter, order, after, limit, count)
+}
+
+// AggregateAccounts is the resolver for the aggregateAccounts field.
+func (r *queryResolver) AggregateAccounts(ctx context.Context, filter generated.AccountAggregationFilter) (*aggregate.AccountsRes, error) {
+	return r.aggregateAccounts(ctx, &filter)
+}
+
+// AccountsHistory is the resolver for the accountsHistory field.
+func (r *queryResolver) AccountsHistory(ctx context.Context, filter generated.AccountHistoryFilter) (*generated.History, error) {
+	return r.accountsHistory(ctx, &filter)
+}
+
+// JettonHolders is the resolver for the jettonHolders field.
+func (r *queryResolver) JettonHolders(ctx context.Context, filter generated.JettonHoldersFilter) (*aggregate.HoldersRes, error) {
+	return r.jettonHolders(ctx, &filter)
+}
+
+// Transactions is the resolver for the transactions field.
+func (r *queryResolver) Transactions(ctx context.Context, filter *generated.TransactionFilter, order *generated.Order, after *uint64, limit *int, count *bool) (*filter.TransactionsRes, error) {
+	return r.filterTransactions(ctx, filter, order, after, limit, count)
+}
+
+// TransactionsHistory is the resolver for the transactionsHistory field.
+func (r *queryResolver) TransactionsHistory(ctx context.Context, filter generated.TransactionHistoryFilter) (*generated.History, error) {
+	return r.transactionsHistory(ctx, &filter)
+}
+
+// Messages is the resolver for the messages field.
+func (r *queryResolver) Messages(ctx context.Context, filter *generated.MessageFilter, order *generated.Order, after *uint64, limit *int, count *bool) (*filter.MessagesRes, error) {
+	return r.filterMessages(ctx, filter, order, after, limit, count)
+}
+
+// AggregateMessages is the resolver for the aggregateMessages field.
+func (r *queryResolver) AggregateMessages(ctx context.Context, filter generated.MessageAggregationFilter) (*generated.MessageAggregation, error) {
+	return r.aggregateMessages(ctx, &filter)
+}
+
+// MessagesHistory is the resolver for the messagesHistory field.
+func (r *queryResolver) MessagesHistory(ctx context.Context, filter generated.MessageHistoryFilter) (*generated.History, error) {
+	return r.messagesHistory(ctx, &filter)
+}
+
+// MessageTypesCount is the resolver for the messageTypesCount field.
+func (r *statisticsResolver) MessageTypesCount(ctx context.Context, obj *aggregate.Statistics) ([]*generated.OperationCount, error) {
+	var ret []*generated.OperationCount
+	for _, c := range obj.MessageTypesCount {
+		ret = append(ret, &generated.OperationCount{Operation: c.Operation, Count: c.Count})
+	}
+	return ret, nil
+}
+
+// AddressTypesCount returns generated.AddressTypesCountResolver implementation.
+func (r *Resolver) AddressTypesCount() generated.AddressTypesCountResolver {
+	return &addressTypesCountResolver{r}
+}
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+// Statistics returns generated.StatisticsResolver implementation.
+func (r *Resolver) Statistics() generated.StatisticsResolver { return &statisticsResolver{r} }
+
+type addressTypesCountResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type statisticsResolver struct{ *Resolver }
@@ -0,0 +1,53 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.40
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stepandra/anton/internal/api/graphql/generated"
+	"github.com/stepandra/anton/internal/core"
+)
+
+// SrcContract is the resolver for the srcContract field.
+func (r *messageResolver) SrcContract(ctx context.Context, obj *core.Message) (*string, error) {
+	if obj.SrcContract == "" {
+		return nil, nil
+	}
+	ret := string(obj.SrcContract)
+	return &ret, nil
+}
+
+// DstContract is the resolver for the dstContract field.
+func (r *messageResolver) DstContract(ctx context.Context, obj *core.Message) (*string, error) {
+	if obj.DstContract == "" {
+		return nil, nil
+	}
+	ret := string(obj.DstContract)
+	return &ret, nil
+}
+
+// DataJSON is the resolver for the data field.
+func (r *messageResolver) DataJSON(ctx context.Context, obj *core.Message) (*json.RawMessage, error) {
+	if len(obj.DataJSON) == 0 {
+		return nil, nil
+	}
+	return &obj.DataJSON, nil
+}
+
+// OutMsgCount is the resolver for the outMsgCount field.
+func (r *transactionResolver) OutMsgCount(ctx context.Context, obj *core.Transaction) (int, error) {
+	return int(obj.OutMsgCount), nil
+}
+
+// Message returns generated.MessageResolver implementation.
+func (r *Resolver) Message() generated.MessageResolver { return &messageResolver{r} }
+
+// Transaction returns generated.TransactionResolver implementation.
+func (r *Resolver) Transaction() generated.TransactionResolver { return &transactionResolver{r} }
+
+type messageResolver struct{ *Resolver }
+type transactionResolver struct{ *Resolver }
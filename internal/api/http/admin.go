@@ -0,0 +1,121 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ AdminController = (*Admin)(nil)
+
+type Admin struct {
+	svc app.ContractService
+}
+
+func NewAdmin(svc app.ContractService) *Admin {
+	return &Admin{svc: svc}
+}
+
+func contractErr(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, core.ErrNotFound):
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, core.ErrAlreadyExists):
+		ctx.IndentedJSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		internalErr(ctx, err)
+	}
+}
+
+// AddInterfaces godoc
+//
+//	@Summary		add contract interfaces
+//	@Description	Inserts new contract interfaces with their definitions and operations and adds rescan tasks for them.
+//	@Description	Request body has the same format as abi/known json files.
+//	@Tags			contract
+//	@Accept			json
+//	@Produce		json
+//	@Security		BasicAuth
+//	@Param   		interfaces			body	[]abi.InterfaceDesc	true	"contract interfaces"
+//	@Success		200		{object}	app.ContractChangeRes
+//	@Failure		400		{object}	gin.H
+//	@Failure		401		{object}	gin.H
+//	@Failure		409		{object}	gin.H
+//	@Router			/contracts/interfaces [post]
+func (c *Admin) AddInterfaces(ctx *gin.Context) {
+	var desc []*abi.InterfaceDesc
+
+	if err := ctx.ShouldBindJSON(&desc); err != nil {
+		paramErr(ctx, "interfaces", err)
+		return
+	}
+
+	ret, err := c.svc.AddInterfaces(ctx, desc)
+	if err != nil {
+		contractErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// UpdateInterface godoc
+//
+//	@Summary		update contract interface
+//	@Description	Updates contract interface and adds rescan tasks for the difference between old and new interfaces.
+//	@Description	Request body has the same format as abi/known json files, it must contain the updated interface.
+//	@Tags			contract
+//	@Accept			json
+//	@Produce		json
+//	@Security		BasicAuth
+//	@Param   		name				path	string  			true	"contract interface name"
+//	@Param   		interfaces			body	[]abi.InterfaceDesc	true	"contract interfaces"
+//	@Success		200		{object}	app.ContractChangeRes
+//	@Failure		400		{object}	gin.H
+//	@Failure		401		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Router			/contracts/interfaces/{name} [put]
+func (c *Admin) UpdateInterface(ctx *gin.Context) {
+	var desc []*abi.InterfaceDesc
+
+	if err := ctx.ShouldBindJSON(&desc); err != nil {
+		paramErr(ctx, "interfaces", err)
+		return
+	}
+
+	ret, err := c.svc.UpdateInterface(ctx, abi.ContractName(ctx.Param("name")), desc, nil)
+	if err != nil {
+		contractErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// DeleteInterface godoc
+//
+//	@Summary		delete contract interface
+//	@Description	Deletes contract interface and adds rescan tasks removing its parsed data.
+//	@Tags			contract
+//	@Produce		json
+//	@Security		BasicAuth
+//	@Param   		name				path	string  	true	"contract interface name"
+//	@Success		200		{object}	app.ContractChangeRes
+//	@Failure		400		{object}	gin.H
+//	@Failure		401		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Router			/contracts/interfaces/{name} [delete]
+func (c *Admin) DeleteInterface(ctx *gin.Context) {
+	ret, err := c.svc.DeleteInterface(ctx, abi.ContractName(ctx.Param("name")))
+	if err != nil {
+		contractErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+type mockContractService struct {
+	added []*abi.InterfaceDesc
+}
+
+func (m *mockContractService) AddInterfaces(_ context.Context, desc []*abi.InterfaceDesc) (*app.ContractChangeRes, error) {
+	m.added = desc
+	return &app.ContractChangeRes{RescanTasks: []int{1, 2}}, nil
+}
+
+func (m *mockContractService) UpdateInterface(_ context.Context, name abi.ContractName, _ []*abi.InterfaceDesc, _ *core.RescanScope) (*app.ContractChangeRes, error) {
+	return nil, errors.Wrapf(core.ErrNotFound, "get '%s' interface", name)
+}
+
+func (m *mockContractService) DeleteInterface(_ context.Context, _ abi.ContractName) (*app.ContractChangeRes, error) {
+	return &app.ContractChangeRes{RescanTasks: []int{3}}, nil
+}
+
+func TestAdmin_Interfaces(t *testing.T) {
+	svc := new(mockContractService)
+
+	s := NewServer("")
+	s.RegisterAdminRoutes(NewAdmin(svc), gin.Accounts{"admin": "secret"})
+
+	do := func(method, path, body string, auth bool) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, basePath+path, strings.NewReader(body))
+		if auth {
+			req.SetBasicAuth("admin", "secret")
+		}
+		s.router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := do(http.MethodPost, "/contracts/interfaces", `[{"interface_name":"wallet"}]`, false)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Nil(t, svc.added)
+
+	w = do(http.MethodPost, "/contracts/interfaces", `[{"interface_name":"wallet"}]`, true)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Len(t, svc.added, 1)
+	require.Equal(t, abi.ContractName("wallet"), svc.added[0].Name)
+
+	var res app.ContractChangeRes
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.Equal(t, []int{1, 2}, res.RescanTasks)
+
+	w = do(http.MethodPost, "/contracts/interfaces", `{`, true)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = do(http.MethodPut, "/contracts/interfaces/wallet", `[]`, true)
+	require.Equal(t, http.StatusNotFound, w.Code)
+
+	w = do(http.MethodDelete, "/contracts/interfaces/wallet", ``, true)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"rescan_tasks": [`)
+}
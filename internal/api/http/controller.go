@@ -218,6 +218,41 @@ func (c *Controller) GetDefinitions(ctx *gin.Context) {
 	ctx.IndentedJSON(http.StatusOK, GetDefinitionsRes{Total: len(ret), Results: ret})
 }
 
+type GetRescanTasksRes struct {
+	Total   int                `json:"total"`
+	Results []*core.RescanTask `json:"results"`
+}
+
+// GetRescanTasks godoc
+//
+//	@Summary		rescan tasks
+//	@Description	Returns unfinished rescan tasks in the order they are run, with their progress and estimated time of finishing.
+//	@Description	Progress is the share of rows matched on the task start, which are already scanned.
+//	@Tags			contract
+//	@Accept			json
+//	@Produce		json
+//	@Param   		all				query	bool  	false	"return finished and cancelled tasks too"
+//	@Success		200		{object}		GetRescanTasksRes
+//	@Failure		400		{object}	gin.H
+//	@Router			/rescan/tasks [get]
+func (c *Controller) GetRescanTasks(ctx *gin.Context) {
+	var all bool
+	if a := ctx.Query("all"); a != "" {
+		var err error
+		if all, err = strconv.ParseBool(a); err != nil {
+			paramErr(ctx, "all", err)
+			return
+		}
+	}
+
+	ret, err := c.svc.GetRescanTasks(ctx, all)
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, GetRescanTasksRes{Total: len(ret), Results: ret})
+}
+
 // GetBlocks godoc
 //
 //	@Summary		block info
@@ -345,6 +380,7 @@ func (c *Controller) GetLabels(ctx *gin.Context) {
 //	@Param   		interface			query	[]string  	false	"filter by interfaces"
 //	@Param   		owner_address		query	string  	false	"filter FT wallets or NFT items by owner address"
 //	@Param   		minter_address		query	string  	false	"filter FT wallets or NFT items by minter address"
+//	@Param   		content_symbol		query	string  	false	"filter FT or NFT minters by TEP-64 token symbol"
 //	@Param			order				query	string		false	"order by last_tx_lt"						Enums(ASC, DESC) default(DESC)
 //	@Param   		after	     		query   int 		false	"start from this last_tx_lt"
 //	@Param   		limit	     		query   int 		false	"limit"										default(3) maximum(10000)
@@ -446,13 +482,14 @@ func (c *Controller) AggregateAccounts(ctx *gin.Context) {
 // AggregateAccountsHistory godoc
 //
 //	@Summary		aggregated accounts grouped by timestamp
-//	@Description	Counts accounts
+//	@Description	Counts accounts, jetton holders or shows jetton balance history of the owner
 //	@Tags			account
 //	@Accept			json
 //	@Produce		json
-//	@Param   		metric				query	string  	true	"metric to show"			Enums(active_addresses)
+//	@Param   		metric				query	string  	true	"metric to show"			Enums(active_addresses, jetton_balance, holder_count)
 //	@Param   		interface			query	[]string  	false	"filter by interfaces"
 //	@Param   		minter_address		query	string  	false	"NFT collection or FT master address"
+//	@Param   		owner_address		query	string  	false	"jetton wallet owner address (for jetton_balance metric)"
 //	@Param   		from				query	string  	false	"from timestamp"
 //	@Param   		to					query	string  	false	"to timestamp"
 //	@Param   		interval			query	string  	true	"group interval"			Enums(24h, 8h, 4h, 1h, 15m)
@@ -472,6 +509,11 @@ func (c *Controller) AggregateAccountsHistory(ctx *gin.Context) {
 		paramErr(ctx, "minter_address", err)
 		return
 	}
+	req.OwnerAddress, err = unmarshalAddress(ctx.Query("owner_address"))
+	if err != nil {
+		paramErr(ctx, "owner_address", err)
+		return
+	}
 
 	ret, err := c.svc.AggregateAccountsHistory(ctx, &req)
 	if err != nil {
@@ -482,6 +524,193 @@ func (c *Controller) AggregateAccountsHistory(ctx *gin.Context) {
 	ctx.IndentedJSON(http.StatusOK, ret)
 }
 
+// AggregateHolders godoc
+//
+//	@Summary		jetton holders
+//	@Description	Returns jetton wallets with non-zero balance at the given masterchain block
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param   		minter_address		query	string  	true	"FT master address"
+//	@Param   		master_seq_no		query	int  		false	"masterchain block seq_no (the latest state by default)"
+//	@Param   		offset	     		query   int 		false	"offset"
+//	@Param   		limit	     		query   int 		false	"limit"										default(25) maximum(10000)
+//	@Success		200		{object}	aggregate.HoldersRes
+//	@Failure		400		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Failure		500		{object}	gin.H
+//	@Router			/accounts/holders [get]
+func (c *Controller) AggregateHolders(ctx *gin.Context) {
+	req := aggregate.HoldersReq{Limit: 25}
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "holders_filter", err)
+		return
+	}
+	if req.Limit > 10000 {
+		paramErr(ctx, "limit", errors.Wrapf(core.ErrInvalidArg, "limit is too big"))
+		return
+	}
+
+	req.MinterAddress, err = unmarshalAddress(ctx.Query("minter_address"))
+	if err != nil {
+		paramErr(ctx, "minter_address", err)
+		return
+	}
+
+	ret, err := c.svc.AggregateHolders(ctx, &req)
+	if errors.Is(err, core.ErrNotFound) {
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// GetTokenMetadata godoc
+//
+//	@Summary		off-chain token metadata
+//	@Description	Returns fetched metadata document referenced by TEP-64 content URI
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param   		uri					query	string  	true	"content URI"
+//	@Param   		content_hash		query	string  	false	"sha256 of the document in hex or base64 (the latest document by default)"
+//	@Success		200		{object}	core.TokenMetadata
+//	@Failure		400		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Failure		500		{object}	gin.H
+//	@Router			/metadata [get]
+func (c *Controller) GetTokenMetadata(ctx *gin.Context) {
+	uri := ctx.Query("uri")
+	if uri == "" {
+		paramErr(ctx, "uri", errors.Wrap(core.ErrInvalidArg, "empty uri"))
+		return
+	}
+
+	hash, err := unmarshalBytes(ctx.Query("content_hash"))
+	if err != nil {
+		paramErr(ctx, "content_hash", err)
+		return
+	}
+
+	ret, err := c.svc.GetTokenMetadata(ctx, uri, hash)
+	if errors.Is(err, core.ErrNotFound) {
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// ExecuteGetMethod godoc
+//
+//	@Summary		execute get-method
+//	@Description	Emulates get-method on the stored account state.
+//	@Description	Arguments and return values are described by the given contract interface or passed ad-hoc.
+//	@Description	If the interface and return values are omitted, the raw stack is returned.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param   		address				path	string  			true	"account address"
+//	@Param   		name				path	string  			true	"get-method name"
+//	@Param   		request				body	app.GetMethodReq  	false	"account state, interface and arguments"
+//	@Success		200		{object}	abi.GetMethodExecution
+//	@Failure		400		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Failure		500		{object}	gin.H
+//	@Router			/accounts/{address}/get-methods/{name} [post]
+func (c *Controller) ExecuteGetMethod(ctx *gin.Context) {
+	var req app.GetMethodReq
+
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			paramErr(ctx, "request", err)
+			return
+		}
+	}
+
+	a, err := unmarshalAddress(ctx.Param("address"))
+	if err != nil {
+		paramErr(ctx, "address", err)
+		return
+	}
+	if a == nil {
+		paramErr(ctx, "address", errors.Wrap(core.ErrInvalidArg, "empty address"))
+		return
+	}
+	req.Address = *a
+	req.GetMethod = ctx.Param("name")
+
+	ret, err := c.svc.ExecuteGetMethod(ctx, &req)
+	if errors.Is(err, core.ErrNotFound) {
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// EmulateMessage godoc
+//
+//	@Summary		emulate message
+//	@Description	Emulates the transaction caused by the internal or external incoming message on the stored account state.
+//	@Description	Messages of the emulated transaction are decoded with known contract interfaces.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param   		address				path	string  				true	"account address"
+//	@Param   		request				body	app.EmulateMessageReq  	true	"message boc and account state"
+//	@Success		200		{object}	app.EmulateMessageRes
+//	@Failure		400		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Failure		500		{object}	gin.H
+//	@Router			/accounts/{address}/emulate [post]
+func (c *Controller) EmulateMessage(ctx *gin.Context) {
+	var req app.EmulateMessageReq
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		paramErr(ctx, "request", err)
+		return
+	}
+
+	a, err := unmarshalAddress(ctx.Param("address"))
+	if err != nil {
+		paramErr(ctx, "address", err)
+		return
+	}
+	if a == nil {
+		paramErr(ctx, "address", errors.Wrap(core.ErrInvalidArg, "empty address"))
+		return
+	}
+	req.Address = *a
+
+	ret, err := c.svc.EmulateMessage(ctx, &req)
+	if errors.Is(err, core.ErrNotFound) {
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
 // GetTransactions godoc
 //
 //	@Summary		transactions data
@@ -492,6 +721,7 @@ func (c *Controller) AggregateAccountsHistory(ctx *gin.Context) {
 //	@Param   		address     		query   []string 	false   "only given addresses"
 //	@Param   		hash				query	string  	false	"search by tx hash"
 //	@Param   		in_msg_hash			query	string  	false	"search by incoming message hash"
+//	@Param   		trace_id			query	string  	false	"search by trace id"
 //	@Param   		workchain			query	int32  		false	"filter by workchain"
 //	@Param			created_lt			query	uint64		false	"search by created_lt"
 //	@Param			order				query	string		false	"order by created_lt"			Enums(ASC, DESC) default(DESC)
@@ -523,6 +753,11 @@ func (c *Controller) GetTransactions(ctx *gin.Context) {
 		paramErr(ctx, "in_msg_hash", err)
 		return
 	}
+	req.TraceID, err = unmarshalBytes(ctx.Query("trace_id"))
+	if err != nil {
+		paramErr(ctx, "trace_id", err)
+		return
+	}
 
 	req.WithAccountState = true
 	req.WithMessages = true
@@ -586,6 +821,40 @@ func (c *Controller) AggregateTransactionsHistory(ctx *gin.Context) {
 	ctx.IndentedJSON(http.StatusOK, ret)
 }
 
+// GetTrace godoc
+//
+//	@Summary		transaction trace
+//	@Description	Returns the tree of transactions caused by the root transaction, for example, by the external message.
+//	@Description	Trace id is the hash of the root transaction.
+//	@Tags			transaction
+//	@Accept			json
+//	@Produce		json
+//	@Param   		id					path	string  	true	"trace id"
+//	@Success		200		{object}	app.Trace
+//	@Failure		400		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Failure		500		{object}	gin.H
+//	@Router			/traces/{id} [get]
+func (c *Controller) GetTrace(ctx *gin.Context) {
+	id, err := unmarshalBytes(ctx.Param("id"))
+	if err != nil {
+		paramErr(ctx, "id", err)
+		return
+	}
+
+	ret, err := c.svc.GetTrace(ctx, id)
+	if errors.Is(err, core.ErrNotFound) {
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
 // GetMessages godoc
 //
 //	@Summary		transaction messages
@@ -763,3 +1032,332 @@ func (c *Controller) AggregateMessagesHistory(ctx *gin.Context) {
 
 	ctx.IndentedJSON(http.StatusOK, ret)
 }
+
+func checkJettonTransferStatus(s core.JettonTransferStatus) error {
+	switch s {
+	case "", core.JettonTransferSuccess, core.JettonTransferBounced:
+		return nil
+	default:
+		return errors.Wrapf(core.ErrInvalidArg, "unknown jetton transfer status %s", s)
+	}
+}
+
+// GetJettonTransfers godoc
+//
+//	@Summary		jetton transfers
+//	@Description	Returns jetton transfers between jetton wallets with their owners and minter
+//	@Tags			transaction
+//	@Accept			json
+//	@Produce		json
+//	@Param   		msg_hash			query	string  	false	"search by jetton_internal_transfer message hash"
+//	@Param   		trace_id			query	string  	false	"search by trace id"
+//	@Param   		minter_address		query	string  	false	"jetton minter address"
+//	@Param   		src_owner     		query   []string 	false   "sender address"
+//	@Param   		dst_owner     		query   []string 	false   "receiver address"
+//	@Param   		owner     			query   []string 	false   "sender or receiver address"
+//	@Param   		query_id			query	uint64  	false	"query id of the transfer"
+//	@Param   		status				query	string  	false	"transfer status"				Enums(success, bounced)
+//	@Param			order				query	string		false	"order by created_lt"			Enums(ASC, DESC) default(DESC)
+//	@Param   		after	     		query   int 		false	"start from this created_lt"
+//	@Param   		limit	     		query   int 		false	"limit"							default(3) maximum(10000)
+//	@Param   		count	     		query   bool 		false	"count total number of rows"	default(false)
+//	@Success		200		{object}	filter.JettonTransfersRes
+//	@Failure		400		{object}	gin.H
+//	@Router			/jettons/transfers [get]
+func (c *Controller) GetJettonTransfers(ctx *gin.Context) {
+	var req filter.JettonTransfersReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "transfer_filter", err)
+		return
+	}
+	if req.Limit > 10000 {
+		paramErr(ctx, "limit", errors.Wrapf(core.ErrInvalidArg, "limit is too big"))
+		return
+	}
+	if err := checkJettonTransferStatus(req.Status); err != nil {
+		paramErr(ctx, "status", err)
+		return
+	}
+
+	req.MsgHash, err = unmarshalBytes(ctx.Query("msg_hash"))
+	if err != nil {
+		paramErr(ctx, "msg_hash", err)
+		return
+	}
+	req.TraceID, err = unmarshalBytes(ctx.Query("trace_id"))
+	if err != nil {
+		paramErr(ctx, "trace_id", err)
+		return
+	}
+	req.MinterAddress, err = unmarshalAddress(ctx.Query("minter_address"))
+	if err != nil {
+		paramErr(ctx, "minter_address", err)
+		return
+	}
+	req.SrcOwners, err = getAddresses(ctx, "src_owner")
+	if err != nil {
+		paramErr(ctx, "src_owner", err)
+		return
+	}
+	req.DstOwners, err = getAddresses(ctx, "dst_owner")
+	if err != nil {
+		paramErr(ctx, "dst_owner", err)
+		return
+	}
+	req.Owners, err = getAddresses(ctx, "owner")
+	if err != nil {
+		paramErr(ctx, "owner", err)
+		return
+	}
+
+	req.Order, err = unmarshalSorting(req.Order)
+	if err != nil {
+		paramErr(ctx, "order", err)
+		return
+	}
+
+	ret, err := c.svc.FilterJettonTransfers(ctx, &req)
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// AggregateJettonTransfersHistory godoc
+//
+//	@Summary		aggregated jetton transfers grouped by timestamp
+//	@Description	Counts jetton transfers or sums transferred amount of the given jetton
+//	@Tags			transaction
+//	@Accept			json
+//	@Produce		json
+//	@Param   		metric				query	string  	true	"metric to show"								Enums(transfer_count, transfer_amount_sum)
+//	@Param   		minter_address		query	string  	false	"jetton minter address, required for the amount sum"
+//	@Param   		src_owner     		query   []string 	false   "sender address"
+//	@Param   		dst_owner     		query   []string 	false   "receiver address"
+//	@Param   		status				query	string  	false	"transfer status"								Enums(success, bounced)
+//	@Param   		from				query	string  	false	"from timestamp"
+//	@Param   		to					query	string  	false	"to timestamp"
+//	@Param   		interval			query	string  	true	"group interval"								Enums(24h, 8h, 4h, 1h, 15m)
+//	@Success		200		{object}	history.JettonTransfersRes
+//	@Failure		400		{object}	gin.H
+//	@Router			/jettons/transfers/aggregated/history [get]
+func (c *Controller) AggregateJettonTransfersHistory(ctx *gin.Context) {
+	var req history.JettonTransfersReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "transfer_filter", err)
+		return
+	}
+	if err := checkJettonTransferStatus(req.Status); err != nil {
+		paramErr(ctx, "status", err)
+		return
+	}
+
+	req.MinterAddress, err = unmarshalAddress(ctx.Query("minter_address"))
+	if err != nil {
+		paramErr(ctx, "minter_address", err)
+		return
+	}
+	req.SrcOwners, err = getAddresses(ctx, "src_owner")
+	if err != nil {
+		paramErr(ctx, "src_owner", err)
+		return
+	}
+	req.DstOwners, err = getAddresses(ctx, "dst_owner")
+	if err != nil {
+		paramErr(ctx, "dst_owner", err)
+		return
+	}
+
+	ret, err := c.svc.AggregateJettonTransfersHistory(ctx, &req)
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+func checkDEX(dex core.DEX) error {
+	switch dex {
+	case "", core.DeDustV2, core.StonFi:
+		return nil
+	default:
+		return errors.Wrapf(core.ErrInvalidArg, "unknown dex %s", dex)
+	}
+}
+
+// GetSwaps godoc
+//
+//	@Summary		dex swaps
+//	@Description	Returns swaps executed by DeDust and STON.fi pools.
+//	@Description	Empty asset address means native TON, jettons are identified by minter addresses. Swaps of fake pools are skipped.
+//	@Tags			transaction
+//	@Accept			json
+//	@Produce		json
+//	@Param   		tx_hash				query	string  	false	"search by pool transaction hash"
+//	@Param   		trace_id			query	string  	false	"search by trace id"
+//	@Param   		dex					query	string  	false	"dex name"						Enums(dedust_v2, stonfi)
+//	@Param   		pool_address		query   []string 	false   "pool address"
+//	@Param   		trader     			query   []string 	false   "trader address"
+//	@Param   		asset     			query   []string 	false   "sold or bought asset"
+//	@Param			order				query	string		false	"order by created_lt"			Enums(ASC, DESC) default(DESC)
+//	@Param   		after	     		query   int 		false	"start from this created_lt"
+//	@Param   		limit	     		query   int 		false	"limit"							default(3) maximum(10000)
+//	@Param   		count	     		query   bool 		false	"count total number of rows"	default(false)
+//	@Success		200		{object}	filter.SwapsRes
+//	@Failure		400		{object}	gin.H
+//	@Router			/swaps [get]
+func (c *Controller) GetSwaps(ctx *gin.Context) {
+	var req filter.SwapsReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "swap_filter", err)
+		return
+	}
+	if req.Limit > 10000 {
+		paramErr(ctx, "limit", errors.Wrapf(core.ErrInvalidArg, "limit is too big"))
+		return
+	}
+	if err := checkDEX(req.DEX); err != nil {
+		paramErr(ctx, "dex", err)
+		return
+	}
+
+	req.TxHash, err = unmarshalBytes(ctx.Query("tx_hash"))
+	if err != nil {
+		paramErr(ctx, "tx_hash", err)
+		return
+	}
+	req.TraceID, err = unmarshalBytes(ctx.Query("trace_id"))
+	if err != nil {
+		paramErr(ctx, "trace_id", err)
+		return
+	}
+	req.PoolAddresses, err = getAddresses(ctx, "pool_address")
+	if err != nil {
+		paramErr(ctx, "pool_address", err)
+		return
+	}
+	req.Traders, err = getAddresses(ctx, "trader")
+	if err != nil {
+		paramErr(ctx, "trader", err)
+		return
+	}
+	req.Assets, err = getAddresses(ctx, "asset")
+	if err != nil {
+		paramErr(ctx, "asset", err)
+		return
+	}
+
+	req.Order, err = unmarshalSorting(req.Order)
+	if err != nil {
+		paramErr(ctx, "order", err)
+		return
+	}
+
+	ret, err := c.svc.FilterSwaps(ctx, &req)
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// GetPoolCandles godoc
+//
+//	@Summary		pool price candles
+//	@Description	Returns OHLCV candles of DEX pool swaps.
+//	@Description	Price is the amount of the first asset of the pair paid for one unit of the second asset, where assets are ordered by address and native TON goes first.
+//	@Tags			transaction
+//	@Accept			json
+//	@Produce		json
+//	@Param   		address				path	string  	true	"pool address"
+//	@Param   		from				query	string  	false	"from timestamp"
+//	@Param   		to					query	string  	false	"to timestamp"
+//	@Param   		interval			query	string  	true	"candle interval"	Enums(24h, 8h, 4h, 1h, 15m, 5m)
+//	@Success		200		{object}	history.SwapCandlesRes
+//	@Failure		400		{object}	gin.H
+//	@Router			/pools/{address}/candles [get]
+func (c *Controller) GetPoolCandles(ctx *gin.Context) {
+	var req history.SwapCandlesReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "candles_filter", err)
+		return
+	}
+
+	a, err := unmarshalAddress(ctx.Param("address"))
+	if err != nil {
+		paramErr(ctx, "address", err)
+		return
+	}
+	if a == nil {
+		paramErr(ctx, "address", errors.Wrap(core.ErrInvalidArg, "empty address"))
+		return
+	}
+	req.PoolAddress = *a
+
+	ret, err := c.svc.AggregateSwapCandles(ctx, &req)
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
+
+// GetPoolHistory godoc
+//
+//	@Summary		pool reserves history
+//	@Description	Returns reserves, LP supply and TVL in nanoTON of DEX liquidity pool over time.
+//	@Description	If none of the pool assets is TON, TVL is priced through the TON-paired pool of one of the assets with the largest TON reserve.
+//	@Tags			account
+//	@Accept			json
+//	@Produce		json
+//	@Param   		address				path	string  	true	"pool address"
+//	@Param   		from				query	string  	false	"from timestamp"
+//	@Param   		to					query	string  	false	"to timestamp"
+//	@Param   		interval			query	string  	true	"interval"	Enums(24h, 8h, 4h, 1h, 15m, 5m)
+//	@Success		200		{object}	history.PoolRes
+//	@Failure		400		{object}	gin.H
+//	@Failure		404		{object}	gin.H
+//	@Router			/pools/{address}/history [get]
+func (c *Controller) GetPoolHistory(ctx *gin.Context) {
+	var req history.PoolReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "pool_history", err)
+		return
+	}
+
+	a, err := unmarshalAddress(ctx.Param("address"))
+	if err != nil {
+		paramErr(ctx, "address", err)
+		return
+	}
+	if a == nil {
+		paramErr(ctx, "address", errors.Wrap(core.ErrInvalidArg, "empty address"))
+		return
+	}
+	req.PoolAddress = *a
+
+	ret, err := c.svc.AggregatePoolHistory(ctx, &req)
+	if errors.Is(err, core.ErrNotFound) {
+		ctx.IndentedJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+	if err != nil {
+		internalErr(ctx, err)
+		return
+	}
+
+	ctx.IndentedJSON(http.StatusOK, ret)
+}
@@ -0,0 +1,87 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmulationLimits protect the public endpoints emulating get-methods and messages,
+// as every request runs TVM.
+type EmulationLimits struct {
+	// MaxBodySize is the maximum request body size in bytes.
+	MaxBodySize int64
+	// RateLimit is the number of requests per second allowed for one client.
+	RateLimit float64
+	// Burst is the number of requests one client can make at once.
+	Burst int
+}
+
+// maxLimitedClients is the number of clients after which the full buckets are dropped.
+const maxLimitedClients = 10000
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket rate limiter keyed by the client address.
+type rateLimiter struct {
+	mx      sync.Mutex
+	rate    float64
+	burst   float64
+	clients map[string]*bucket
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{rate: rate, burst: float64(burst), clients: map[string]*bucket{}}
+}
+
+func (l *rateLimiter) refill(b *bucket, now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+}
+
+func (l *rateLimiter) allow(client string, now time.Time) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if len(l.clients) > maxLimitedClients {
+		for k, b := range l.clients {
+			if l.refill(b, now); b.tokens >= l.burst {
+				delete(l.clients, k)
+			}
+		}
+	}
+
+	b, ok := l.clients[client]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.clients[client] = b
+	}
+	l.refill(b, now)
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func emulationLimiter(limits EmulationLimits) gin.HandlerFunc {
+	l := newRateLimiter(limits.RateLimit, limits.Burst)
+
+	return func(ctx *gin.Context) {
+		if !l.allow(ctx.ClientIP(), time.Now()) {
+			ctx.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "too many emulation requests"})
+			return
+		}
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, limits.MaxBodySize)
+		ctx.Next()
+	}
+}
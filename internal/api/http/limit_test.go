@@ -0,0 +1,55 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	l := newRateLimiter(2, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		require.True(t, l.allow("a", now))
+	}
+	require.False(t, l.allow("a", now))
+	require.True(t, l.allow("b", now))
+
+	require.True(t, l.allow("a", now.Add(500*time.Millisecond)))
+	require.False(t, l.allow("a", now.Add(500*time.Millisecond)))
+
+	for i := 0; i < 3; i++ {
+		require.True(t, l.allow("a", now.Add(time.Hour)))
+	}
+	require.False(t, l.allow("a", now.Add(time.Hour)))
+}
+
+func TestEmulationLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.POST("/emulate", emulationLimiter(EmulationLimits{MaxBodySize: 8, RateLimit: 1, Burst: 2}), func(ctx *gin.Context) {
+		if _, err := io.ReadAll(ctx.Request.Body); err != nil {
+			ctx.Status(http.StatusRequestEntityTooLarge)
+			return
+		}
+		ctx.Status(http.StatusOK)
+	})
+
+	post := func(body string) int {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/emulate", strings.NewReader(body)))
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, post("{}"))
+	require.Equal(t, http.StatusRequestEntityTooLarge, post(`{"boc": "te6cc"}`))
+	require.Equal(t, http.StatusTooManyRequests, post("{}"))
+}
@@ -24,22 +24,47 @@ type QueryController interface {
 	GetAccounts(*gin.Context)
 	AggregateAccounts(*gin.Context)
 	AggregateAccountsHistory(*gin.Context)
+	AggregateHolders(*gin.Context)
+	GetTokenMetadata(*gin.Context)
+	ExecuteGetMethod(*gin.Context)
+	EmulateMessage(*gin.Context)
 
 	GetTransactions(*gin.Context)
 	AggregateTransactionsHistory(*gin.Context)
+	GetTrace(*gin.Context)
 
 	GetMessages(*gin.Context)
 	AggregateMessages(*gin.Context)
 	AggregateMessagesHistory(*gin.Context)
 
+	GetJettonTransfers(*gin.Context)
+	AggregateJettonTransfersHistory(*gin.Context)
+
+	GetSwaps(*gin.Context)
+	GetPoolCandles(*gin.Context)
+	GetPoolHistory(*gin.Context)
+
 	GetInterfaces(*gin.Context)
 	GetOperations(*gin.Context)
 	GetDefinitions(*gin.Context)
+	GetRescanTasks(*gin.Context)
+}
+
+type AdminController interface {
+	AddInterfaces(*gin.Context)
+	UpdateInterface(*gin.Context)
+	DeleteInterface(*gin.Context)
+}
+
+type StreamController interface {
+	StreamTransactions(*gin.Context)
+	StreamMessages(*gin.Context)
 }
 
 type Server struct {
-	listenHost string
-	router     *gin.Engine
+	listenHost      string
+	router          *gin.Engine
+	emulationLimits EmulationLimits
 }
 
 func NewServer(host string) *Server {
@@ -47,7 +72,29 @@ func NewServer(host string) *Server {
 	config := cors.DefaultConfig()
 	config.AllowAllOrigins = true
 	router.Use(cors.New(config))
-	return &Server{listenHost: host, router: router}
+	return &Server{
+		listenHost: host,
+		router:     router,
+		emulationLimits: EmulationLimits{
+			MaxBodySize: 64 << 10,
+			RateLimit:   5,
+			Burst:       10,
+		},
+	}
+}
+
+// SetEmulationLimits overrides the default limits of get-method and message emulation endpoints.
+// It must be called before RegisterRoutes.
+func (s *Server) SetEmulationLimits(limits EmulationLimits) {
+	if limits.MaxBodySize > 0 {
+		s.emulationLimits.MaxBodySize = limits.MaxBodySize
+	}
+	if limits.RateLimit > 0 {
+		s.emulationLimits.RateLimit = limits.RateLimit
+	}
+	if limits.Burst > 0 {
+		s.emulationLimits.Burst = limits.Burst
+	}
 }
 
 func (s *Server) RegisterRoutes(t QueryController) {
@@ -63,14 +110,28 @@ func (s *Server) RegisterRoutes(t QueryController) {
 	base.GET("/accounts", t.GetAccounts)
 	base.GET("/accounts/aggregated", t.AggregateAccounts)
 	base.GET("/accounts/aggregated/history", t.AggregateAccountsHistory)
+	base.GET("/accounts/holders", t.AggregateHolders)
+	base.GET("/metadata", t.GetTokenMetadata)
+
+	emulation := base.Group("", emulationLimiter(s.emulationLimits))
+	emulation.POST("/accounts/:address/get-methods/:name", t.ExecuteGetMethod)
+	emulation.POST("/accounts/:address/emulate", t.EmulateMessage)
 
 	base.GET("/transactions", t.GetTransactions)
 	base.GET("/transactions/aggregated/history", t.AggregateTransactionsHistory)
+	base.GET("/traces/:id", t.GetTrace)
 
 	base.GET("/messages", t.GetMessages)
 	base.GET("/messages/aggregated", t.AggregateMessages)
 	base.GET("/messages/aggregated/history", t.AggregateMessagesHistory)
 
+	base.GET("/jettons/transfers", t.GetJettonTransfers)
+	base.GET("/jettons/transfers/aggregated/history", t.AggregateJettonTransfersHistory)
+
+	base.GET("/swaps", t.GetSwaps)
+	base.GET("/pools/:address/candles", t.GetPoolCandles)
+	base.GET("/pools/:address/history", t.GetPoolHistory)
+
 	base.GET("/contract/interfaces", t.GetInterfaces) // DEPRECATED
 	base.GET("/contract/operations", t.GetOperations) // DEPRECATED
 
@@ -78,6 +139,8 @@ func (s *Server) RegisterRoutes(t QueryController) {
 	base.GET("/contracts/operations", t.GetOperations)
 	base.GET("/contracts/definitions", t.GetDefinitions)
 
+	base.GET("/rescan/tasks", t.GetRescanTasks)
+
 	base.GET("/swagger/*any", ginSwagger.WrapHandler(
 		swaggerFiles.Handler,
 		ginSwagger.URL(basePath+"/swagger/doc.json"),
@@ -88,6 +151,30 @@ func (s *Server) RegisterRoutes(t QueryController) {
 	})
 }
 
+// RegisterAdminRoutes serves endpoints changing contract interfaces behind the basic authentication.
+func (s *Server) RegisterAdminRoutes(t AdminController, accounts gin.Accounts) {
+	base := s.router.Group(basePath, gin.BasicAuth(accounts))
+
+	base.POST("/contracts/interfaces", t.AddInterfaces)
+	base.PUT("/contracts/interfaces/:name", t.UpdateInterface)
+	base.DELETE("/contracts/interfaces/:name", t.DeleteInterface)
+}
+
+// RegisterStreamRoutes serves server-sent events with new transactions and messages.
+func (s *Server) RegisterStreamRoutes(t StreamController) {
+	base := s.router.Group(basePath)
+
+	base.GET("/stream/transactions", t.StreamTransactions)
+	base.GET("/stream/messages", t.StreamMessages)
+}
+
+// RegisterGraphQL serves GraphQL queries on /graphql and the playground on /graphql/playground.
+func (s *Server) RegisterGraphQL(query, playground http.Handler) {
+	s.router.POST("/graphql", gin.WrapH(query))
+	s.router.GET("/graphql", gin.WrapH(query))
+	s.router.GET("/graphql/playground", gin.WrapH(playground))
+}
+
 func (s *Server) Run() error {
 	return s.router.Run(s.listenHost)
 }
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+var keepAliveInterval = 15 * time.Second
+
+var _ StreamController = (*Streamer)(nil)
+
+type Streamer struct {
+	svc app.StreamService
+}
+
+func NewStreamer(svc app.StreamService) *Streamer {
+	return &Streamer{svc: svc}
+}
+
+// streamEvents writes events as server-sent events until the client goes away or the subscription is closed.
+func streamEvents[T any](ctx *gin.Context, name string, events <-chan T) {
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush() // send headers to let the client know it is subscribed
+
+	ctx.Stream(func(io.Writer) bool {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				ctx.SSEvent("error", gin.H{"error": "subscription is closed, as the client is too slow"})
+				return false
+			}
+			ctx.SSEvent(name, e)
+			return true
+
+		case <-keepAlive.C:
+			ctx.SSEvent("ping", gin.H{"time": time.Now().UTC()})
+			return true
+
+		case <-ctx.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// StreamTransactions godoc
+//
+//	@Summary		transactions stream
+//	@Description	Streams new transactions as server-sent events (`transaction` event type) right after they are committed to the database
+//	@Tags			stream
+//	@Produce		text/event-stream
+//	@Param   		address     		query   []string 	false   "only given addresses"
+//	@Param   		hash				query	string  	false	"search by tx hash"
+//	@Param   		in_msg_hash			query	string  	false	"search by incoming message hash"
+//	@Param   		workchain			query	int32  		false	"filter by workchain"
+//	@Success		200		{object}	core.Transaction
+//	@Router			/stream/transactions [get]
+func (c *Streamer) StreamTransactions(ctx *gin.Context) {
+	var req filter.TransactionsReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "tx_filter", err)
+		return
+	}
+
+	req.Hash, err = unmarshalBytes(ctx.Query("hash"))
+	if err != nil {
+		paramErr(ctx, "hash", err)
+		return
+	}
+	req.InMsgHash, err = unmarshalBytes(ctx.Query("in_msg_hash"))
+	if err != nil {
+		paramErr(ctx, "in_msg_hash", err)
+		return
+	}
+
+	req.Addresses, err = getAddresses(ctx, "address")
+	if err != nil {
+		paramErr(ctx, "address", err)
+		return
+	}
+
+	events, cancel := c.svc.SubscribeTransactions(&req)
+	defer cancel()
+
+	streamEvents(ctx, "transaction", events)
+}
+
+// StreamMessages godoc
+//
+//	@Summary		messages stream
+//	@Description	Streams new parsed messages as server-sent events (`message` event type) right after they are committed to the database
+//	@Tags			stream
+//	@Produce		text/event-stream
+//	@Param   		hash				query	string  	false	"msg hash"
+//	@Param   		src_address     	query   []string 	false   "source address"
+//	@Param   		dst_address     	query   []string 	false   "destination address"
+//	@Param   		src_workchain		query	int32  		false	"source workchain"
+//	@Param   		dst_workchain		query	int32  		false	"destination workchain"
+//	@Param   		src_contract		query	[]string	false	"source contract interface"
+//	@Param   		dst_contract		query	[]string	false	"destination contract interface"
+//	@Param   		operation_id		query	string		false	"operation id in hex or decimal"
+//	@Param   		operation_name		query	[]string	false	"filter by contract operation names"
+//	@Success		200		{object}	core.Message
+//	@Router			/stream/messages [get]
+func (c *Streamer) StreamMessages(ctx *gin.Context) {
+	var req filter.MessagesReq
+
+	err := ctx.ShouldBindQuery(&req)
+	if err != nil {
+		paramErr(ctx, "msg_filter", err)
+		return
+	}
+
+	req.Hash, err = unmarshalBytes(ctx.Query("hash"))
+	if err != nil {
+		paramErr(ctx, "hash", err)
+		return
+	}
+	req.SrcAddresses, err = getAddresses(ctx, "src_address")
+	if err != nil {
+		paramErr(ctx, "src_address", err)
+		return
+	}
+	req.DstAddresses, err = getAddresses(ctx, "dst_address")
+	if err != nil {
+		paramErr(ctx, "dst_address", err)
+		return
+	}
+
+	if op := ctx.Query("operation_id"); op != "" {
+		id, err := unmarshalOperationID(op)
+		if err != nil {
+			paramErr(ctx, "operation_id", err)
+			return
+		}
+		req.OperationID = &id
+	}
+
+	events, cancel := c.svc.SubscribeMessages(&req)
+	defer cancel()
+
+	streamEvents(ctx, "message", events)
+}
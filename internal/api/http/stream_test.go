@@ -0,0 +1,61 @@
+package http
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/stream"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func TestStreamer_StreamMessages(t *testing.T) {
+	svc := stream.NewService(&app.StreamConfig{})
+
+	s := NewServer("")
+	s.RegisterStreamRoutes(NewStreamer(svc))
+
+	srv := httptest.NewServer(s.router)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + basePath + "/stream/messages?operation_name=jetton_transfer&operation_id=0xf8a7ea5")
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	// headers are sent after the subscription is registered
+	svc.Publish(nil, []*core.Message{
+		{OperationName: "jetton_burn", OperationID: 0x595f07bc},
+		{OperationName: "jetton_transfer", OperationID: 0xf8a7ea5, CreatedLT: 42},
+	})
+
+	var lines []string
+	sc := bufio.NewScanner(res.Body)
+	for sc.Scan() && len(lines) < 2 {
+		lines = append(lines, sc.Text())
+	}
+	require.Nil(t, sc.Err())
+
+	require.Equal(t, "event:message", lines[0])
+	require.True(t, strings.HasPrefix(lines[1], "data:{"))
+	require.Contains(t, lines[1], `"operation_name":"jetton_transfer"`)
+	require.Contains(t, lines[1], `"created_lt":42`)
+}
+
+func TestStreamer_StreamTransactions_BadAddress(t *testing.T) {
+	s := NewServer("")
+	s.RegisterStreamRoutes(NewStreamer(stream.NewService(&app.StreamConfig{})))
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, basePath+"/stream/transactions?address=abc", http.NoBody)
+	s.router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/repository"
+)
+
+type ContractConfig struct {
+	// DB is used to save every change of contract interfaces in a single transaction
+	DB *repository.DB
+
+	ContractRepo core.ContractRepository
+	RescanRepo   core.RescanRepository
+}
+
+// ContractChangeRes lists rescan tasks added for the changed contract interfaces.
+type ContractChangeRes struct {
+	RescanTasks []int `json:"rescan_tasks"`
+}
+
+type ContractService interface {
+	// AddInterfaces inserts new contract interfaces with their definitions and operations.
+	// Nothing is saved if any of them cannot be inserted.
+	AddInterfaces(ctx context.Context, desc []*abi.InterfaceDesc) (*ContractChangeRes, error)
+
+	// UpdateInterface updates the named contract interface
+	// and adds rescan tasks for the difference between old and new interfaces.
+	// Descriptions may contain other interfaces, their definitions are updated as well.
+	// If the scope is given, added rescan tasks cover only account states and messages within it.
+	// All changes and rescan tasks are saved in a single transaction.
+	UpdateInterface(ctx context.Context, name abi.ContractName, desc []*abi.InterfaceDesc, scope *core.RescanScope) (*ContractChangeRes, error)
+
+	// DeleteInterface deletes contract interface and adds rescan tasks removing its parsed data.
+	DeleteInterface(ctx context.Context, name abi.ContractName) (*ContractChangeRes, error)
+}
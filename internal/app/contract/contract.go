@@ -0,0 +1,331 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ app.ContractService = (*Service)(nil)
+
+type Service struct {
+	*app.ContractConfig
+}
+
+func NewService(cfg *app.ContractConfig) (*Service, error) {
+	var s = new(Service)
+
+	s.ContractConfig = cfg
+
+	// validate config
+	if s.DB == nil || s.DB.PG == nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, "no postgresql connection")
+	}
+	if s.ContractRepo == nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, "no contract repository")
+	}
+	if s.RescanRepo == nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, "no rescan repository")
+	}
+
+	return s, nil
+}
+
+func (s *Service) addRescanTask(ctx context.Context, tx bun.Tx, res *app.ContractChangeRes, scope *core.RescanScope, task *core.RescanTask) error {
+	if scope != nil {
+		task.Scope = *scope
+	}
+	if err := s.RescanRepo.AddRescanTask(ctx, tx, task); err != nil {
+		return err
+	}
+	res.RescanTasks = append(res.RescanTasks, task.ID)
+	return nil
+}
+
+func (s *Service) rescanInterface(ctx context.Context, tx bun.Tx, res *app.ContractChangeRes, scope *core.RescanScope, in abi.ContractName, t core.RescanTaskType) error {
+	err := s.addRescanTask(ctx, tx, res, scope, &core.RescanTask{
+		Type:         t,
+		ContractName: in,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "add rescan task for '%s' contract interface", in)
+	}
+
+	log.Info().
+		Str("rescan_type", string(t)).
+		Str("interface_name", string(in)).
+		Msg("added contract interface rescan task")
+
+	return nil
+}
+
+func (s *Service) rescanGetMethod(ctx context.Context, tx bun.Tx, res *app.ContractChangeRes, scope *core.RescanScope, in abi.ContractName, t core.RescanTaskType, getMethods []string) error {
+	if len(getMethods) == 0 {
+		return nil
+	}
+
+	err := s.addRescanTask(ctx, tx, res, scope, &core.RescanTask{
+		Type:              t,
+		ContractName:      in,
+		ChangedGetMethods: getMethods,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "add rescan task for '%s' get-method", getMethods)
+	}
+
+	for _, gm := range getMethods {
+		log.Info().
+			Str("rescan_type", string(t)).
+			Str("interface_name", string(in)).
+			Str("get_method", gm).
+			Msg("added get-method rescan task")
+	}
+
+	return nil
+}
+
+func (s *Service) rescanOperation(ctx context.Context, tx bun.Tx, res *app.ContractChangeRes, scope *core.RescanScope, t core.RescanTaskType, op *core.ContractOperation) error {
+	err := s.addRescanTask(ctx, tx, res, scope, &core.RescanTask{
+		Type:         t,
+		ContractName: op.ContractName,
+		MessageType:  op.MessageType,
+		Outgoing:     op.Outgoing,
+		OperationID:  op.OperationID,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "add rescan task for '%s' operation", op.OperationName)
+	}
+
+	log.Info().
+		Str("rescan_type", string(t)).
+		Str("interface_name", string(op.ContractName)).
+		Str("operation_name", op.OperationName).
+		Msg("added operation rescan task")
+
+	return nil
+}
+
+func (s *Service) saveDefinitions(ctx context.Context, tx bun.Tx, definitions map[abi.TLBType]abi.TLBFieldsDesc) error {
+	addedDef, changedDef, err := diffDefinitions(ctx, s.ContractRepo, definitions)
+	if err != nil {
+		return err
+	}
+	for dn, d := range changedDef {
+		if err := s.ContractRepo.UpdateDefinition(ctx, tx, dn, d); err != nil {
+			return errors.Wrapf(err, "cannot update contract definition '%s'", dn)
+		}
+	}
+	for dn, d := range addedDef {
+		if err := s.ContractRepo.AddDefinition(ctx, tx, dn, d); err != nil {
+			return errors.Wrapf(err, "cannot insert contract definition '%s'", dn)
+		}
+	}
+	return nil
+}
+
+func (s *Service) AddInterfaces(ctx context.Context, desc []*abi.InterfaceDesc) (*app.ContractChangeRes, error) {
+	var res app.ContractChangeRes
+
+	// definitions are registered globally only after the transaction is committed
+	reg := abi.NewRegistry()
+
+	definitions, interfaces, operations, err := ParseInterfacesDesc(reg, desc)
+	if err != nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, err.Error())
+	}
+
+	tx, err := s.DB.PG.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot begin db tx")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := s.saveDefinitions(ctx, tx, definitions); err != nil {
+		return nil, err
+	}
+
+	for _, i := range interfaces {
+		if err := s.ContractRepo.AddInterface(ctx, tx, i); err != nil {
+			return nil, errors.Wrapf(err, "cannot insert contract interface '%s'", i.Name)
+		}
+		if err := s.rescanInterface(ctx, tx, &res, nil, i.Name, core.AddInterface); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, op := range operations {
+		if err := s.ContractRepo.AddOperation(ctx, tx, op); err != nil {
+			return nil, errors.Wrapf(err, "cannot insert contract operation '%s'", op.OperationName)
+		}
+		if err := s.rescanOperation(ctx, tx, &res, nil, core.UpdOperation, op); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "cannot commit db tx")
+	}
+	reg.Commit()
+
+	return &res, nil
+}
+
+func (s *Service) UpdateInterface(ctx context.Context, contractName abi.ContractName, desc []*abi.InterfaceDesc, scope *core.RescanScope) (*app.ContractChangeRes, error) {
+	var res app.ContractChangeRes
+
+	if contractName == "" {
+		return nil, errors.Wrap(core.ErrInvalidArg, "contract interface name is not set")
+	}
+	if scope != nil {
+		if err := scope.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	reg := abi.NewRegistry()
+
+	definitions, interfaces, _, err := ParseInterfacesDesc(reg, desc)
+	if err != nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, err.Error())
+	}
+
+	var newInterface *core.ContractInterface
+	for _, i := range interfaces {
+		if i.Name == contractName {
+			newInterface = i
+		}
+	}
+	if newInterface == nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "contract interface '%s' is not found in abi description", contractName)
+	}
+
+	oldInterface, err := s.ContractRepo.GetInterface(ctx, contractName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get '%s' interface", newInterface.Name)
+	}
+
+	tx, err := s.DB.PG.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot begin db tx")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := s.saveDefinitions(ctx, tx, definitions); err != nil {
+		return nil, err
+	}
+
+	iChanged, dataChanged, addedGm, changedGm, deletedGm := diffInterface(oldInterface, newInterface)
+	if iChanged || dataChanged || len(addedGm) > 0 || len(changedGm) > 0 || len(deletedGm) > 0 {
+		if err := s.ContractRepo.UpdateInterface(ctx, tx, newInterface); err != nil {
+			return nil, errors.Wrapf(err, "cannot update contract interface '%s'", newInterface.Name)
+		}
+	}
+
+	addedOp, changedOp, deletedOp := diffOperations(oldInterface.Operations, newInterface.Operations)
+	for _, op := range deletedOp {
+		if err := s.ContractRepo.DeleteOperation(ctx, tx, op.OperationName); err != nil {
+			return nil, errors.Wrapf(err, "cannot delete contract operation '%s'", op.OperationName)
+		}
+	}
+	for _, op := range changedOp {
+		if err := s.ContractRepo.UpdateOperation(ctx, tx, op); err != nil {
+			return nil, errors.Wrapf(err, "cannot update contract operation '%s'", op.OperationName)
+		}
+	}
+	for _, op := range addedOp {
+		if err := s.ContractRepo.AddOperation(ctx, tx, op); err != nil {
+			return nil, errors.Wrapf(err, "cannot insert contract operation '%s'", op.OperationName)
+		}
+	}
+
+	if iChanged {
+		if err := s.rescanInterface(ctx, tx, &res, scope, contractName, core.UpdInterface); err != nil {
+			return nil, err
+		}
+	}
+	if dataChanged && !iChanged {
+		// interface rescan reparses contract data too
+		if err := s.rescanInterface(ctx, tx, &res, scope, contractName, core.UpdContractData); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.rescanGetMethod(ctx, tx, &res, scope, contractName, core.AddGetMethod, getGetMethodNames(addedGm)); err != nil {
+		return nil, err
+	}
+	if err := s.rescanGetMethod(ctx, tx, &res, scope, contractName, core.UpdGetMethod, getGetMethodNames(changedGm)); err != nil {
+		return nil, err
+	}
+	if err := s.rescanGetMethod(ctx, tx, &res, scope, contractName, core.DelGetMethod, getGetMethodNames(deletedGm)); err != nil {
+		return nil, err
+	}
+
+	for _, op := range deletedOp {
+		if err := s.rescanOperation(ctx, tx, &res, scope, core.DelOperation, op); err != nil {
+			return nil, err
+		}
+	}
+	for _, op := range append(addedOp, changedOp...) {
+		if err := s.rescanOperation(ctx, tx, &res, scope, core.UpdOperation, op); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "cannot commit db tx")
+	}
+	reg.Commit()
+
+	return &res, nil
+}
+
+func (s *Service) DeleteInterface(ctx context.Context, contractName abi.ContractName) (*app.ContractChangeRes, error) {
+	var res app.ContractChangeRes
+
+	if contractName == "" {
+		return nil, errors.Wrap(core.ErrInvalidArg, "contract interface name is not set")
+	}
+
+	oldInterface, err := s.ContractRepo.GetInterface(ctx, contractName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get '%s' interface", contractName)
+	}
+
+	tx, err := s.DB.PG.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot begin db tx")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := s.ContractRepo.DeleteInterface(ctx, tx, contractName); err != nil {
+		return nil, errors.Wrapf(err, "cannot delete '%s' interface", contractName)
+	}
+
+	for _, op := range oldInterface.Operations {
+		if err := s.rescanOperation(ctx, tx, &res, nil, core.DelOperation, op); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.rescanInterface(ctx, tx, &res, nil, contractName, core.DelInterface); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "cannot commit db tx")
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,88 @@
+package contract
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pkg/errors"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func diffDefinitions(ctx context.Context, contractRepo core.ContractRepository, current map[abi.TLBType]abi.TLBFieldsDesc) (added, changed map[abi.TLBType]abi.TLBFieldsDesc, err error) {
+	old, err := contractRepo.GetDefinitions(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "get definitions")
+	}
+
+	added, changed = map[abi.TLBType]abi.TLBFieldsDesc{}, map[abi.TLBType]abi.TLBFieldsDesc{}
+	for dt, d := range current {
+		od, ok := old[dt]
+		if !ok {
+			added[dt] = d
+			continue
+		}
+		if !reflect.DeepEqual(od, d) {
+			changed[dt] = d
+		}
+	}
+
+	return added, changed, nil
+}
+
+func diffSlices[V any](oldS, newS []V, getName func(v V) string) (added, changed, deleted []V) {
+	oldM, newM := map[string]V{}, map[string]V{}
+	for _, v := range oldS {
+		oldM[getName(v)] = v
+	}
+	for _, v := range newS {
+		newM[getName(v)] = v
+	}
+
+	for vn, v := range newM {
+		ov, ok := oldM[vn]
+		if !ok {
+			added = append(added, v)
+			continue
+		}
+		if !reflect.DeepEqual(ov, v) {
+			changed = append(changed, v)
+		}
+	}
+	for vn := range oldM {
+		_, ok := newM[vn]
+		if !ok {
+			deleted = append(deleted, oldM[vn])
+		}
+	}
+
+	return added, changed, deleted
+}
+
+func diffInterface(oldInterface, newInterface *core.ContractInterface) (interfaceChanged, dataChanged bool, added, changed, deleted []abi.GetMethodDesc) {
+	interfaceChanged = !reflect.DeepEqual(newInterface.Addresses, oldInterface.Addresses) ||
+		!reflect.DeepEqual(newInterface.Code, oldInterface.Code) ||
+		!reflect.DeepEqual(newInterface.GetMethodHashes, oldInterface.GetMethodHashes) ||
+		!reflect.DeepEqual(newInterface.Verification, oldInterface.Verification)
+
+	dataChanged = !reflect.DeepEqual(newInterface.ContractData, oldInterface.ContractData)
+
+	added, changed, deleted = diffSlices(oldInterface.GetMethodsDesc, newInterface.GetMethodsDesc, func(v abi.GetMethodDesc) string { return v.Name })
+
+	return interfaceChanged, dataChanged, added, changed, deleted
+}
+
+func diffOperations(oldOperations, newOperations []*core.ContractOperation) (added, changed, deleted []*core.ContractOperation) {
+	return diffSlices(oldOperations, newOperations, func(v *core.ContractOperation) string { return v.OperationName })
+}
+
+func getGetMethodNames(desc []abi.GetMethodDesc) (names []string) {
+	for i := range desc {
+		if len(desc[i].Arguments) > 0 {
+			continue
+		}
+		names = append(names, desc[i].Name)
+	}
+	return
+}
@@ -0,0 +1,146 @@
+package contract
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func ParseOperationDesc(r *abi.Registry, t abi.ContractName, d *abi.OperationDesc) (*core.ContractOperation, error) {
+	var opId uint32
+
+	if c := d.Code; strings.HasPrefix(c, "0x") {
+		n := new(big.Int)
+		_, ok := n.SetString(c[2:], 16)
+		if !ok {
+			return nil, fmt.Errorf("wrong hex %s operation id format: %s", d.Name, d.Code)
+		}
+		opId = uint32(n.Uint64())
+	} else {
+		n, err := strconv.ParseUint(c, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s operation id", d.Name)
+		}
+		opId = uint32(n)
+	}
+
+	// this is needed to map interface definitions into schema
+	x, err := d.NewIn(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating new operation structure")
+	}
+	_, err = abi.NewOperationDesc(x)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating new operation descriptor")
+	}
+
+	if d.Type == "" {
+		d.Type = string(core.Internal)
+	}
+
+	return &core.ContractOperation{
+		OperationName: d.Name,
+		ContractName:  t,
+		MessageType:   core.MessageType(strings.ToUpper(d.Type)),
+		Outgoing:      false,
+		OperationID:   opId,
+		Schema:        *d,
+	}, nil
+}
+
+func ParseInterfaceDesc(r *abi.Registry, d *abi.InterfaceDesc) (*core.ContractInterface, []*core.ContractOperation, error) {
+	var operations []*core.ContractOperation
+
+	code, err := base64.StdEncoding.DecodeString(d.CodeBoc)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "decode code boc from base64")
+	}
+
+	i := core.ContractInterface{
+		Name:           d.Name,
+		Addresses:      d.Addresses,
+		Code:           code,
+		GetMethodsDesc: d.GetMethods,
+		ContractData:   d.ContractData,
+		Verification:   d.Verification,
+	}
+	for it := range i.GetMethodsDesc {
+		i.GetMethodHashes = append(i.GetMethodHashes, abi.MethodNameHash(i.GetMethodsDesc[it].Name))
+
+		for _, c := range i.GetMethodsDesc[it].MappedColumns() {
+			if !c.IsValid() {
+				return nil, nil, fmt.Errorf("unknown '%s' account column in %s `%s` get-method", c, d.Name, i.GetMethodsDesc[it].Name)
+			}
+		}
+	}
+	if len(i.Code) == 0 {
+		i.Code = nil
+	}
+	if len(i.ContractData) > 0 {
+		// check that contract data schema can be mapped into structure
+		if _, err := i.ContractData.NewIn(r); err != nil {
+			return nil, nil, errors.Wrapf(err, "creating %s contract data structure", d.Name)
+		}
+	} else {
+		i.ContractData = nil
+	}
+	if i.Verification != nil {
+		if err := i.Verification.Validate(i.GetMethodsDesc); err != nil {
+			return nil, nil, errors.Wrapf(err, "%s verification rule", d.Name)
+		}
+	}
+
+	for it := range d.InMessages {
+		op, err := ParseOperationDesc(r, i.Name, &d.InMessages[it])
+		if err != nil {
+			return nil, nil, err
+		}
+		op.Outgoing = false
+		operations = append(operations, op)
+	}
+
+	for it := range d.OutMessages {
+		op, err := ParseOperationDesc(r, i.Name, &d.OutMessages[it])
+		if err != nil {
+			return nil, nil, err
+		}
+		op.Outgoing = true
+		operations = append(operations, op)
+	}
+
+	i.Operations = operations
+
+	return &i, operations, nil
+}
+
+// ParseInterfacesDesc registers definitions of the descriptions in the given registry and parses interfaces with them.
+// Local registry is used to check the descriptions without changing the global definitions,
+// it can be committed after the descriptions are saved.
+func ParseInterfacesDesc(r *abi.Registry, descriptors []*abi.InterfaceDesc) (retD map[abi.TLBType]abi.TLBFieldsDesc, retI []*core.ContractInterface, retOp []*core.ContractOperation, _ error) {
+	retD = map[abi.TLBType]abi.TLBFieldsDesc{}
+	for _, desc := range descriptors {
+		err := r.Register(desc.Definitions)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for dn, d := range desc.Definitions {
+			retD[dn] = d
+		}
+	}
+	for _, desc := range descriptors {
+		i, operations, err := ParseInterfaceDesc(r, desc)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		retI = append(retI, i)
+		retOp = append(retOp, operations...)
+	}
+	return
+}
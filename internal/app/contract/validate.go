@@ -0,0 +1,77 @@
+package contract
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func validateOperationsCollisions(p string, i *abi.InterfaceDesc, ops []abi.OperationDesc, outgoing bool, known []*core.ContractOperation) (ret []*core.ContractOperation, errs []*abi.ValidationError) {
+	for it := range ops {
+		d := &ops[it]
+
+		id, err := abi.ParseOperationCode(d.Code)
+		if err != nil {
+			continue // reported by abi.ValidateInterfaces
+		}
+		t := core.MessageType(strings.ToUpper(d.Type))
+		if t == "" {
+			t = core.Internal
+		}
+
+		for _, k := range known {
+			if k.ContractName == i.Name || k.Outgoing != outgoing || k.OperationID != id || k.MessageType != t {
+				continue
+			}
+			// operations of different interfaces can share the same code,
+			// but messages of contracts with both interfaces are parsed with the first suitable operation
+			errs = append(errs, &abi.ValidationError{
+				Path:    fmt.Sprintf("%s[%d].op_code", p, it),
+				Message: fmt.Sprintf("operation code %s collides with '%s' operation of '%s' interface", d.Code, k.OperationName, k.ContractName),
+				Warning: true,
+			})
+		}
+
+		ret = append(ret, &core.ContractOperation{
+			OperationName: d.Name,
+			ContractName:  i.Name,
+			MessageType:   t,
+			Outgoing:      outgoing,
+			OperationID:   id,
+		})
+	}
+
+	return ret, errs
+}
+
+// ValidateOperations checks operation codes of the contract interfaces for collisions
+// with the known operations of other interfaces, for example, stored in the database.
+// It returns operations of the given interfaces to check the following documents against them.
+func ValidateOperations(interfaces []*abi.InterfaceDesc, known []*core.ContractOperation) (ret []*core.ContractOperation, errs []*abi.ValidationError) {
+	for it, i := range interfaces {
+		var (
+			p            = abi.InterfacePath(it)
+			interfaceOps []*core.ContractOperation
+		)
+
+		for _, x := range []struct {
+			field    string
+			ops      []abi.OperationDesc
+			outgoing bool
+		}{
+			{field: "in_messages", ops: i.InMessages, outgoing: false},
+			{field: "out_messages", ops: i.OutMessages, outgoing: true},
+		} {
+			ops, opErrs := validateOperationsCollisions(p+"."+x.field, i, x.ops, x.outgoing, known)
+			errs = append(errs, opErrs...)
+			interfaceOps = append(interfaceOps, ops...)
+		}
+
+		known = append(known[:len(known):len(known)], interfaceOps...)
+		ret = append(ret, interfaceOps...)
+	}
+
+	return ret, errs
+}
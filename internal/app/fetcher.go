@@ -21,5 +21,6 @@ type FetcherService interface {
 	LookupMaster(ctx context.Context, api ton.APIClientWrapped, seqNo uint32) (*ton.BlockIDExt, error)
 	UnseenBlocks(ctx context.Context, masterSeqNo uint32) (master *ton.BlockIDExt, shards []*ton.BlockIDExt, err error)
 	UnseenShards(ctx context.Context, master *ton.BlockIDExt) (shards []*ton.BlockIDExt, err error)
+	ParentBlocks(ctx context.Context, b *ton.BlockIDExt) ([]*ton.BlockIDExt, error)
 	BlockTransactions(ctx context.Context, master, b *ton.BlockIDExt) ([]*core.Transaction, error)
 }
@@ -44,19 +44,28 @@ func getShardID(shard *ton.BlockIDExt) string {
 	return fmt.Sprintf("%d|%d", shard.Workchain, shard.Shard)
 }
 
-func (s *Service) getNotSeenShards(ctx context.Context, shard *ton.BlockIDExt, shardLastSeqNo map[string]uint32) (ret []*ton.BlockIDExt, err error) {
-	if no, ok := shardLastSeqNo[getShardID(shard)]; ok && no == shard.SeqNo {
-		return nil, nil
+func (s *Service) ParentBlocks(ctx context.Context, b *ton.BlockIDExt) ([]*ton.BlockIDExt, error) {
+	data, err := s.API.GetBlockData(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("get block data: %w", err)
 	}
 
-	b, err := s.API.GetBlockData(ctx, shard)
+	parents, err := data.BlockInfo.GetParentBlocks()
 	if err != nil {
-		return nil, fmt.Errorf("get block data: %w", err)
+		return nil, fmt.Errorf("get parent blocks (%d:%x:%d): %w", b.Workchain, uint64(b.Shard), b.Shard, err)
+	}
+
+	return parents, nil
+}
+
+func (s *Service) getNotSeenShards(ctx context.Context, shard *ton.BlockIDExt, shardLastSeqNo map[string]uint32) (ret []*ton.BlockIDExt, err error) {
+	if no, ok := shardLastSeqNo[getShardID(shard)]; ok && no == shard.SeqNo {
+		return nil, nil
 	}
 
-	parents, err := b.BlockInfo.GetParentBlocks()
+	parents, err := s.ParentBlocks(ctx, shard)
 	if err != nil {
-		return nil, fmt.Errorf("get parent blocks (%d:%x:%d): %w", shard.Workchain, uint64(shard.Shard), shard.Shard, err)
+		return nil, err
 	}
 
 	for _, parent := range parents {
@@ -34,7 +34,7 @@ func (s *Service) getTransaction(ctx context.Context, master, b *ton.BlockIDExt,
 	go func() {
 		rawTx, err := s.API.GetTransaction(ctx, b, a, id.LT)
 		if err == nil {
-			tx, err := mapTransaction(b, rawTx)
+			tx, err := MapTransaction(b, rawTx)
 			txCh <- ret{res: tx, err: errors.Wrapf(err, "map transaction (hash = %x)", rawTx.Hash)}
 			return
 		}
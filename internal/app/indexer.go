@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/xssnick/tonutils-go/ton"
 
 	"github.com/stepandra/anton/internal/core/repository"
@@ -14,6 +16,11 @@ type IndexerConfig struct {
 	Fetcher FetcherService
 	Parser  ParserService
 
+	// Stream is notified about every committed batch, it can be nil
+	Stream StreamService
+	// Webhook sends committed batches to registered webhooks, it can be nil
+	Webhook WebhookService
+
 	FromBlock uint32
 	Workers   int
 }
@@ -21,4 +28,12 @@ type IndexerConfig struct {
 type IndexerService interface {
 	Start() error
 	Stop()
+
+	// Rollback deletes blocks, transactions, messages and account states
+	// committed after the given masterchain block.
+	Rollback(ctx context.Context, toMaster uint32) error
+
+	// Backfill fetches and saves masterchain blocks from the given range, which are missing in the databases.
+	// It can be run alongside the live indexer.
+	Backfill(ctx context.Context, from, to uint32) error
 }
@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+// backfillChunk is the number of masterchain blocks checked for existence at once.
+const backfillChunk = 1000
+
+func (s *Service) getUnsavedMasterBlocks(ctx context.Context, from, to uint32) ([]uint32, error) {
+	saved, err := s.blockRepo.GetSavedMasterBlocks(ctx, from, to)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get saved master blocks from %d to %d", from, to)
+	}
+
+	savedMap := make(map[uint32]struct{}, len(saved))
+	for _, seq := range saved {
+		savedMap[seq] = struct{}{}
+	}
+
+	var ret []uint32
+	for seq := from; seq <= to; seq++ {
+		if _, ok := savedMap[seq]; !ok {
+			ret = append(ret, seq)
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *Service) fetchMasters(ctx context.Context, seqNos []uint32) ([]*core.Block, error) {
+	var wg sync.WaitGroup
+
+	ret := make([]*core.Block, len(seqNos))
+	errs := make([]error, len(seqNos))
+
+	wg.Add(len(seqNos))
+	for it := range seqNos {
+		go func(it int) {
+			defer wg.Done()
+			ret[it], errs[it] = s.fetchMaster(ctx, seqNos[it])
+		}(it)
+	}
+	wg.Wait()
+
+	for it, err := range errs {
+		if err != nil {
+			return nil, errors.Wrapf(err, "fetch master block %d", seqNos[it])
+		}
+	}
+
+	return ret, nil
+}
+
+// saveBackfilledBlocks inserts historical blocks.
+// Sources of incoming messages can be located before the backfilled range, so such messages are skipped.
+// New data is not published to streams and webhooks.
+func (s *Service) saveBackfilledBlocks(ctx context.Context, masterBlocks []*core.Block) error {
+	newBlocks, newTransactions, _ := flattenBlocks(masterBlocks)
+
+	newMessages := s.uniqMessages(ctx, newTransactions, true)
+
+	return s.insertData(ctx, s.uniqAccounts(newTransactions), newMessages, newTransactions, newBlocks)
+}
+
+func (s *Service) Backfill(ctx context.Context, from, to uint32) error {
+	if from < 2 {
+		from = 2
+	}
+
+	// the live indexer saves blocks after the last one
+	last, err := s.blockRepo.GetLastMasterBlock(ctx)
+	switch {
+	case err == nil:
+		if to > last.SeqNo {
+			to = last.SeqNo
+		}
+	case !errors.Is(err, core.ErrNotFound):
+		return errors.Wrap(err, "get last masterchain block")
+	}
+
+	log.Info().
+		Uint32("from_block", from).
+		Uint32("to_block", to).
+		Int("workers", s.Workers).
+		Msg("backfill started")
+
+	for chunkFrom := uint64(from); chunkFrom <= uint64(to); chunkFrom += backfillChunk {
+		chunkTo := chunkFrom + backfillChunk - 1
+		if chunkTo > uint64(to) {
+			chunkTo = uint64(to)
+		}
+
+		seqNos, err := s.getUnsavedMasterBlocks(ctx, uint32(chunkFrom), uint32(chunkTo))
+		if err != nil {
+			return err
+		}
+
+		for len(seqNos) > 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			n := s.Workers
+			if n > len(seqNos) {
+				n = len(seqNos)
+			}
+
+			start := time.Now()
+
+			blocks, err := s.fetchMasters(ctx, seqNos[:n])
+			if err != nil {
+				return err
+			}
+			if err := s.saveBackfilledBlocks(ctx, blocks); err != nil {
+				return errors.Wrapf(err, "save blocks from %d to %d", blocks[0].SeqNo, blocks[len(blocks)-1].SeqNo)
+			}
+
+			log.Debug().
+				Uint32("from_block", blocks[0].SeqNo).
+				Uint32("to_block", blocks[len(blocks)-1].SeqNo).
+				Dur("took", time.Since(start)).
+				Msg("backfilled blocks")
+
+			seqNos = seqNos[n:]
+		}
+
+		log.Info().Uint32("last_checked_block", uint32(chunkTo)).Msg("backfill progress")
+	}
+
+	log.Info().Uint32("from_block", from).Uint32("to_block", to).Msg("backfill finished")
+
+	return nil
+}
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -35,7 +36,42 @@ func (s *Service) getUnseenBlocks(ctx context.Context, seq uint32) (master *ton.
 	return master, shards, nil
 }
 
-func (s *Service) fetchMaster(seq uint32) *core.Block {
+func (s *Service) getPrevMaster(ctx context.Context, master *ton.BlockIDExt) (*ton.BlockIDExt, error) {
+	parents, err := s.Fetcher.ParentBlocks(ctx, master)
+	if err != nil {
+		return nil, errors.Wrap(err, "get master parent blocks")
+	}
+	for _, p := range parents {
+		if p.Workchain == master.Workchain {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find previous block of master %d", master.SeqNo)
+}
+
+const (
+	fetchRetryBackoff    = time.Second
+	fetchRetryMaxBackoff = 30 * time.Second
+)
+
+// retryWait sleeps before the next attempt to fetch a block, doubling the delay up to fetchRetryMaxBackoff.
+// It returns the context error if the context is done earlier.
+func retryWait(ctx context.Context, backoff *time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(*backoff):
+	}
+
+	*backoff *= 2
+	if *backoff > fetchRetryMaxBackoff {
+		*backoff = fetchRetryMaxBackoff
+	}
+	return nil
+}
+
+// fetchMaster retries to fetch the masterchain block with its shards until it succeeds or the context is done.
+func (s *Service) fetchMaster(ctx context.Context, seq uint32) (*core.Block, error) {
 	type processedBlock struct {
 		block *core.Block
 		err   error
@@ -43,13 +79,28 @@ func (s *Service) fetchMaster(seq uint32) *core.Block {
 
 	defer core.Timer(time.Now(), "fetchMaster(%d)", seq)
 
+	backoff := fetchRetryBackoff
+
 	for {
-		ctx := context.Background()
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 
 		master, shards, err := s.getUnseenBlocks(ctx, seq)
 		if err != nil {
 			log.Error().Err(err).Uint32("master_seq", seq).Msg("get unseen blocks")
-			time.Sleep(time.Second)
+			if err := retryWait(ctx, &backoff); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		prev, err := s.getPrevMaster(ctx, master)
+		if err != nil {
+			log.Error().Err(err).Uint32("master_seq", seq).Msg("get previous master block")
+			if err := retryWait(ctx, &backoff); err != nil {
+				return nil, err
+			}
 			continue
 		}
 
@@ -70,6 +121,8 @@ func (s *Service) fetchMaster(seq uint32) *core.Block {
 					SeqNo:        master.SeqNo,
 					FileHash:     master.FileHash,
 					RootHash:     master.RootHash,
+					PrevFileHash: prev.FileHash,
+					PrevRootHash: prev.RootHash,
 					Transactions: tx,
 					ScannedAt:    time.Now(),
 				},
@@ -128,10 +181,12 @@ func (s *Service) fetchMaster(seq uint32) *core.Block {
 				Uint64("shard", uint64(errBlock.block.Shard)).
 				Uint32("seq", errBlock.block.SeqNo).
 				Msg("cannot process block")
-			time.Sleep(time.Second)
+			if err := retryWait(ctx, &backoff); err != nil {
+				return nil, err
+			}
 		} else {
 			gotMaster.Shards = gotShards
-			return gotMaster
+			return gotMaster, nil
 		}
 	}
 }
@@ -188,7 +243,8 @@ func (s *Service) fetchMastersConcurrent(fromBlock uint32, results chan<- *core.
 
 	for i := 0; i < workers; i++ {
 		go func(seq uint32) {
-			ch <- s.fetchMaster(seq)
+			b, _ := s.fetchMaster(context.Background(), seq) // never fails without a deadline
+			ch <- b
 		}(fromBlock + uint32(i))
 	}
 
@@ -205,6 +261,13 @@ func (s *Service) fetchMasterLoop(fromBlock uint32, results chan<- *core.Block)
 	defer s.wg.Done()
 
 	for s.running() {
+		select {
+		case seq := <-s.rewind:
+			log.Info().Uint32("from_block", seq).Msg("fetching blocks again after rollback")
+			fromBlock = seq
+		default:
+		}
+
 		fromBlock = s.fetchMastersConcurrent(fromBlock, results)
 	}
 }
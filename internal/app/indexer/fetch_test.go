@@ -0,0 +1,23 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryWait(t *testing.T) {
+	backoff := time.Millisecond
+	require.Nil(t, retryWait(context.Background(), &backoff))
+	require.Equal(t, 2*time.Millisecond, backoff)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// does not wait for the backoff if the context is done
+	backoff = fetchRetryMaxBackoff
+	require.ErrorIs(t, retryWait(ctx, &backoff), context.Canceled)
+	require.Equal(t, fetchRetryMaxBackoff, backoff)
+}
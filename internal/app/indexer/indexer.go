@@ -7,24 +7,39 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 
+	"github.com/stepandra/anton/addr"
 	"github.com/stepandra/anton/internal/app"
 	"github.com/stepandra/anton/internal/core"
 	"github.com/stepandra/anton/internal/core/repository"
 	"github.com/stepandra/anton/internal/core/repository/account"
 	"github.com/stepandra/anton/internal/core/repository/block"
 	"github.com/stepandra/anton/internal/core/repository/msg"
+	"github.com/stepandra/anton/internal/core/repository/swap"
+	"github.com/stepandra/anton/internal/core/repository/transfer"
 	"github.com/stepandra/anton/internal/core/repository/tx"
+	"github.com/stepandra/anton/lru"
 )
 
 var _ app.IndexerService = (*Service)(nil)
 
+const jettonMinterCacheLen = 10000
+
 type Service struct {
 	*app.IndexerConfig
 
 	blockRepo   core.BlockRepository
 	txRepo      core.TransactionRepository
 	msgRepo     repository.Message
-	accountRepo core.AccountRepository
+	accountRepo repository.Account
+
+	transferRepo core.JettonTransferRepository
+	swapRepo     core.SwapRepository
+
+	// jettonMinterCache keeps minters of the jetton wallets used by DEX pools
+	jettonMinterCache *lru.Cache[addr.Address, addr.Address]
+
+	// rewind is used by saving loop to restart fetching from the given master block
+	rewind chan uint32
 
 	run bool
 	mx  sync.RWMutex
@@ -49,6 +64,12 @@ func NewService(cfg *app.IndexerConfig) *Service {
 	s.msgRepo = msg.NewRepository(ch, pg)
 	s.blockRepo = block.NewRepository(ch, pg)
 	s.accountRepo = account.NewRepository(ch, pg)
+	s.transferRepo = transfer.NewRepository(ch, pg)
+	s.swapRepo = swap.NewRepository(ch, pg)
+
+	s.jettonMinterCache = lru.New[addr.Address, addr.Address](jettonMinterCacheLen)
+
+	s.rewind = make(chan uint32, 1)
 
 	return s
 }
@@ -83,7 +104,7 @@ func (s *Service) Start() error {
 	go s.fetchMasterLoop(fromBlock, blocksChan)
 
 	s.wg.Add(1)
-	go s.saveBlocksLoop(blocksChan)
+	go s.saveBlocksLoop(lastMaster, blocksChan)
 
 	log.Info().
 		Uint32("from_block", fromBlock).
@@ -0,0 +1,137 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+// rollbackMasterBatch is the number of masterchain blocks removed in one database transaction.
+const rollbackMasterBatch = 64
+
+func (s *Service) rollbackBlocks(ctx context.Context, ids []core.BlockID) error {
+	dbTx, err := s.DB.PG.Begin()
+	if err != nil {
+		return errors.Wrap(err, "cannot begin db tx")
+	}
+	defer func() {
+		_ = dbTx.Rollback()
+	}()
+
+	if err := s.accountRepo.DeleteAccountStates(ctx, dbTx, ids); err != nil {
+		return errors.Wrap(err, "delete account states")
+	}
+	if err := s.transferRepo.DeleteJettonTransfers(ctx, dbTx, ids); err != nil {
+		return errors.Wrap(err, "delete jetton transfers")
+	}
+	if err := s.swapRepo.DeleteSwaps(ctx, dbTx, ids); err != nil {
+		return errors.Wrap(err, "delete swaps")
+	}
+	if err := s.msgRepo.DeleteMessages(ctx, dbTx, ids); err != nil {
+		return errors.Wrap(err, "delete messages")
+	}
+	if err := s.txRepo.DeleteTransactions(ctx, dbTx, ids); err != nil {
+		return errors.Wrap(err, "delete transactions")
+	}
+	if err := s.blockRepo.DeleteBlocks(ctx, dbTx, ids); err != nil {
+		return errors.Wrap(err, "delete blocks")
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return errors.Wrap(err, "cannot commit db tx")
+	}
+
+	return nil
+}
+
+func (s *Service) Rollback(ctx context.Context, toMaster uint32) error {
+	for {
+		last, err := s.blockRepo.GetLastMasterBlock(ctx)
+		if errors.Is(err, core.ErrNotFound) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "get last masterchain block")
+		}
+		if last.SeqNo <= toMaster {
+			return nil
+		}
+
+		// remove blocks from the top, so the rollback can be continued after an error
+		from := toMaster
+		if last.SeqNo-toMaster > rollbackMasterBatch {
+			from = last.SeqNo - rollbackMasterBatch
+		}
+
+		ids, err := s.blockRepo.GetBlocksAfterMaster(ctx, from)
+		if err != nil {
+			return errors.Wrapf(err, "get blocks after %d master", from)
+		}
+
+		if err := s.rollbackBlocks(ctx, ids); err != nil {
+			return errors.Wrapf(err, "rollback blocks after %d master", from)
+		}
+
+		log.Info().
+			Uint32("from_master_seq", last.SeqNo).
+			Uint32("to_master_seq", from).
+			Int("blocks_len", len(ids)).
+			Msg("rolled back blocks")
+	}
+}
+
+// consistentWithPrev checks that the given master block references the previous one.
+func consistentWithPrev(prev, b *core.Block) bool {
+	if b.SeqNo != prev.SeqNo+1 {
+		return false
+	}
+	if prev.FileHash == nil && prev.RootHash == nil {
+		return true
+	}
+	return bytes.Equal(b.PrevFileHash, prev.FileHash) &&
+		bytes.Equal(b.PrevRootHash, prev.RootHash)
+}
+
+// rollbackInconsistent removes the last saved master block, which is not referenced by the new one.
+// It returns the new last saved master block and restarts fetching from the next one.
+func (s *Service) rollbackInconsistent(ctx context.Context, last, b *core.Block) *core.Block {
+	log.Warn().
+		Uint32("master_seq", b.SeqNo).
+		Hex("prev_root_hash", b.PrevRootHash).
+		Hex("prev_file_hash", b.PrevFileHash).
+		Uint32("saved_master_seq", last.SeqNo).
+		Hex("saved_root_hash", last.RootHash).
+		Hex("saved_file_hash", last.FileHash).
+		Msg("masterchain block does not reference the saved one, rolling back")
+
+	for {
+		err := s.Rollback(ctx, last.SeqNo-1)
+		if err == nil {
+			break
+		}
+		log.Error().Err(err).Uint32("to_master_seq", last.SeqNo-1).Msg("rollback")
+		time.Sleep(time.Second)
+	}
+
+	newLast, err := s.blockRepo.GetLastMasterBlock(ctx)
+	if errors.Is(err, core.ErrNotFound) {
+		// nothing is saved, so we only check the order of the next blocks
+		newLast = &core.Block{Workchain: last.Workchain, Shard: last.Shard, SeqNo: last.SeqNo - 1}
+	} else if err != nil {
+		panic(errors.Wrap(err, "get last masterchain block"))
+	}
+
+	// there is only one rewind sender, so the queue can be just replaced
+	select {
+	case <-s.rewind:
+	default:
+	}
+	s.rewind <- last.SeqNo
+
+	return newLast
+}
@@ -0,0 +1,30 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func TestConsistentWithPrev(t *testing.T) {
+	prev := rndm.MasterBlock()
+
+	b := rndm.MasterBlock()
+	b.SeqNo = prev.SeqNo + 1
+	b.PrevFileHash, b.PrevRootHash = prev.FileHash, prev.RootHash
+	require.True(t, consistentWithPrev(prev, b))
+
+	b.PrevRootHash = rndm.Bytes(32)
+	require.False(t, consistentWithPrev(prev, b))
+
+	b.PrevRootHash = prev.RootHash
+	b.SeqNo = prev.SeqNo + 2
+	require.False(t, consistentWithPrev(prev, b))
+
+	// nothing is saved after the rollback
+	b.SeqNo = prev.SeqNo + 1
+	require.True(t, consistentWithPrev(&core.Block{SeqNo: prev.SeqNo}, b))
+}
@@ -29,6 +29,8 @@ func (s *Service) insertData(
 		_ = dbTx.Rollback()
 	}()
 
+	assignTraces(tx, msg)
+
 	for _, message := range msg {
 		err := s.Parser.ParseMessagePayload(ctx, message)
 		if errors.Is(err, app.ErrImpossibleParsing) {
@@ -71,6 +73,22 @@ func (s *Service) insertData(
 		return errors.Wrap(err, "add transactions")
 	}
 
+	transfers := jettonTransfers(tx, msg)
+	if err := func() error {
+		defer core.Timer(time.Now(), "AddJettonTransfers(%d)", len(transfers))
+		return s.transferRepo.AddJettonTransfers(ctx, dbTx, transfers)
+	}(); err != nil {
+		return errors.Wrap(err, "add jetton transfers")
+	}
+
+	swaps := poolSwaps(tx, msg, s.jettonMinterFunc(ctx, acc))
+	if err := func() error {
+		defer core.Timer(time.Now(), "AddSwaps(%d)", len(swaps))
+		return s.swapRepo.AddSwaps(ctx, dbTx, swaps)
+	}(); err != nil {
+		return errors.Wrap(err, "add swaps")
+	}
+
 	if err := func() error {
 		defer core.Timer(time.Now(), "AddBlocks(%d)", len(b))
 		return s.blockRepo.AddBlocks(ctx, dbTx, b)
@@ -134,7 +152,7 @@ func (s *Service) addMessage(msg *core.Message, uniqMsg map[string]*core.Message
 	}
 }
 
-func (s *Service) getMessagesSource(ctx context.Context, messages []*core.Message) (valid []*core.Message) {
+func (s *Service) getMessagesSource(ctx context.Context, messages []*core.Message, skipUnknown bool) (valid []*core.Message) {
 	var checkSourceHashes [][]byte
 	for _, msg := range messages {
 		checkSourceHashes = append(checkSourceHashes, msg.Hash)
@@ -155,6 +173,7 @@ func (s *Service) getMessagesSource(ctx context.Context, messages []*core.Messag
 		if source, ok := messageSourceMap[string(msg.Hash)]; ok {
 			msg.SrcTxLT, msg.SrcShard, msg.SrcBlockSeqNo, msg.SrcState =
 				source.SrcTxLT, source.SrcShard, source.SrcBlockSeqNo, source.SrcState
+			msg.TraceID = source.TraceID
 			valid = append(valid, msg)
 			continue
 		}
@@ -171,7 +190,7 @@ func (s *Service) getMessagesSource(ctx context.Context, messages []*core.Messag
 				panic(errors.Wrap(err, "count masterchain blocks"))
 			}
 		}
-		if totalBlocks < 1000 {
+		if skipUnknown || totalBlocks < 1000 {
 			log.Debug().
 				Hex("dst_tx_hash", msg.DstTxHash).
 				Int32("dst_workchain", msg.DstWorkchain).Int64("dst_shard", msg.DstShard).Uint32("dst_block_seq_no", msg.DstBlockSeqNo).
@@ -187,7 +206,9 @@ func (s *Service) getMessagesSource(ctx context.Context, messages []*core.Messag
 	return valid
 }
 
-func (s *Service) uniqMessages(ctx context.Context, transactions []*core.Transaction) []*core.Message {
+// uniqMessages merges messages from the given transactions and fills their source from the database.
+// If skipUnknownSource is set, messages with unknown source are omitted instead of panic.
+func (s *Service) uniqMessages(ctx context.Context, transactions []*core.Transaction, skipUnknownSource bool) []*core.Message {
 	defer core.Timer(time.Now(), "uniqMessages(%d)", len(transactions))
 
 	var ret []*core.Message
@@ -215,36 +236,45 @@ func (s *Service) uniqMessages(ctx context.Context, transactions []*core.Transac
 		ret = append(ret, msg)
 	}
 
-	return append(ret, s.getMessagesSource(ctx, checkSourceMessages)...)
+	return append(ret, s.getMessagesSource(ctx, checkSourceMessages, skipUnknownSource)...)
 }
 
 var lastLog = time.Now()
 
-func (s *Service) saveBlocks(ctx context.Context, masterBlocks []*core.Block) {
-	var (
-		newBlocks       []*core.Block
-		newTransactions []*core.Transaction
-		lastSeqNo       uint32
-	)
-
+func flattenBlocks(masterBlocks []*core.Block) (blocks []*core.Block, transactions []*core.Transaction, lastSeqNo uint32) {
 	for _, master := range masterBlocks {
 		if master.SeqNo > lastSeqNo {
 			lastSeqNo = master.SeqNo
 		}
 
-		newBlocks = append(newBlocks, master)
-		newBlocks = append(newBlocks, master.Shards...)
+		blocks = append(blocks, master)
+		blocks = append(blocks, master.Shards...)
 
-		newTransactions = append(newTransactions, master.Transactions...)
+		transactions = append(transactions, master.Transactions...)
 		for i := range master.Shards {
-			newTransactions = append(newTransactions, master.Shards[i].Transactions...)
+			transactions = append(transactions, master.Shards[i].Transactions...)
 		}
 	}
 
-	if err := s.insertData(ctx, s.uniqAccounts(newTransactions), s.uniqMessages(ctx, newTransactions), newTransactions, newBlocks); err != nil {
+	return blocks, transactions, lastSeqNo
+}
+
+func (s *Service) saveBlocks(ctx context.Context, masterBlocks []*core.Block) {
+	newBlocks, newTransactions, lastSeqNo := flattenBlocks(masterBlocks)
+
+	newMessages := s.uniqMessages(ctx, newTransactions, false)
+
+	if err := s.insertData(ctx, s.uniqAccounts(newTransactions), newMessages, newTransactions, newBlocks); err != nil {
 		panic(err)
 	}
 
+	if s.Stream != nil {
+		s.Stream.Publish(newTransactions, newMessages)
+	}
+	if s.Webhook != nil {
+		s.Webhook.Publish(newTransactions, newMessages)
+	}
+
 	lvl := log.Debug()
 	if time.Since(lastLog) > 10*time.Minute {
 		lvl = log.Info()
@@ -256,7 +286,7 @@ func (s *Service) saveBlocks(ctx context.Context, masterBlocks []*core.Block) {
 		Msg("inserted new block")
 }
 
-func (s *Service) saveBlocksLoop(results <-chan *core.Block) {
+func (s *Service) saveBlocksLoop(lastMaster *core.Block, results <-chan *core.Block) {
 	t := time.NewTicker(100 * time.Millisecond)
 	defer t.Stop()
 
@@ -273,7 +303,21 @@ func (s *Service) saveBlocksLoop(results <-chan *core.Block) {
 					Int("shards", len(b.Shards)).
 					Msg("new master")
 
+				if lastMaster != nil && b.SeqNo != lastMaster.SeqNo+1 {
+					// skip blocks fetched before the rollback
+					continue
+				}
+				if lastMaster != nil && !consistentWithPrev(lastMaster, b) {
+					if len(blocks) != 0 {
+						s.saveBlocks(context.Background(), blocks)
+						blocks = nil
+					}
+					lastMaster = s.rollbackInconsistent(context.Background(), lastMaster, b)
+					continue
+				}
+
 				blocks = append(blocks, b)
+				lastMaster = b
 
 			case <-t.C:
 				break _loop
@@ -0,0 +1,321 @@
+package indexer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/abi/known"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+const (
+	dedustSwap     = "dedust_v2_swap"
+	stonfiPoolSwap = "stonfi_pool_swap"
+	stonfiPayTo    = "stonfi_pool_pay_to"
+)
+
+// exit codes of stonfi_pool_pay_to sent by the pool on successful swap
+const (
+	stonfiSwapOK    = 0xc64370e5
+	stonfiSwapOKRef = 0x45078540
+)
+
+// stonfiProxyTON is the minter of STON.fi proxy TON, which wallets hold native TON in the pools
+var stonfiProxyTON = *addr.MustFromBase64("EQCM3B12QK1e4yZSf8GtBRT0aLMNyEsBc_DhVfRRtOEffLez")
+
+// jettonMinterFunc returns the minter of the given jetton wallet.
+type jettonMinterFunc func(wallet addr.Address) (addr.Address, error)
+
+// dedust_v2_swap external out message emitted by DeDust pool
+type dedustSwapEvent struct {
+	_         tlb.Magic        `tlb:"#9c610de3"`
+	AssetIn   *abi.DedustAsset `tlb:"."`
+	AssetOut  *abi.DedustAsset `tlb:"."`
+	AmountIn  tlb.Coins        `tlb:"."`
+	AmountOut tlb.Coins        `tlb:"."`
+	Next      struct {
+		SenderAddr   *address.Address `tlb:"addr"`
+		ReferralAddr *address.Address `tlb:"addr"`
+		Reserve0     tlb.Coins        `tlb:"."`
+		Reserve1     tlb.Coins        `tlb:"."`
+	} `tlb:"^"`
+}
+
+// stonfi_pool_swap message sent by STON.fi router to the pool
+type stonfiPoolSwapBody struct {
+	_           tlb.Magic        `tlb:"#25938561"`
+	QueryID     uint64           `tlb:"## 64"`
+	FromUser    *address.Address `tlb:"addr"`
+	TokenWallet *address.Address `tlb:"addr"`
+	Amount      tlb.Coins        `tlb:"."`
+	MinOut      tlb.Coins        `tlb:"."`
+}
+
+// stonfi_pool_pay_to message sent by STON.fi pool to the router
+type stonfiPayToBody struct {
+	_            tlb.Magic        `tlb:"#f93bb43f"`
+	QueryID      uint64           `tlb:"## 64"`
+	Owner        *address.Address `tlb:"addr"`
+	ExitCode     uint32           `tlb:"## 32"`
+	RefCoinsData struct {
+		Amount0Out    tlb.Coins        `tlb:"."`
+		Token0Address *address.Address `tlb:"addr"`
+		Amount1Out    tlb.Coins        `tlb:"."`
+		Token1Address *address.Address `tlb:"addr"`
+	} `tlb:"^"`
+}
+
+func loadBody(body []byte, v any) error {
+	c, err := cell.FromBOC(body)
+	if err != nil {
+		return errors.Wrap(err, "message body from boc")
+	}
+	return tlb.LoadFromCell(v, c.BeginParse())
+}
+
+func fromTonutils(a *address.Address) (ret addr.Address) {
+	if a == nil {
+		return ret
+	}
+	if x := addr.MustFromTonutils(a); x != nil {
+		ret = *x
+	}
+	return ret
+}
+
+// dedustAssetAddress returns jetton minter address or empty address for native TON.
+func dedustAssetAddress(a *abi.DedustAsset) (addr.Address, error) {
+	if a == nil {
+		return addr.Address{}, errors.New("no asset")
+	}
+	switch v := a.Asset.(type) {
+	case *abi.DedustAssetNative:
+		return addr.Address{}, nil
+	case *abi.DedustAssetJetton:
+		return fromTonutils(address.NewAddress(0, byte(v.Workchain), v.Address)), nil
+	default:
+		return addr.Address{}, fmt.Errorf("unsupported dedust asset %T", a.Asset)
+	}
+}
+
+// stonfiAssetAddress returns jetton minter of the router jetton wallet or empty address for proxy TON.
+func stonfiAssetAddress(wallet *address.Address, minterOf jettonMinterFunc) (addr.Address, error) {
+	w := fromTonutils(wallet)
+	if w == (addr.Address{}) {
+		return addr.Address{}, errors.New("no jetton wallet")
+	}
+	minter, err := minterOf(w)
+	if err != nil {
+		return addr.Address{}, errors.Wrapf(err, "get minter of %s jetton wallet", w.Base64())
+	}
+	if minter == stonfiProxyTON {
+		return addr.Address{}, nil
+	}
+	return minter, nil
+}
+
+// verifiedPool checks that the transaction account is the pool confirmed by its factory or router.
+func verifiedPool(tx *core.Transaction, pool abi.ContractName) bool {
+	if tx.Account == nil || tx.Account.Fake {
+		return false
+	}
+	for _, t := range tx.Account.Types {
+		if t == pool {
+			return true
+		}
+	}
+	return false
+}
+
+// swapPrice returns the amount of the first asset of the pair paid for one unit of the second asset.
+func swapPrice(s *core.Swap) (float64, bool) {
+	amount0, amount1 := s.AmountIn.ToMathBig(), s.AmountOut.ToMathBig()
+	if bytes.Compare(s.AssetIn[:], s.AssetOut[:]) > 0 {
+		amount0, amount1 = amount1, amount0
+	}
+	if amount1.Sign() == 0 {
+		return 0, false
+	}
+	price, _ := new(big.Float).Quo(new(big.Float).SetInt(amount0), new(big.Float).SetInt(amount1)).Float64()
+	return price, true
+}
+
+func newPoolSwap(dex core.DEX, tx *core.Transaction) *core.Swap {
+	return &core.Swap{
+		TxHash:      tx.Hash,
+		TraceID:     tx.TraceID,
+		DEX:         dex,
+		PoolAddress: tx.Address,
+		Workchain:   tx.Workchain,
+		Shard:       tx.Shard,
+		BlockSeqNo:  tx.BlockSeqNo,
+		CreatedLT:   tx.CreatedLT,
+		CreatedAt:   tx.CreatedAt,
+	}
+}
+
+func mapDedustSwap(tx *core.Transaction, event *core.Message) (*core.Swap, error) {
+	var body dedustSwapEvent
+	if err := loadBody(event.Body, &body); err != nil {
+		return nil, errors.Wrap(err, "load dedust swap event")
+	}
+
+	s := newPoolSwap(core.DeDustV2, tx)
+	s.Trader = fromTonutils(body.Next.SenderAddr)
+	s.AmountIn = bunbig.FromMathBig(body.AmountIn.Nano())
+	s.AmountOut = bunbig.FromMathBig(body.AmountOut.Nano())
+
+	var err error
+	if s.AssetIn, err = dedustAssetAddress(body.AssetIn); err != nil {
+		return nil, errors.Wrap(err, "asset in")
+	}
+	if s.AssetOut, err = dedustAssetAddress(body.AssetOut); err != nil {
+		return nil, errors.Wrap(err, "asset out")
+	}
+
+	return s, nil
+}
+
+func mapStonfiSwap(tx *core.Transaction, in, payTo *core.Message, minterOf jettonMinterFunc) (*core.Swap, error) {
+	var swap stonfiPoolSwapBody
+	if err := loadBody(in.Body, &swap); err != nil {
+		return nil, errors.Wrap(err, "load stonfi pool swap")
+	}
+
+	var pay stonfiPayToBody
+	if err := loadBody(payTo.Body, &pay); err != nil {
+		return nil, errors.Wrap(err, "load stonfi pay to")
+	}
+	if pay.ExitCode != stonfiSwapOK && pay.ExitCode != stonfiSwapOKRef {
+		return nil, nil // swap is refunded
+	}
+
+	s := newPoolSwap(core.StonFi, tx)
+	s.Trader = fromTonutils(swap.FromUser)
+	s.AmountIn = bunbig.FromMathBig(swap.Amount.Nano())
+
+	walletOut, out := pay.RefCoinsData.Token1Address, pay.RefCoinsData
+	s.AmountOut = bunbig.FromMathBig(out.Amount1Out.Nano())
+	if out.Amount0Out.Nano().Sign() != 0 {
+		walletOut, s.AmountOut = out.Token0Address, bunbig.FromMathBig(out.Amount0Out.Nano())
+	}
+
+	var err error
+	if s.AssetIn, err = stonfiAssetAddress(swap.TokenWallet, minterOf); err != nil {
+		return nil, errors.Wrap(err, "asset in")
+	}
+	if s.AssetOut, err = stonfiAssetAddress(walletOut, minterOf); err != nil {
+		return nil, errors.Wrap(err, "asset out")
+	}
+
+	return s, nil
+}
+
+func mapSwap(tx *core.Transaction, messages map[string]*core.Message, minterOf jettonMinterFunc) (*core.Swap, error) {
+	in := messages[string(tx.InMsgHash)]
+
+	for _, o := range tx.OutMsg {
+		out, ok := messages[string(o.Hash)]
+		if !ok {
+			continue
+		}
+		switch {
+		case out.OperationName == dedustSwap && verifiedPool(tx, known.DedustV2Pool):
+			return mapDedustSwap(tx, out)
+		case out.OperationName == stonfiPayTo && in != nil && in.OperationName == stonfiPoolSwap && verifiedPool(tx, known.StonFiPool):
+			return mapStonfiSwap(tx, in, out, minterOf)
+		}
+	}
+
+	return nil, nil
+}
+
+// poolSwaps finds swaps executed by DeDust and STON.fi pools in the given transactions.
+// Pools, which are not confirmed by their factory or router, are skipped.
+func poolSwaps(transactions []*core.Transaction, messages []*core.Message, minterOf jettonMinterFunc) (ret []*core.Swap) {
+	byHash := make(map[string]*core.Message, len(messages))
+	for _, msg := range messages {
+		byHash[string(msg.Hash)] = msg
+	}
+
+	for _, tx := range transactions {
+		s, err := mapSwap(tx, byHash, minterOf)
+		if err != nil {
+			log.Error().Err(err).Hex("tx_hash", tx.Hash).Str("pool", tx.Address.String()).Msg("map swap")
+			continue
+		}
+		if s == nil {
+			continue
+		}
+
+		var ok bool
+		if s.Price, ok = swapPrice(s); !ok {
+			continue
+		}
+		ret = append(ret, s)
+	}
+
+	return ret
+}
+
+func walletMinter(st *core.AccountState) (addr.Address, error) {
+	if st.Fake {
+		return addr.Address{}, errors.Wrap(core.ErrInvalidArg, "fake jetton wallet")
+	}
+	if st.MinterAddress == nil {
+		return addr.Address{}, errors.Wrap(core.ErrNotFound, "no jetton minter address")
+	}
+	return *st.MinterAddress, nil
+}
+
+// jettonMinterFunc looks for the jetton wallet state in the given batch of account states,
+// and then for its minter in the cache or in the latest wallet state from the database.
+func (s *Service) jettonMinterFunc(ctx context.Context, batch []*core.AccountState) jettonMinterFunc {
+	latest := make(map[addr.Address]*core.AccountState, len(batch))
+	for _, st := range batch {
+		if prev, ok := latest[st.Address]; !ok || prev.LastTxLT < st.LastTxLT {
+			latest[st.Address] = st
+		}
+	}
+
+	return func(wallet addr.Address) (addr.Address, error) {
+		if m, ok := s.jettonMinterCache.Get(wallet); ok {
+			return m, nil
+		}
+
+		st, ok := latest[wallet]
+		if !ok {
+			res, err := s.accountRepo.FilterAccounts(ctx, &filter.AccountsReq{
+				Addresses:   []*addr.Address{&wallet},
+				LatestState: true,
+				Limit:       1,
+			})
+			if err != nil {
+				return addr.Address{}, errors.Wrap(err, "filter accounts")
+			}
+			if len(res.Rows) == 0 {
+				return addr.Address{}, errors.Wrap(core.ErrNotFound, "no jetton wallet state")
+			}
+			st = res.Rows[0]
+		}
+
+		m, err := walletMinter(st)
+		if err != nil {
+			return addr.Address{}, err
+		}
+		s.jettonMinterCache.Put(wallet, m)
+		return m, nil
+	}
+}
@@ -0,0 +1,161 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/abi/known"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func TestPoolSwaps(t *testing.T) {
+	trader, jetton := rndm.Address(), rndm.Address()
+	jettonTU := jetton.MustToTonutils()
+
+	newTx := func(pool abi.ContractName, in *core.Message, out ...*core.Message) *core.Transaction {
+		tx := &core.Transaction{
+			Address: *rndm.Address(), Hash: rndm.Bytes(32), TraceID: rndm.Bytes(32), OutMsg: out,
+			Workchain: 0, Shard: -9223372036854775808, BlockSeqNo: 1000, CreatedLT: 100, CreatedAt: time.Now().UTC(),
+		}
+		tx.Account = &core.AccountState{Address: tx.Address, Types: []abi.ContractName{pool}}
+		if in != nil {
+			tx.InMsgHash = in.Hash
+		}
+		return tx
+	}
+	newMsg := func(op string, body *cell.Cell) *core.Message {
+		return &core.Message{Hash: rndm.Bytes(32), OperationName: op, Body: body.ToBOC()}
+	}
+
+	// DeDust: 10 TON are sold for 25 jettons
+	dedust := newMsg(dedustSwap, cell.BeginCell().
+		MustStoreUInt(0x9c610de3, 32).
+		MustStoreUInt(0b0000, 4).
+		MustStoreUInt(0b0001, 4).MustStoreInt(int64(jettonTU.Workchain()), 8).MustStoreSlice(jettonTU.Data(), 256).
+		MustStoreBigCoins(tlb.MustFromTON("10").Nano()).
+		MustStoreBigCoins(tlb.MustFromTON("25").Nano()).
+		MustStoreRef(cell.BeginCell().
+			MustStoreAddr(trader.MustToTonutils()).
+			MustStoreAddr(nil).
+			MustStoreBigCoins(tlb.MustFromTON("1000").Nano()).
+			MustStoreBigCoins(tlb.MustFromTON("2500").Nano()).
+			EndCell()).
+		EndCell())
+	dedustTx := newTx(known.DedustV2Pool, newMsg("", cell.BeginCell().EndCell()), dedust)
+
+	// STON.fi: 3 jettons are sold for 6 proxy TON
+	wallet0, wallet1 := rndm.Address(), rndm.Address()
+	minters := map[addr.Address]addr.Address{*wallet0: stonfiProxyTON, *wallet1: *jetton}
+	minterOf := func(wallet addr.Address) (addr.Address, error) {
+		m, ok := minters[wallet]
+		if !ok {
+			return addr.Address{}, core.ErrNotFound
+		}
+		return m, nil
+	}
+
+	stonfiBody := stonfiPoolSwapBody{
+		QueryID:     1,
+		FromUser:    trader.MustToTonutils(),
+		TokenWallet: wallet1.MustToTonutils(),
+		Amount:      tlb.MustFromTON("3"),
+		MinOut:      tlb.MustFromTON("5"),
+	}
+	payTo := func(exitCode uint32) *core.Message {
+		var pay stonfiPayToBody
+		pay.Owner, pay.ExitCode = trader.MustToTonutils(), exitCode
+		pay.RefCoinsData.Amount0Out, pay.RefCoinsData.Token0Address = tlb.MustFromTON("6"), wallet0.MustToTonutils()
+		pay.RefCoinsData.Amount1Out, pay.RefCoinsData.Token1Address = tlb.MustFromTON("0"), wallet1.MustToTonutils()
+		c, err := tlb.ToCell(&pay)
+		require.Nil(t, err)
+		return newMsg(stonfiPayTo, c)
+	}
+	swapCell, err := tlb.ToCell(&stonfiBody)
+	require.Nil(t, err)
+
+	stonfiIn, stonfiOut := newMsg(stonfiPoolSwap, swapCell), payTo(stonfiSwapOK)
+	stonfiTx := newTx(known.StonFiPool, stonfiIn, stonfiOut)
+
+	refundIn, refundOut := newMsg(stonfiPoolSwap, swapCell), payTo(0x5ffe1295)
+	refundTx := newTx(known.StonFiPool, refundIn, refundOut)
+
+	fakeIn, fakeOut := newMsg(stonfiPoolSwap, swapCell), payTo(stonfiSwapOK)
+	fakeTx := newTx(known.StonFiPool, fakeIn, fakeOut)
+	fakeTx.Account.Fake = true
+
+	unknownIn, unknownOut := newMsg(stonfiPoolSwap, swapCell), payTo(stonfiSwapOK)
+	unknownTx := newTx("", unknownIn, unknownOut)
+
+	unresolvedIn, unresolvedOut := newMsg(stonfiPoolSwap, swapCell), payTo(stonfiSwapOK)
+	unresolvedTx := newTx(known.StonFiPool, unresolvedIn, unresolvedOut)
+
+	other := newTx("", nil, newMsg("jetton_transfer", cell.BeginCell().EndCell()))
+
+	swaps := poolSwaps(
+		[]*core.Transaction{dedustTx, stonfiTx, refundTx, fakeTx, unknownTx, other},
+		[]*core.Message{dedust, stonfiIn, stonfiOut, refundIn, refundOut, fakeIn, fakeOut, unknownIn, unknownOut},
+		minterOf)
+	require.Len(t, swaps, 2)
+
+	delete(minters, *wallet0)
+	unresolved := poolSwaps([]*core.Transaction{unresolvedTx}, []*core.Message{unresolvedIn, unresolvedOut}, minterOf)
+	require.Len(t, unresolved, 0)
+
+	d := swaps[0]
+	require.Equal(t, core.DeDustV2, d.DEX)
+	require.Equal(t, dedustTx.Hash, d.TxHash)
+	require.Equal(t, dedustTx.TraceID, d.TraceID)
+	require.Equal(t, dedustTx.Address, d.PoolAddress)
+	require.Equal(t, *trader, d.Trader)
+	require.Equal(t, addr.Address{}, d.AssetIn)
+	require.Equal(t, *jetton, d.AssetOut)
+	require.Equal(t, "10000000000", d.AmountIn.String())
+	require.Equal(t, "25000000000", d.AmountOut.String())
+	require.Equal(t, 0.4, d.Price) // TON goes first
+	require.Equal(t, dedustTx.BlockSeqNo, d.BlockSeqNo)
+	require.Equal(t, dedustTx.CreatedAt, d.CreatedAt)
+
+	s := swaps[1]
+	require.Equal(t, core.StonFi, s.DEX)
+	require.Equal(t, stonfiTx.Address, s.PoolAddress)
+	require.Equal(t, *trader, s.Trader)
+	require.Equal(t, *jetton, s.AssetIn)
+	require.Equal(t, addr.Address{}, s.AssetOut)
+	require.Equal(t, "3000000000", s.AmountIn.String())
+	require.Equal(t, "6000000000", s.AmountOut.String())
+	require.Equal(t, 2.0, s.Price) // TON goes first
+}
+
+func TestDedustAssetAddress_ExtraCurrency(t *testing.T) {
+	var body dedustSwapEvent
+
+	c := cell.BeginCell().
+		MustStoreUInt(0x9c610de3, 32).
+		MustStoreUInt(0b0010, 4).MustStoreInt(1, 32).
+		MustStoreUInt(0b0000, 4).
+		MustStoreBigCoins(tlb.MustFromTON("1").Nano()).
+		MustStoreBigCoins(tlb.MustFromTON("1").Nano()).
+		MustStoreRef(cell.BeginCell().
+			MustStoreAddr(address.NewAddressNone()).
+			MustStoreAddr(nil).
+			MustStoreBigCoins(tlb.MustFromTON("1").Nano()).
+			MustStoreBigCoins(tlb.MustFromTON("1").Nano()).
+			EndCell()).
+		EndCell()
+	require.Nil(t, tlb.LoadFromCell(&body, c.BeginParse()))
+
+	_, err := dedustAssetAddress(body.AssetIn)
+	require.NotNil(t, err)
+
+	a, err := dedustAssetAddress(body.AssetOut)
+	require.Nil(t, err)
+	require.Equal(t, addr.Address{}, a)
+}
@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"sort"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+// assignTraces sets trace id of the given transactions and messages.
+// Trace id is the hash of the transaction, which has no incoming internal message,
+// for example, the transaction caused by an external message.
+// Transactions are handled in the order of logical time, so the trace id of an incoming message
+// is already known from its source transaction in the same batch
+// or from the source message loaded from the database in the previous batches.
+func assignTraces(transactions []*core.Transaction, messages []*core.Message) {
+	traces := make(map[string][]byte)
+
+	for _, msg := range messages {
+		if len(msg.TraceID) > 0 {
+			traces[string(msg.Hash)] = msg.TraceID
+		}
+	}
+
+	sorted := make([]*core.Transaction, len(transactions))
+	copy(sorted, transactions)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedLT < sorted[j].CreatedLT })
+
+	for _, tx := range sorted {
+		traceID := tx.Hash
+
+		if in := tx.InMsg; in != nil {
+			if id, ok := traces[string(in.Hash)]; ok && in.Type == core.Internal {
+				traceID = id
+			} else if !ok {
+				traces[string(in.Hash)] = traceID
+			}
+			in.TraceID = traces[string(in.Hash)]
+		}
+
+		tx.TraceID = traceID
+
+		for _, out := range tx.OutMsg {
+			traces[string(out.Hash)] = traceID
+			out.TraceID = traceID
+		}
+	}
+
+	for _, msg := range messages {
+		msg.TraceID = traces[string(msg.Hash)]
+	}
+}
@@ -0,0 +1,70 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func traceMsg(t core.MessageType) *core.Message {
+	return &core.Message{Type: t, Hash: rndm.Bytes(32)}
+}
+
+func traceTx(lt uint64, in *core.Message, out ...*core.Message) *core.Transaction {
+	return &core.Transaction{Hash: rndm.Bytes(32), CreatedLT: lt, InMsg: in, OutMsg: out}
+}
+
+func copyMsg(m *core.Message) *core.Message {
+	c := *m
+	return &c
+}
+
+func TestAssignTraces(t *testing.T) {
+	ext, transfer, internal := traceMsg(core.ExternalIn), traceMsg(core.Internal), traceMsg(core.Internal)
+	notification, excesses := traceMsg(core.Internal), traceMsg(core.Internal)
+
+	wallet := traceTx(100, ext, transfer)
+	jettonWallet := traceTx(102, copyMsg(transfer), internal)
+	tickTock := traceTx(101, nil)
+
+	// messages are merged by hash in uniqMessages
+	batch1 := []*core.Transaction{jettonWallet, tickTock, wallet}
+	assignTraces(batch1, []*core.Message{ext, transfer, internal})
+
+	require.Equal(t, wallet.Hash, wallet.TraceID)
+	require.Equal(t, wallet.Hash, jettonWallet.TraceID)
+	require.Equal(t, tickTock.Hash, tickTock.TraceID)
+	for _, m := range []*core.Message{ext, transfer, internal, jettonWallet.InMsg} {
+		require.Equal(t, wallet.Hash, m.TraceID)
+	}
+
+	// the rest of the trace is in the next master block,
+	// trace id of the incoming message is loaded from the database with its source
+	internalDst := copyMsg(internal)
+	internalDst.TraceID = nil
+	internalSrc := copyMsg(internal)
+
+	receiver := traceTx(104, internalDst, notification, excesses)
+	owner := traceTx(106, copyMsg(notification))
+	sender := traceTx(106, copyMsg(excesses))
+	unknown := traceTx(105, traceMsg(core.Internal))
+
+	batch2 := []*core.Transaction{sender, owner, unknown, receiver}
+	messages := []*core.Message{internalSrc, notification, excesses, unknown.InMsg}
+	assignTraces(batch2, messages)
+
+	for _, tx := range []*core.Transaction{receiver, owner, sender} {
+		require.Equal(t, wallet.Hash, tx.TraceID)
+		require.Equal(t, wallet.Hash, tx.InMsg.TraceID)
+	}
+	for _, m := range messages[:3] {
+		require.Equal(t, wallet.Hash, m.TraceID)
+	}
+
+	// message with unknown source starts a new trace
+	require.Equal(t, unknown.Hash, unknown.TraceID)
+	require.Equal(t, unknown.Hash, unknown.InMsg.TraceID)
+}
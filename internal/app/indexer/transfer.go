@@ -0,0 +1,129 @@
+package indexer
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+)
+
+const jettonInternalTransfer = "jetton_internal_transfer"
+
+// jetton_internal_transfer message body from TEP-74, as it is parsed with the known interface
+type jettonInternalTransferData struct {
+	QueryID          uint64           `json:"query_id"`
+	Amount           tlb.Coins        `json:"amount"`
+	From             *address.Address `json:"from"`
+	ResponseAddress  *address.Address `json:"response_address"`
+	ForwardTONAmount tlb.Coins        `json:"forward_ton_amount"`
+	ForwardPayload   *cell.Cell       `json:"forward_payload"`
+}
+
+// transactionAborted checks if the transaction was aborted, so the incoming message has been bounced.
+func transactionAborted(tx *core.Transaction) bool {
+	c, err := cell.FromBOC(tx.Description)
+	if err == nil {
+		var d tlb.TransactionDescription
+		if err := tlb.LoadFromCell(&d, c.BeginParse()); err == nil {
+			if o, ok := d.Description.(tlb.TransactionDescriptionOrdinary); ok {
+				return o.Aborted
+			}
+		}
+	}
+	return (tx.ComputePhaseExitCode != 0 && tx.ComputePhaseExitCode != 1) || tx.ActionPhaseResultCode != 0
+}
+
+func mapJettonTransfer(msg *core.Message, dstTx *core.Transaction) (*core.JettonTransfer, error) {
+	if len(msg.DataJSON) == 0 {
+		return nil, errors.New("no parsed jetton internal transfer")
+	}
+
+	var body jettonInternalTransferData
+	if err := json.Unmarshal(msg.DataJSON, &body); err != nil {
+		return nil, errors.Wrap(err, "unmarshal jetton internal transfer")
+	}
+
+	t := &core.JettonTransfer{
+		MsgHash:          msg.Hash,
+		TraceID:          msg.TraceID,
+		SrcWallet:        msg.SrcAddress,
+		DstWallet:        msg.DstAddress,
+		Amount:           bunbig.FromMathBig(body.Amount.Nano()),
+		QueryID:          body.QueryID,
+		ForwardTonAmount: bunbig.FromMathBig(body.ForwardTONAmount.Nano()),
+		Status:           core.JettonTransferSuccess,
+		Workchain:        dstTx.Workchain,
+		Shard:            dstTx.Shard,
+		BlockSeqNo:       dstTx.BlockSeqNo,
+		DstTxLT:          dstTx.CreatedLT,
+		CreatedLT:        msg.CreatedLT,
+		CreatedAt:        msg.CreatedAt,
+		ReceivedAt:       dstTx.CreatedAt,
+	}
+	if body.From != nil {
+		if from := addr.MustFromTonutils(body.From); from != nil {
+			t.SrcOwner = *from
+		}
+	}
+	if body.ForwardPayload != nil {
+		t.ForwardPayload = body.ForwardPayload.ToBOC()
+	}
+	if transactionAborted(dstTx) {
+		t.Status = core.JettonTransferBounced
+	}
+
+	if st := msg.DstState; st != nil {
+		if st.OwnerAddress != nil {
+			t.DstOwner = *st.OwnerAddress
+		}
+		if st.MinterAddress != nil {
+			t.MinterAddress = *st.MinterAddress
+		}
+	}
+	if st := msg.SrcState; st != nil && st.MinterAddress != nil && t.MinterAddress == (addr.Address{}) {
+		t.MinterAddress = *st.MinterAddress
+	}
+
+	return t, nil
+}
+
+// jettonTransfers builds jetton transfers from parsed jetton_internal_transfer messages,
+// which are received by transactions of the given batch.
+// Messages sent in the batch, but not received yet, are handled with the batch of the destination transaction.
+func jettonTransfers(transactions []*core.Transaction, messages []*core.Message) (ret []*core.JettonTransfer) {
+	byInMsg := make(map[string]*core.Transaction, len(transactions))
+	for _, tx := range transactions {
+		if len(tx.InMsgHash) > 0 {
+			byInMsg[string(tx.InMsgHash)] = tx
+		}
+	}
+
+	for _, msg := range messages {
+		if msg.Type != core.Internal || msg.OperationName != jettonInternalTransfer {
+			continue
+		}
+		if (msg.SrcState != nil && msg.SrcState.Fake) || (msg.DstState != nil && msg.DstState.Fake) {
+			continue // wallet does not belong to its minter, the transfer is not a real jetton transfer
+		}
+		dstTx, ok := byInMsg[string(msg.Hash)]
+		if !ok {
+			continue
+		}
+
+		t, err := mapJettonTransfer(msg, dstTx)
+		if err != nil {
+			log.Error().Err(err).Hex("msg_hash", msg.Hash).Msg("map jetton transfer")
+			continue
+		}
+		ret = append(ret, t)
+	}
+
+	return ret
+}
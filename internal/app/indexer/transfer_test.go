@@ -0,0 +1,84 @@
+package indexer
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func TestJettonTransfers(t *testing.T) {
+	minter, srcOwner, dstOwner := rndm.Address(), rndm.Address(), rndm.Address()
+	payload := cell.BeginCell().MustStoreUInt(0, 32).MustStoreStringSnake("hello").EndCell()
+
+	data, err := json.Marshal(&jettonInternalTransferData{
+		QueryID:          42,
+		Amount:           tlb.MustFromTON("1.5"),
+		From:             srcOwner.MustToTonutils(),
+		ResponseAddress:  srcOwner.MustToTonutils(),
+		ForwardTONAmount: tlb.MustFromNano(big.NewInt(1), 0),
+		ForwardPayload:   payload,
+	})
+	require.Nil(t, err)
+
+	newMsg := func() *core.Message {
+		return &core.Message{
+			Type:          core.Internal,
+			Hash:          rndm.Bytes(32),
+			TraceID:       rndm.Bytes(32),
+			SrcAddress:    *rndm.Address(),
+			DstAddress:    *rndm.Address(),
+			OperationName: jettonInternalTransfer,
+			DataJSON:      data,
+			SrcState:      &core.AccountState{MinterAddress: minter},
+			DstState:      &core.AccountState{OwnerAddress: dstOwner, MinterAddress: minter},
+			CreatedLT:     100,
+			CreatedAt:     time.Now().Add(-time.Second).UTC(),
+		}
+	}
+	newTx := func(in *core.Message, exitCode int32) *core.Transaction {
+		return &core.Transaction{
+			Hash: rndm.Bytes(32), InMsgHash: in.Hash, CreatedLT: 102, CreatedAt: time.Now().UTC(),
+			Workchain: 0, Shard: -9223372036854775808, BlockSeqNo: 1000, ComputePhaseExitCode: exitCode,
+		}
+	}
+
+	success, bounced, pending := newMsg(), newMsg(), newMsg()
+	other := newMsg()
+	other.OperationName = "jetton_transfer"
+	fakeSrc, fakeDst := newMsg(), newMsg()
+	fakeSrc.SrcState.Fake = true
+	fakeDst.DstState.Fake = true
+	txs := []*core.Transaction{newTx(success, 0), newTx(bounced, 47), newTx(other, 0), newTx(fakeSrc, 0), newTx(fakeDst, 0)}
+
+	transfers := jettonTransfers(txs, []*core.Message{success, bounced, pending, other, fakeSrc, fakeDst})
+	require.Len(t, transfers, 2)
+
+	s := transfers[0]
+	require.Equal(t, success.Hash, s.MsgHash)
+	require.Equal(t, success.TraceID, s.TraceID)
+	require.Equal(t, *minter, s.MinterAddress)
+	require.Equal(t, success.SrcAddress, s.SrcWallet)
+	require.Equal(t, *srcOwner, s.SrcOwner)
+	require.Equal(t, success.DstAddress, s.DstWallet)
+	require.Equal(t, *dstOwner, s.DstOwner)
+	require.Equal(t, "1500000000", s.Amount.String())
+	require.Equal(t, uint64(42), s.QueryID)
+	require.Equal(t, "1", s.ForwardTonAmount.String())
+	require.Equal(t, payload.ToBOC(), s.ForwardPayload)
+	require.Equal(t, core.JettonTransferSuccess, s.Status)
+	require.Equal(t, txs[0].BlockSeqNo, s.BlockSeqNo)
+	require.Equal(t, txs[0].CreatedLT, s.DstTxLT)
+	require.Equal(t, success.CreatedAt, s.CreatedAt)
+	require.Equal(t, txs[0].CreatedAt, s.ReceivedAt)
+
+	require.Equal(t, bounced.Hash, transfers[1].MsgHash)
+	require.Equal(t, core.JettonTransferBounced, transfers[1].Status)
+}
@@ -0,0 +1,43 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+type MetadataConfig struct {
+	MetadataRepo core.MetadataRepository
+
+	// Client fetches documents, by default it connects only to public addresses.
+	Client *http.Client
+
+	// IPFSGateway is used to resolve ipfs:// URIs.
+	IPFSGateway string
+
+	// Workers is the number of concurrent fetches.
+	Workers int
+	// RateLimit is the maximum number of requests per second.
+	RateLimit int
+	// MaxSize is the maximum size of metadata document in bytes.
+	MaxSize int64
+	// Timeout limits the duration of one request.
+	Timeout time.Duration
+
+	// RefetchInterval is how often successfully fetched documents are fetched again.
+	RefetchInterval time.Duration
+	// RetryBackoff is the delay before the first retry of failed fetch, it doubles on every next failure
+	// and is limited by RefetchInterval.
+	RetryBackoff time.Duration
+
+	// SelectLimit is the number of URIs taken for fetching at once.
+	SelectLimit int
+	// PollInterval is how often new URIs are looked up in the database.
+	PollInterval time.Duration
+}
+
+type MetadataService interface {
+	Start() error
+	Stop()
+}
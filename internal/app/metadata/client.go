@@ -0,0 +1,103 @@
+package metadata
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrForbiddenAddress is returned on attempts to connect to a non-public address.
+var ErrForbiddenAddress = errors.New("forbidden address")
+
+const maxRedirects = 5
+
+// nonPublicNets are special-purpose ranges, which are not covered by net.IP methods.
+var nonPublicNets = func() (ret []*net.IPNet) {
+	for _, cidr := range []string{
+		"0.0.0.0/8",       // "this" network
+		"100.64.0.0/10",   // carrier-grade NAT
+		"192.0.0.0/24",    // IETF protocol assignments
+		"192.0.2.0/24",    // TEST-NET-1
+		"198.18.0.0/15",   // benchmarking
+		"198.51.100.0/24", // TEST-NET-2
+		"203.0.113.0/24",  // TEST-NET-3
+		"240.0.0.0/4",     // reserved
+		"64:ff9b::/96",    // IPv4/IPv6 translation
+		"2001:db8::/32",   // documentation
+	} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, n)
+	}
+	return ret
+}()
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// dialControl is called after DNS resolution with the exact address to connect to,
+// so it also catches host names resolving to internal addresses.
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return errors.Wrapf(ErrForbiddenAddress, "%s", host)
+	}
+	return nil
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return errors.Wrapf(ErrUnsupportedURI, "redirect to '%s' scheme", req.URL.Scheme)
+	}
+	if ip := net.ParseIP(req.URL.Hostname()); ip != nil && !isPublicIP(ip) {
+		return errors.Wrapf(ErrForbiddenAddress, "redirect to %s", ip)
+	}
+	// host names are checked by the dialer after resolution
+	return nil
+}
+
+// NewClient returns http client for fetching documents by URIs from the untrusted token content.
+// It connects only to public addresses, including redirects, so the content
+// cannot make the fetcher request internal services or cloud metadata endpoints.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: dialControl,
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 nil, // proxy would connect to the target instead of the dialer
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   5 * time.Second,
+			ResponseHeaderTimeout: timeout,
+		},
+		CheckRedirect: checkRedirect,
+		Timeout:       timeout,
+	}
+}
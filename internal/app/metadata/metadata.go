@@ -0,0 +1,323 @@
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ app.MetadataService = (*Service)(nil)
+
+var ErrUnsupportedURI = errors.New("unsupported uri")
+
+type Service struct {
+	*app.MetadataConfig
+
+	limiter *time.Ticker
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewService(cfg *app.MetadataConfig) *Service {
+	var s = new(Service)
+
+	s.MetadataConfig = cfg
+
+	// validate config
+	if s.IPFSGateway == "" {
+		s.IPFSGateway = "https://ipfs.io/ipfs/"
+	}
+	if !strings.HasSuffix(s.IPFSGateway, "/") {
+		s.IPFSGateway += "/"
+	}
+	if s.Workers < 1 {
+		s.Workers = 4
+	}
+	if s.RateLimit < 1 {
+		s.RateLimit = 10
+	}
+	if s.MaxSize <= 0 {
+		s.MaxSize = 1 << 20
+	}
+	if s.Timeout <= 0 {
+		s.Timeout = 10 * time.Second
+	}
+	if s.Client == nil {
+		s.Client = NewClient(s.Timeout)
+	}
+	if s.RefetchInterval <= 0 {
+		s.RefetchInterval = 24 * time.Hour
+	}
+	if s.RetryBackoff <= 0 {
+		s.RetryBackoff = time.Minute
+	}
+	if s.SelectLimit < 1 {
+		s.SelectLimit = 100
+	}
+	if s.PollInterval <= 0 {
+		s.PollInterval = 10 * time.Second
+	}
+
+	s.limiter = time.NewTicker(time.Second / time.Duration(s.RateLimit))
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	return s
+}
+
+func (s *Service) Start() error {
+	s.wg.Add(1)
+	go s.fetchLoop()
+
+	log.Info().
+		Int("workers", s.Workers).
+		Int("rate_limit", s.RateLimit).
+		Msg("metadata fetcher started")
+
+	return nil
+}
+
+func (s *Service) Stop() {
+	s.cancel()
+	s.wg.Wait()
+	s.limiter.Stop()
+}
+
+func (s *Service) resolveURI(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", errors.Wrapf(ErrUnsupportedURI, "parse %s: %s", uri, err.Error())
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return uri, nil
+	case "ipfs":
+		path := strings.TrimPrefix(strings.TrimPrefix(uri, "ipfs://"), "ipfs/")
+		if path == "" {
+			return "", errors.Wrapf(ErrUnsupportedURI, "empty ipfs path in %s", uri)
+		}
+		return s.IPFSGateway + path, nil
+	default:
+		return "", errors.Wrapf(ErrUnsupportedURI, "'%s' scheme of %s", u.Scheme, uri)
+	}
+}
+
+func (s *Service) wait(ctx context.Context) error {
+	select {
+	case <-s.limiter.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func parseDecimals(v any) (uint8, bool) {
+	var d uint64
+	var err error
+
+	switch x := v.(type) {
+	case string:
+		d, err = strconv.ParseUint(x, 10, 8)
+	case float64:
+		if x < 0 || x > 255 || x != float64(uint8(x)) {
+			return 0, false
+		}
+		d = uint64(x)
+	default:
+		return 0, false
+	}
+	if err != nil {
+		return 0, false
+	}
+
+	return uint8(d), true
+}
+
+func parseDocument(m *core.TokenMetadata, body []byte) error {
+	var doc map[string]any
+
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return errors.Wrap(err, "document is not a json object")
+	}
+
+	str := func(k string) string {
+		s, _ := doc[k].(string)
+		return s
+	}
+	m.Name = str("name")
+	m.Description = str("description")
+	m.Image = str("image")
+	m.Symbol = str("symbol")
+	if v, ok := doc["decimals"]; ok {
+		if d, ok := parseDecimals(v); ok {
+			m.Decimals = &d
+		}
+	}
+
+	return nil
+}
+
+// Fetch downloads and parses the metadata document by the given URI.
+func (s *Service) Fetch(ctx context.Context, uri string) (*core.TokenMetadata, error) {
+	u, err := s.resolveURI(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "do request")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	if res.ContentLength > s.MaxSize {
+		return nil, fmt.Errorf("document size %d exceeds %d bytes", res.ContentLength, s.MaxSize)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, s.MaxSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+	if int64(len(body)) > s.MaxSize {
+		return nil, fmt.Errorf("document size exceeds %d bytes", s.MaxSize)
+	}
+
+	hash := sha256.Sum256(body)
+
+	m := &core.TokenMetadata{
+		URI:         uri,
+		ContentHash: hash[:],
+		Document:    body,
+		FetchedAt:   time.Now().UTC(),
+	}
+	if err := parseDocument(m, body); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// retryDelay returns the delay before the next fetch after the given number of failures in a row.
+func (s *Service) retryDelay(attempts int) time.Duration {
+	d := s.RetryBackoff
+	for i := 1; i < attempts && d < s.RefetchInterval; i++ {
+		d *= 2
+	}
+	if d > s.RefetchInterval {
+		d = s.RefetchInterval
+	}
+	return d
+}
+
+func (s *Service) process(ctx context.Context, f *core.TokenMetadataFetch) {
+	m, err := s.Fetch(ctx, f.URI)
+	if ctx.Err() != nil {
+		return // service is stopped
+	}
+
+	now := time.Now().UTC()
+	f.FetchedAt = now
+
+	if err != nil {
+		f.Attempts++
+		f.Error = err.Error()
+		f.NextFetchAt = now.Add(s.retryDelay(f.Attempts))
+
+		log.Debug().Err(err).Str("uri", f.URI).Int("attempts", f.Attempts).Msg("fetch token metadata")
+	} else {
+		f.Attempts = 0
+		f.Error = ""
+		f.ContentHash = m.ContentHash
+		f.NextFetchAt = now.Add(s.RefetchInterval)
+	}
+
+	if err := s.MetadataRepo.SaveFetch(ctx, f, m); err != nil {
+		log.Error().Err(err).Str("uri", f.URI).Msg("save token metadata")
+	}
+}
+
+func (s *Service) fetchBatch(ctx context.Context) (int, error) {
+	uris, err := s.MetadataRepo.GetNewURIs(ctx, s.SelectLimit)
+	if err != nil {
+		return 0, errors.Wrap(err, "get new uris")
+	}
+	if err := s.MetadataRepo.AddURIs(ctx, uris); err != nil {
+		return 0, errors.Wrap(err, "add uris")
+	}
+
+	fetches, err := s.MetadataRepo.GetURIsToFetch(ctx, s.SelectLimit)
+	if err != nil {
+		return 0, errors.Wrap(err, "get uris to fetch")
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.Workers)
+
+	for _, f := range fetches {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(f *core.TokenMetadataFetch) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			s.process(ctx, f)
+		}(f)
+	}
+
+	wg.Wait()
+
+	return len(fetches), nil
+}
+
+func (s *Service) fetchLoop() {
+	defer s.wg.Done()
+
+	for {
+		n, err := s.fetchBatch(s.ctx)
+		if err != nil && s.ctx.Err() == nil {
+			log.Error().Err(err).Msg("fetch token metadata")
+		}
+		if n == s.SelectLimit && err == nil {
+			continue // there could be more uris to fetch
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(s.PollInterval):
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package metadata
+
+import (
+	"context"
+	"crypto/sha256"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+type mockRepo struct {
+	mx      sync.Mutex
+	fetches []*core.TokenMetadataFetch
+	saved   []*core.TokenMetadata
+}
+
+var _ core.MetadataRepository = (*mockRepo)(nil)
+
+func (m *mockRepo) GetNewURIs(context.Context, int) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockRepo) AddURIs(context.Context, []string) error {
+	return nil
+}
+
+func (m *mockRepo) GetURIsToFetch(context.Context, int) ([]*core.TokenMetadataFetch, error) {
+	return nil, nil
+}
+
+func (m *mockRepo) SaveFetch(_ context.Context, f *core.TokenMetadataFetch, md *core.TokenMetadata) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.fetches = append(m.fetches, f)
+	if md != nil {
+		m.saved = append(m.saved, md)
+	}
+	return nil
+}
+
+func (m *mockRepo) GetMetadata(context.Context, string, []byte) (*core.TokenMetadata, error) {
+	return nil, core.ErrNotFound
+}
+
+func (m *mockRepo) GetLatestMetadata(context.Context, []string) (map[string]*core.TokenMetadata, error) {
+	return nil, nil
+}
+
+func newTestService(t *testing.T, srv *httptest.Server) (*Service, *mockRepo) {
+	repo := new(mockRepo)
+
+	s := NewService(&app.MetadataConfig{
+		MetadataRepo:    repo,
+		Client:          srv.Client(),
+		IPFSGateway:     srv.URL + "/ipfs",
+		RateLimit:       1000,
+		MaxSize:         128,
+		Timeout:         time.Second,
+		RefetchInterval: time.Hour,
+		RetryBackoff:    time.Minute,
+	})
+	t.Cleanup(s.Stop)
+
+	return s, repo
+}
+
+func TestService_Fetch(t *testing.T) {
+	const doc = `{"name":"Test","symbol":"TST","decimals":"9","image":"https://x"}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/jetton.json", "/ipfs/QmHash":
+			_, _ = w.Write([]byte(doc))
+		case "/zero.json":
+			_, _ = w.Write([]byte(`{"symbol":"ZRO","decimals":0}`))
+		case "/no_decimals.json":
+			_, _ = w.Write([]byte(`{"symbol":"NOD"}`))
+		case "/big.json":
+			_, _ = w.Write([]byte(`{"name":"` + strings.Repeat("a", 200) + `"}`))
+		case "/text":
+			_, _ = w.Write([]byte("not a json"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	s, _ := newTestService(t, srv)
+	ctx := context.Background()
+
+	t.Run("ok", func(t *testing.T) {
+		m, err := s.Fetch(ctx, srv.URL+"/jetton.json")
+		require.Nil(t, err)
+
+		hash := sha256.Sum256([]byte(doc))
+		require.Equal(t, srv.URL+"/jetton.json", m.URI)
+		require.Equal(t, hash[:], m.ContentHash)
+		require.JSONEq(t, doc, string(m.Document))
+		require.Equal(t, "Test", m.Name)
+		require.Equal(t, "TST", m.Symbol)
+		require.NotNil(t, m.Decimals)
+		require.Equal(t, uint8(9), *m.Decimals)
+		require.Equal(t, "https://x", m.Image)
+	})
+
+	t.Run("decimals", func(t *testing.T) {
+		m, err := s.Fetch(ctx, srv.URL+"/zero.json")
+		require.Nil(t, err)
+		require.NotNil(t, m.Decimals)
+		require.Equal(t, uint8(0), *m.Decimals)
+
+		m, err = s.Fetch(ctx, srv.URL+"/no_decimals.json")
+		require.Nil(t, err)
+		require.Nil(t, m.Decimals)
+	})
+
+	t.Run("ipfs", func(t *testing.T) {
+		for _, uri := range []string{"ipfs://QmHash", "ipfs://ipfs/QmHash"} {
+			m, err := s.Fetch(ctx, uri)
+			require.Nil(t, err)
+			require.Equal(t, uri, m.URI)
+			require.Equal(t, "TST", m.Symbol)
+		}
+	})
+
+	t.Run("size cap", func(t *testing.T) {
+		_, err := s.Fetch(ctx, srv.URL+"/big.json")
+		require.ErrorContains(t, err, "exceeds 128 bytes")
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		_, err := s.Fetch(ctx, srv.URL+"/text")
+		require.ErrorContains(t, err, "not a json object")
+	})
+
+	t.Run("status", func(t *testing.T) {
+		_, err := s.Fetch(ctx, srv.URL+"/missing.json")
+		require.ErrorContains(t, err, "unexpected status code 404")
+	})
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		_, err := s.Fetch(ctx, "ftp://example.com/jetton.json")
+		require.ErrorIs(t, err, ErrUnsupportedURI)
+	})
+}
+
+func TestIsPublicIP(t *testing.T) {
+	for ip, public := range map[string]bool{
+		"1.1.1.1":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"::ffff:10.0.0.1": false,
+	} {
+		require.Equal(t, public, isPublicIP(net.ParseIP(ip)), ip)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"Test"}`))
+	}))
+	defer srv.Close()
+
+	s := NewService(&app.MetadataConfig{MetadataRepo: new(mockRepo), RateLimit: 1000})
+	t.Cleanup(s.Stop)
+
+	// default client does not connect to the loopback test server
+	_, err := s.Fetch(context.Background(), srv.URL+"/jetton.json")
+	require.ErrorIs(t, err, ErrForbiddenAddress)
+
+	// host names are checked after resolution
+	u, err := url.Parse(srv.URL)
+	require.Nil(t, err)
+	_, err = s.Fetch(context.Background(), "http://localhost:"+u.Port()+"/jetton.json")
+	require.ErrorIs(t, err, ErrForbiddenAddress)
+
+	for _, target := range []string{"http://169.254.169.254/latest/meta-data/", "file:///etc/passwd"} {
+		req := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+		require.NotNil(t, checkRedirect(req, nil), target)
+	}
+}
+
+func TestService_process(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"symbol":"TST"}`))
+	}))
+	defer srv.Close()
+
+	s, repo := newTestService(t, srv)
+	ctx := context.Background()
+
+	f := &core.TokenMetadataFetch{URI: srv.URL + "/fail"}
+	for i, delay := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute} {
+		s.process(ctx, f)
+
+		require.Equal(t, i+1, f.Attempts)
+		require.NotEmpty(t, f.Error)
+		require.Nil(t, f.ContentHash)
+		require.WithinDuration(t, time.Now().Add(delay), f.NextFetchAt, time.Second)
+	}
+
+	f.Attempts = 100
+	s.process(ctx, f)
+	require.WithinDuration(t, time.Now().Add(time.Hour), f.NextFetchAt, time.Second)
+	require.Len(t, repo.saved, 0)
+
+	f = &core.TokenMetadataFetch{URI: srv.URL + "/ok", Attempts: 3, Error: "error"}
+	s.process(ctx, f)
+
+	require.Equal(t, 0, f.Attempts)
+	require.Empty(t, f.Error)
+	require.NotNil(t, f.ContentHash)
+	require.WithinDuration(t, time.Now().Add(time.Hour), f.NextFetchAt, time.Second)
+	require.Len(t, repo.saved, 1)
+	require.Equal(t, "TST", repo.saved[0].Symbol)
+}
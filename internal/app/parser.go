@@ -84,6 +84,11 @@ type ParserService interface {
 		others func(context.Context, addr.Address) (*core.AccountState, error),
 	) error
 
+	ParseAccountContractState(
+		contractDesc *core.ContractInterface,
+		acc *core.AccountState,
+	) error
+
 	ExecuteAccountGetMethod(
 		ctx context.Context,
 		contract abi.ContractName,
@@ -96,4 +101,15 @@ type ParserService interface {
 		ctx context.Context,
 		message *core.Message, // source and destination account states must be known
 	) error
+
+	// ParseMessageOperation parses message payload with the given operation description,
+	// which is not required to be saved in the database.
+	ParseMessageOperation(
+		message *core.Message,
+		operation *core.ContractOperation,
+	) error
+
+	// WithRegistry returns the parser looking for definitions in the given registry,
+	// so data can be parsed with definitions which are not registered globally.
+	WithRegistry(r *abi.Registry) ParserService
 }
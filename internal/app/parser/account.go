@@ -106,7 +106,9 @@ func (s *Service) ParseAccountData(
 		acc.Types = append(acc.Types, i.Name)
 	}
 	acc.ExecutedGetMethods = map[abi.ContractName][]abi.GetMethodExecution{}
+	acc.ContractData = nil
 
+	s.parseContractStates(acc, interfaces)
 	s.callPossibleGetMethods(ctx, acc, others, interfaces)
 
 	return nil
@@ -141,6 +143,7 @@ func (s *Service) ParseAccountContractData(
 	}
 	delete(acc.ExecutedGetMethods, contractDesc.Name)
 
+	s.parseContractStates(acc, []*core.ContractInterface{contractDesc})
 	s.callPossibleGetMethods(ctx, acc, others, []*core.ContractInterface{contractDesc})
 
 	return nil
@@ -200,5 +203,13 @@ func (s *Service) ExecuteAccountGetMethod(
 		acc.ExecutedGetMethods = map[abi.ContractName][]abi.GetMethodExecution{}
 	}
 
-	return s.callGetMethod(ctx, acc, i, d, others)
+	if err := s.callGetMethod(ctx, acc, i, d, others); err != nil {
+		return err
+	}
+
+	if i.Verification != nil && i.Verification.References(getMethod) {
+		s.verifyParent(ctx, acc, i, others)
+	}
+
+	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/xssnick/tonutils-go/address"
 	"github.com/xssnick/tonutils-go/tvm/cell"
 
 	"github.com/stepandra/anton/abi"
@@ -27,9 +28,10 @@ func TestService_ParseAccountData_WalletV3R2(t *testing.T) {
 	ret := &core.AccountState{
 		Address:  *addr.MustFromBase64("EQDj5AA8mQvM5wJEQsFFFof79y3ZsuX6wowktWQFhz_Anton"),
 		IsActive: true, Status: core.Active,
-		Balance: bunbig.FromInt64(1e9),
-		Code:    code,
-		Data:    data,
+		Balance:  bunbig.FromInt64(1e9),
+		Code:     code,
+		CodeHash: codeHash(t, code),
+		Data:     data,
 	}
 	err = s.ParseAccountData(ctx, ret, nil)
 	require.Nil(t, err)
@@ -50,9 +52,10 @@ func TestService_ParseAccountData_WalletV4R2(t *testing.T) {
 	ret := &core.AccountState{
 		Address:  *addr.MustFromBase64("EQBCPrKazoIMW0CBYbHitNdrh2Lf_s70EtqdSqp0Y4k9Ul6N"),
 		IsActive: true, Status: core.Active,
-		Balance: bunbig.FromInt64(1e9),
-		Code:    code,
-		Data:    data,
+		Balance:  bunbig.FromInt64(1e9),
+		Code:     code,
+		CodeHash: codeHash(t, code),
+		Data:     data,
 	}
 	err = s.ParseAccountData(ctx, ret, nil)
 	require.Nil(t, err)
@@ -158,7 +161,58 @@ func TestService_ParseAccountData_JettonWallet_Fake(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, []abi.ContractName{"jetton_wallet"}, ret.Types)
 	require.Equal(t, true, ret.Fake)
+	require.NotNil(t, ret.TokenBalance)
+	require.Equal(t, "EQBlqsm144Dq6SjbPI4jjZvA1hqTIP3CvHovbIfW_t-SCALE", ret.MinterAddress.Base64())
 	// j, err := json.Marshal(ret.ExecutedGetMethods)
 	// require.Nil(t, err)
 	// require.Equal(t, `{"jetton_minter":[{"name":"get_wallet_address","receives":["EQDzbH7_4vlLEwPzoRakykrvoHaXiRgVB42GZMGLBesFqemt"],"returns":["EQBWICDwlBzfMdyM56TAMikgKVNfssQzvqKK964A1SIlC8jb"]}],"jetton_wallet":[{"name":"get_wallet_data","returns":[8878686000000000,"EQDzbH7_4vlLEwPzoRakykrvoHaXiRgVB42GZMGLBesFqemt","EQBlqsm144Dq6SjbPI4jjZvA1hqTIP3CvHovbIfW_t-SCALE","te6cckECEwEAA8oAART/APSkE/S88sgLAQIBYgMCAGGg9gXaiaH0AfSBGhDABlqsm144Dq6SjbPI4jjZvA1hqTIP3CvHovbIfW/t+SCIA6hhAgLMBgQCAUgFDAIBIBEJAgHUCAcAET6RDBwuvLhTYADDCDHAJJfBOAB0NMDAXGwlRNfA/AL4PpA+kAx+gAxcdch+gAx+gAwc6m0AALTH4IQD4p+pVIgupUxNFnwCOCCEBeNRRlSILqWMUREA/AJ4DWCEFlfB7y6k1nwCuBfBIQP8vCAD5Qz7UTQ+gD6QI0IYAMtVk2vHAdXSUbZ5HEcbN4GsNSZB+4V49F7ZD639vyQRAHUMAfTP/oAUVGgBfpA+kD6AFGroYIImJaAggiYloAStgihggjk4cCgG6EqlhBKUJhfBeMNJNcLAcMAJMIAsJJsM+MNVQKAQCwoAHshQBPoCWM8WAc8WzMntVABEghDVMnbbcIAQyMsFUAfPFlAF+gIVy2oTyx8Uyz/JcvsAAQIBIA4NAMkgCDXIe1E0PoA+kCNCGADLVZNrxwHV0lG2eRxHGzeBrDUmQfuFePRe2Q+t/b8kEQB1DAE0x+CEBeNRRlSILqCEHvdl94TuhKx8uLF0z8x+gAwE6BQI8hQBPoCWM8WAc8WzMntVIAH3O1E0PoA+kCNCGADLVZNrxwHV0lG2eRxHGzeBrDUmQfuFePRe2Q+t/b8kEQB1DAH0z/6APpAMFFRoVJJxwXy4sEnwv/y4sKCCOThwKoAFqAWvPLiw4IQe92X3sjLHxXLP1AD+gIizxYBzxbJcYAYyMsFJM8WcPoCy2rMyYA8AKoBA+wBAE8hQBPoCWM8WAc8WzMntVACyUqmgGKGCEHNi0JzIyx9SQMs/UAP6AgHPFlAHzxbJcYAQyMsFjQhgBbWhcJjpQm9RyLSvwsNEAC+U4R2pHtgLa0CNYirI6pYkzxZQCfoCGMtqF8zJcfsAEDQB9QD0z/6APpAIfAB7UTQ+gD6QI0IYAMtVk2vHAdXSUbZ5HEcbN4GsNSZB+4V49F7ZD639vyQRAHUMFE2oVIqxwXy4sEowv/y4sJUNEJwVCATVBQDyFAE+gJYzxYBzxbMySLIywES9AD0AMsAySD5AHB0yMsCygfL/8nQBIBIA8PpA9AQx+gAg10nCAPLixHeAGMjLBVAIzxZw+gIXy2sTzIIQF41FGcjLHxnLP1AH+gIizxZQBs8WJfoCUAPPFslQBcwjkXKRceJQCKgToIII5OHAqgCCCJiWgKCgFLzy4sUEyYBA+wAQI8hQBPoCWM8WAc8WzMntVB9hzdY="]}]}`, string(j))
 }
+
+func TestService_ParseAccountContractState(t *testing.T) {
+	s := newService(t)
+
+	data, err := base64.StdEncoding.DecodeString("te6cckEBAQEAKgAAUAAAAAEGQZj7UhMYn0DGJKa8VAJx2X9dF+VkfoJrgOKgW7MinX6Pqkvc3Pev")
+	require.Nil(t, err)
+
+	i := &core.ContractInterface{
+		Name: "wallet_v3r2",
+		ContractData: abi.TLBFieldsDesc{
+			{Name: "seqno", Type: "## 32", Format: "uint32"},
+			{Name: "subwallet_id", Type: "## 32", Format: "uint32"},
+		},
+	}
+
+	ret := &core.AccountState{
+		Address: *addr.MustFromBase64("EQDj5AA8mQvM5wJEQsFFFof79y3ZsuX6wowktWQFhz_Anton"),
+		Data:    data,
+	}
+	err = s.ParseAccountContractState(i, ret)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"seqno":1,"subwallet_id":104962299}`, string(ret.ContractData["wallet_v3r2"]))
+
+	i.ContractData = nil
+	err = s.ParseAccountContractState(i, ret)
+	require.Nil(t, err)
+	require.Len(t, ret.ContractData, 0)
+}
+
+func TestAddressValue(t *testing.T) {
+	a := address.MustParseAddr("EQCD39VS5jcptHL8vMjEXrzGaRcCVYto7HUn4bpAOg8xqB2N")
+
+	ret, err := addressValue(a)
+	require.Nil(t, err)
+	require.Equal(t, a.String(), ret.Base64())
+
+	// get-method can return null instead of an address
+	_, err = addressValue((*address.Address)(nil))
+	require.ErrorIs(t, err, core.ErrInvalidArg)
+
+	_, err = addressValue((*addr.Address)(nil))
+	require.ErrorIs(t, err, core.ErrInvalidArg)
+
+	_, err = addressValue(address.NewAddressNone())
+	require.ErrorIs(t, err, core.ErrInvalidArg)
+
+	_, err = addressValue("not an address")
+	require.ErrorIs(t, err, core.ErrInvalidArg)
+}
@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func decodeContractData(r *abi.Registry, i *core.ContractInterface, data []byte) (json.RawMessage, error) {
+	c, err := cell.FromBOC(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "account data from boc")
+	}
+
+	parsed, err := i.ContractData.FromCellIn(r, c)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decode '%s' contract data", i.Name)
+	}
+
+	raw, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal '%s' contract data", i.Name)
+	}
+
+	return raw, nil
+}
+
+// ParseAccountContractState decodes account data with the contract data schema of the given interface.
+func (s *Service) ParseAccountContractState(contractDesc *core.ContractInterface, acc *core.AccountState) error {
+	if acc.ContractData == nil {
+		acc.ContractData = map[abi.ContractName]json.RawMessage{}
+	}
+	delete(acc.ContractData, contractDesc.Name)
+
+	if len(contractDesc.ContractData) == 0 {
+		return nil
+	}
+	if len(acc.Data) == 0 {
+		return errors.Wrap(app.ErrImpossibleParsing, "no account data")
+	}
+
+	raw, err := decodeContractData(s.registry, contractDesc, acc.Data)
+	if err != nil {
+		return err
+	}
+
+	acc.ContractData[contractDesc.Name] = raw
+
+	return nil
+}
+
+func (s *Service) parseContractStates(acc *core.AccountState, interfaces []*core.ContractInterface) {
+	for _, i := range interfaces {
+		if err := s.ParseAccountContractState(i, acc); err != nil {
+			log.Error().Err(err).Str("contract_name", string(i.Name)).Str("addr", acc.Address.Base64()).Msg("parse contract data")
+		}
+	}
+	if len(acc.ContractData) == 0 {
+		acc.ContractData = nil
+	}
+}
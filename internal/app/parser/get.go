@@ -4,15 +4,14 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
-	"math/big"
 	"sort"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
-	"github.com/uptrace/bun/extra/bunbig"
 
 	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tvm/cell"
 
 	"github.com/stepandra/anton/abi"
 	"github.com/stepandra/anton/abi/known"
@@ -21,16 +20,6 @@ import (
 	"github.com/stepandra/anton/internal/core"
 )
 
-var (
-	dedustFactoryAddr *addr.Address
-	stonfiRouterAddr  *addr.Address
-)
-
-func init() {
-	dedustFactoryAddr = addr.MustFromBase64("EQBfBWT7X2BHg9tXAxzhz2aKiNTU1tpt5NsiK0uSDW_YAJ67")
-	stonfiRouterAddr = addr.MustFromBase64("EQB3ncyBUTjZUA5EnFKR5_EnOMI9V1tTEAAPaiU71gc4TiUt")
-}
-
 func getMethodByName(i *core.ContractInterface, n string) *abi.GetMethodDesc {
 	for it := range i.GetMethodsDesc {
 		if i.GetMethodsDesc[it].Name == n {
@@ -68,6 +57,7 @@ func (s *Service) emulateGetMethod(ctx context.Context, d *abi.GetMethodDesc, ac
 	if err != nil {
 		return ret, errors.Wrap(err, "new emulator")
 	}
+	e.WithRegistry(s.registry)
 
 	retStack, err := e.RunGetMethod(ctx, d.Name, argsStack, d.ReturnValues)
 
@@ -134,154 +124,191 @@ func appendGetMethodExecution(acc *core.AccountState, contract abi.ContractName,
 	acc.ExecutedGetMethods[contract] = append(acc.ExecutedGetMethods[contract], *exec)
 }
 
-func (s *Service) checkJettonMinter(ctx context.Context, ownerAddr *addr.Address, walletAcc *core.AccountState, others func(context.Context, addr.Address) (*core.AccountState, error)) {
-	if minterAddr, ok := s.itemsMinterCache.Get(walletAcc.Address); ok && addr.Equal(walletAcc.MinterAddress, &minterAddr) {
-		return
-	}
-
-	minter, err := others(ctx, *walletAcc.MinterAddress)
+func (s *Service) getNFTItemContent(ctx context.Context, collection *core.AccountState, idx any, itemContent *cell.Cell, acc *core.AccountState) {
+	desc, err := s.ContractRepo.GetMethodDescription(ctx, known.NFTCollection, "get_nft_content")
 	if err != nil {
-		log.Error().Str("minter_address", walletAcc.MinterAddress.Base64()).Err(err).Msg("get jetton minter state")
-		return
+		panic(fmt.Errorf("get 'get_nft_content' method description: %w", err))
 	}
 
-	desc, err := s.ContractRepo.GetMethodDescription(ctx, known.JettonMinter, "get_wallet_address")
-	if err != nil {
-		panic(fmt.Errorf("get 'get_wallet_address' method description: %w", err))
-	}
+	args := []any{idx, itemContent}
 
-	args := []any{ownerAddr.MustToTonutils()}
-
-	walletAcc.Fake = true
-
-	exec, err := s.emulateGetMethod(ctx, &desc, minter, args)
+	exec, err := s.emulateGetMethod(ctx, &desc, collection, args)
 	if err != nil {
-		log.Error().Err(err).Msgf("execute %s %s get-method", desc.Name, known.JettonMinter)
+		log.Error().Err(err).Msgf("execute %s %s get-method", desc.Name, known.NFTCollection)
 		return
 	}
 
-	exec.Address = &minter.Address
+	exec.Address = &collection.Address
 
-	appendGetMethodExecution(walletAcc, known.JettonMinter, &exec)
+	appendGetMethodExecution(acc, known.NFTCollection, &exec)
 	if exec.Error != "" {
-		log.Error().Str("exec_error", exec.Error).Msgf("execute %s %s get-method", desc.Name, known.JettonMinter)
+		log.Error().Str("exec_error", exec.Error).Msgf("execute %s %s get-method", desc.Name, known.NFTCollection)
 		return
 	}
 
-	itemAddr := addr.MustFromTonutils(exec.Returns[0].(*address.Address)) //nolint:forcetypeassert // panic on wrong interface
-	if addr.Equal(itemAddr, &walletAcc.Address) {
-		walletAcc.Fake = false
-	}
-
-	if !walletAcc.Fake {
-		s.itemsMinterCache.Put(walletAcc.Address, minter.Address)
+	if err := acc.SetColumn(abi.ColumnContent, exec.Returns[0]); err != nil {
+		log.Error().Err(err).Msgf("map %s %s get-method content", desc.Name, known.NFTCollection)
 	}
 }
 
-func (s *Service) checkDeDustMinter(ctx context.Context, acc *core.AccountState, others func(context.Context, addr.Address) (*core.AccountState, error)) {
-	if minterAddr, ok := s.itemsMinterCache.Get(acc.Address); ok && addr.Equal(dedustFactoryAddr, &minterAddr) {
-		return
-	}
-
-	factory, err := others(ctx, *dedustFactoryAddr)
+func returnValue(acc *core.AccountState, i *core.ContractInterface, ref abi.GetMethodReturnRef) (any, error) {
+	idx, err := abi.ReturnValueIndex(i.GetMethodsDesc, ref)
 	if err != nil {
-		log.Error().Str("factory_address", dedustFactoryAddr.Base64()).Err(err).Msg("get dedust v2 factory state")
-		return
+		return nil, err
 	}
 
-	acc.Fake = true
-
-	if len(acc.ExecutedGetMethods[known.DedustV2Pool]) < 6 ||
-		acc.ExecutedGetMethods[known.DedustV2Pool][0].Name != "get_assets" || acc.ExecutedGetMethods[known.DedustV2Pool][5].Name != "is_stable" ||
-		acc.ExecutedGetMethods[known.DedustV2Pool][0].Error != "" || acc.ExecutedGetMethods[known.DedustV2Pool][5].Error != "" {
-		return
+	for _, exec := range acc.ExecutedGetMethods[i.Name] {
+		if exec.Name != ref.GetMethod {
+			continue
+		}
+		if exec.Error != "" {
+			return nil, fmt.Errorf("'%s' get-method execution failed", ref.GetMethod)
+		}
+		if idx >= len(exec.Returns) {
+			return nil, fmt.Errorf("no '%s' return value in '%s' get-method execution", ref.ReturnValue, ref.GetMethod)
+		}
+		return exec.Returns[idx], nil
 	}
 
-	desc, err := s.ContractRepo.GetMethodDescription(ctx, known.DedustV2Factory, "get_pool_address")
-	if err != nil {
-		panic(fmt.Errorf("get 'get_pool_address' method description: %w", err))
+	return nil, fmt.Errorf("'%s' get-method was not executed", ref.GetMethod)
+}
+
+func addressValue(v any) (*addr.Address, error) {
+	switch a := v.(type) {
+	case *address.Address:
+		if a == nil {
+			return nil, errors.Wrap(core.ErrInvalidArg, "nil address")
+		}
+		if a.Type() != address.StdAddress {
+			return nil, errors.Wrapf(core.ErrInvalidArg, "unsupported address type %d", a.Type())
+		}
+		return addr.MustFromTonutils(a), nil
+	case *addr.Address:
+		if a == nil {
+			return nil, errors.Wrap(core.ErrInvalidArg, "nil address")
+		}
+		return a, nil
+	default:
+		return nil, errors.Wrapf(core.ErrInvalidArg, "expected address, got %T", v)
 	}
+}
 
-	asset0 := acc.ExecutedGetMethods[known.DedustV2Pool][0].Returns[0].(*abi.DedustAsset) //nolint:forcetypeassert // that's ok
-	asset1 := acc.ExecutedGetMethods[known.DedustV2Pool][0].Returns[1].(*abi.DedustAsset) //nolint:forcetypeassert // that's ok
-	isStable := acc.ExecutedGetMethods[known.DedustV2Pool][5].Returns[0].(bool)           //nolint:forcetypeassert // that's ok
+func (s *Service) getParentAddress(ctx context.Context, acc *core.AccountState, i *core.ContractInterface) (*addr.Address, error) {
+	v := i.Verification
 
-	args := []any{isStable, asset0, asset1}
+	if v.ParentAddress != nil {
+		ret, err := returnValue(acc, i, *v.ParentAddress)
+		if err != nil {
+			return nil, err
+		}
+		if a, ok := ret.(*address.Address); ok && a != nil && a.Type() == address.NoneAddress {
+			// contract without parent, for example, nft item without collection
+			return nil, nil
+		}
+		return addressValue(ret)
+	}
 
-	exec, err := s.emulateGetMethod(ctx, &desc, factory, args)
+	interfaces, err := s.ContractRepo.GetInterfaces(ctx)
 	if err != nil {
-		log.Error().Err(err).Msgf("execute %s %s get-method", desc.Name, known.DedustV2Factory)
-		return
+		return nil, errors.Wrap(err, "get contract interfaces")
+	}
+	for _, parent := range interfaces {
+		if parent.Name != v.ParentInterface {
+			continue
+		}
+		if len(parent.Addresses) != 1 {
+			return nil, fmt.Errorf("'%s' parent interface must have the only address", v.ParentInterface)
+		}
+		return parent.Addresses[0], nil
 	}
 
-	exec.Address = &factory.Address
+	return nil, fmt.Errorf("cannot find '%s' parent interface", v.ParentInterface)
+}
 
-	appendGetMethodExecution(acc, known.DedustV2Factory, &exec)
-	if exec.Error != "" {
-		log.Error().Str("exec_error", exec.Error).Msgf("execute %s %s get-method", desc.Name, known.DedustV2Factory)
+// verifyParent executes the parent get-method described in the interface verification rule
+// and marks account as fake if the returned address is not equal to the account address.
+func (s *Service) verifyParent(ctx context.Context, acc *core.AccountState, i *core.ContractInterface, others func(context.Context, addr.Address) (*core.AccountState, error)) {
+	v := i.Verification
+	if v == nil {
 		return
 	}
 
-	itemAddr := addr.MustFromTonutils(exec.Returns[0].(*address.Address)) //nolint:forcetypeassert // panic on wrong interface
-	if addr.Equal(itemAddr, &acc.Address) {
-		acc.Fake = false
+	parentAddr, err := s.getParentAddress(ctx, acc, i)
+	if err != nil {
+		log.Error().Err(err).Str("contract_name", string(i.Name)).Str("addr", acc.Address.Base64()).Msg("get parent address")
+		return
 	}
-
-	if !acc.Fake {
-		s.itemsMinterCache.Put(acc.Address, factory.Address)
+	if parentAddr == nil {
+		return
 	}
-}
 
-func (s *Service) checkStonFiMinter(ctx context.Context, acc *core.AccountState, others func(context.Context, addr.Address) (*core.AccountState, error)) {
-	if minterAddr, ok := s.itemsMinterCache.Get(acc.Address); ok && addr.Equal(stonfiRouterAddr, &minterAddr) {
+	if minterAddr, ok := s.itemsMinterCache.Get(acc.Address); ok && addr.Equal(parentAddr, &minterAddr) {
 		return
 	}
 
-	router, err := others(ctx, *stonfiRouterAddr)
+	parent, err := others(ctx, *parentAddr)
 	if err != nil {
-		log.Error().Str("router_address", stonfiRouterAddr.Base64()).Err(err).Msg("get stonfi router state")
+		log.Error().Str("parent_address", parentAddr.Base64()).Err(err).Msgf("get %s state", v.ParentInterface)
 		return
 	}
 
 	acc.Fake = true
 
-	if len(acc.ExecutedGetMethods[known.StonFiPool]) < 1 ||
-		acc.ExecutedGetMethods[known.StonFiPool][0].Name != "get_pool_data" ||
-		acc.ExecutedGetMethods[known.StonFiPool][0].Error != "" {
-		return
+	var args []any
+	for _, ref := range v.Arguments {
+		arg, err := returnValue(acc, i, ref)
+		if err != nil {
+			log.Debug().Err(err).Str("contract_name", string(i.Name)).Str("addr", acc.Address.Base64()).Msg("get parent get-method arguments")
+			return
+		}
+		args = append(args, arg)
 	}
 
-	desc, err := s.ContractRepo.GetMethodDescription(ctx, known.StonFiRouter, "get_pool_address")
+	desc, err := s.ContractRepo.GetMethodDescription(ctx, v.ParentInterface, v.GetMethod)
 	if err != nil {
-		panic(fmt.Errorf("get 'get_pool_address' method description: %w", err))
+		log.Error().Err(err).Msgf("get '%s' %s get-method description", v.GetMethod, v.ParentInterface)
+		return
 	}
 
-	asset0 := acc.ExecutedGetMethods[known.StonFiPool][0].Returns[2].(*address.Address) //nolint:forcetypeassert // that's ok
-	asset1 := acc.ExecutedGetMethods[known.StonFiPool][0].Returns[3].(*address.Address) //nolint:forcetypeassert // that's ok
-
-	args := []any{asset0, asset1}
+	retIdx := -1
+	for it := range desc.ReturnValues {
+		if desc.ReturnValues[it].Name == v.ReturnValue {
+			retIdx = it
+		}
+	}
+	if retIdx < 0 {
+		log.Error().Msgf("cannot find '%s' return value of %s %s get-method", v.ReturnValue, desc.Name, v.ParentInterface)
+		return
+	}
 
-	exec, err := s.emulateGetMethod(ctx, &desc, router, args)
+	exec, err := s.emulateGetMethod(ctx, &desc, parent, args)
 	if err != nil {
-		log.Error().Err(err).Msgf("execute %s %s get-method", desc.Name, known.StonFiRouter)
+		log.Error().Err(err).Msgf("execute %s %s get-method", desc.Name, v.ParentInterface)
 		return
 	}
 
-	exec.Address = &router.Address
+	exec.Address = &parent.Address
 
-	appendGetMethodExecution(acc, known.StonFiRouter, &exec)
+	appendGetMethodExecution(acc, v.ParentInterface, &exec)
 	if exec.Error != "" {
-		log.Error().Str("exec_error", exec.Error).Msgf("execute %s %s get-method", desc.Name, known.StonFiRouter)
+		log.Error().Str("exec_error", exec.Error).Msgf("execute %s %s get-method", desc.Name, v.ParentInterface)
 		return
 	}
 
-	itemAddr := addr.MustFromTonutils(exec.Returns[0].(*address.Address)) //nolint:forcetypeassert // panic on wrong interface
-	if addr.Equal(itemAddr, &acc.Address) {
+	if retIdx >= len(exec.Returns) {
+		return
+	}
+	retAddr, err := addressValue(exec.Returns[retIdx])
+	if err != nil {
+		log.Debug().Err(err).Msgf("parse '%s' return value of %s %s get-method", v.ReturnValue, desc.Name, v.ParentInterface)
+		return
+	}
+	if addr.Equal(retAddr, &acc.Address) {
 		acc.Fake = false
 	}
 
 	if !acc.Fake {
-		s.itemsMinterCache.Put(acc.Address, router.Address)
+		s.itemsMinterCache.Put(acc.Address, parent.Address)
 	}
 }
 
@@ -302,20 +329,34 @@ func (s *Service) callGetMethod(
 		return nil
 	}
 
-	switch getMethodDesc.Name {
-	case "get_jetton_data":
-		// no action needed after NFT removal
+	for it, v := range getMethodDesc.ReturnValues {
+		if v.Column == "" || it >= len(exec.Returns) {
+			continue
+		}
+		if err := acc.SetColumn(v.Column, exec.Returns[it]); err != nil {
+			log.Error().Err(err).
+				Str("contract_name", string(i.Name)).
+				Str("get_method", getMethodDesc.Name).
+				Str("return_value", v.Name).
+				Msg("map get-method return value")
+		}
+	}
 
-	case "get_wallet_data":
-		acc.JettonBalance = bunbig.FromMathBig(exec.Returns[0].(*big.Int))            //nolint:forcetypeassert // panic on wrong interface
-		acc.OwnerAddress = addr.MustFromTonutils(exec.Returns[1].(*address.Address))  //nolint:forcetypeassert // panic on wrong interface
-		acc.MinterAddress = addr.MustFromTonutils(exec.Returns[2].(*address.Address)) //nolint:forcetypeassert // panic on wrong interface
+	switch getMethodDesc.Name {
+	case "get_nft_data":
+		if acc.MinterAddress == nil {
+			return nil
+		}
 
-		if acc.MinterAddress == nil || acc.OwnerAddress == nil {
+		collection, err := others(ctx, *acc.MinterAddress)
+		if err != nil {
+			log.Error().Str("collection_address", acc.MinterAddress.Base64()).Err(err).Msg("get nft collection state")
 			return nil
 		}
 
-		s.checkJettonMinter(ctx, acc.OwnerAddress, acc, others)
+		itemContent, _ := exec.Returns[4].(*cell.Cell)
+
+		s.getNFTItemContent(ctx, collection, exec.Returns[1], itemContent, acc)
 	}
 
 	return nil
@@ -346,12 +387,6 @@ func (s *Service) callPossibleGetMethods(
 			return acc.ExecutedGetMethods[i.Name][it].Name < acc.ExecutedGetMethods[i.Name][jt].Name
 		})
 
-		switch i.Name {
-		case known.DedustV2Pool:
-			s.checkDeDustMinter(ctx, acc, others)
-
-		case known.StonFiPool:
-			s.checkStonFiMinter(ctx, acc, others)
-		}
+		s.verifyParent(ctx, acc, i, others)
 	}
 }
@@ -3,6 +3,7 @@ package parser
 import (
 	"encoding/base64"
 
+	"github.com/stepandra/anton/abi"
 	"github.com/stepandra/anton/addr"
 	"github.com/stepandra/anton/internal/app"
 	"github.com/stepandra/anton/lru"
@@ -20,6 +21,9 @@ type Service struct {
 	itemsMinterCache *lru.Cache[addr.Address, addr.Address]
 
 	bcConfigBase64 string
+
+	// registry keeps definitions used for parsing, the global ones if it is nil
+	registry *abi.Registry
 }
 
 func NewService(cfg *app.ParserConfig) *Service {
@@ -30,3 +34,10 @@ func NewService(cfg *app.ParserConfig) *Service {
 	s.itemsMinterCache = lru.New[addr.Address, addr.Address](itemsMinterCacheLen)
 	return s
 }
+
+// WithRegistry returns the parser looking for definitions in the given registry.
+func (s *Service) WithRegistry(r *abi.Registry) app.ParserService {
+	p := *s
+	p.registry = r
+	return &p
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
 	"github.com/xssnick/tonutils-go/tvm/cell"
 
 	"github.com/stepandra/anton/abi"
@@ -36,26 +37,26 @@ type mockContractRepo struct {
 	interfaces []*core.ContractInterface
 }
 
-func (m *mockContractRepo) AddDefinition(context.Context, abi.TLBType, abi.TLBFieldsDesc) error {
+func (m *mockContractRepo) AddDefinition(context.Context, bun.Tx, abi.TLBType, abi.TLBFieldsDesc) error {
 	panic("implement me")
 }
-func (m *mockContractRepo) UpdateDefinition(context.Context, abi.TLBType, abi.TLBFieldsDesc) error {
+func (m *mockContractRepo) UpdateDefinition(context.Context, bun.Tx, abi.TLBType, abi.TLBFieldsDesc) error {
 	panic("implement me")
 }
-func (m *mockContractRepo) DeleteDefinition(context.Context, abi.TLBType) error {
+func (m *mockContractRepo) DeleteDefinition(context.Context, bun.Tx, abi.TLBType) error {
 	panic("implement me")
 }
 func (m *mockContractRepo) GetDefinitions(context.Context) (map[abi.TLBType]abi.TLBFieldsDesc, error) {
 	panic("implement me")
 }
 
-func (m *mockContractRepo) AddInterface(_ context.Context, _ *core.ContractInterface) error {
+func (m *mockContractRepo) AddInterface(_ context.Context, _ bun.Tx, _ *core.ContractInterface) error {
 	panic("implement me")
 }
-func (m *mockContractRepo) UpdateInterface(context.Context, *core.ContractInterface) error {
+func (m *mockContractRepo) UpdateInterface(context.Context, bun.Tx, *core.ContractInterface) error {
 	panic("implement me")
 }
-func (m *mockContractRepo) DeleteInterface(context.Context, abi.ContractName) error {
+func (m *mockContractRepo) DeleteInterface(context.Context, bun.Tx, abi.ContractName) error {
 	panic("implement me")
 }
 func (m *mockContractRepo) GetInterfaces(_ context.Context) ([]*core.ContractInterface, error) {
@@ -84,20 +85,20 @@ func (m *mockContractRepo) GetMethodDescription(_ context.Context, contract abi.
 			}},
 		}, nil
 
-	// case contract == known.NFTCollection && gm == "get_nft_address_by_index":
-	// 	return abi.GetMethodDesc{
-	// 		Name: "get_nft_address_by_index",
-	// 		Arguments: []abi.VmValueDesc{{
-	// 			Name:      "index",
-	// 			StackType: "int",
-	// 			Format:    "bytes",
-	// 		}},
-	// 		ReturnValues: []abi.VmValueDesc{{
-	// 			Name:      "address",
-	// 			StackType: "slice",
-	// 			Format:    "addr",
-	// 		}},
-	// 	}, nil
+	case contract == known.NFTCollection && gm == "get_nft_address_by_index":
+		return abi.GetMethodDesc{
+			Name: "get_nft_address_by_index",
+			Arguments: []abi.VmValueDesc{{
+				Name:      "index",
+				StackType: "int",
+				Format:    "bytes",
+			}},
+			ReturnValues: []abi.VmValueDesc{{
+				Name:      "address",
+				StackType: "slice",
+				Format:    "addr",
+			}},
+		}, nil
 
 	case contract == known.JettonMinter && gm == "get_wallet_address":
 		return abi.GetMethodDesc{
@@ -118,13 +119,13 @@ func (m *mockContractRepo) GetMethodDescription(_ context.Context, contract abi.
 	panic(fmt.Errorf("unknown %s get-method description for %s contract", contract, gm))
 }
 
-func (m *mockContractRepo) AddOperation(_ context.Context, _ *core.ContractOperation) error {
+func (m *mockContractRepo) AddOperation(_ context.Context, _ bun.Tx, _ *core.ContractOperation) error {
 	panic("implement me")
 }
-func (m *mockContractRepo) UpdateOperation(context.Context, *core.ContractOperation) error {
+func (m *mockContractRepo) UpdateOperation(context.Context, bun.Tx, *core.ContractOperation) error {
 	panic("implement me")
 }
-func (m *mockContractRepo) DeleteOperation(context.Context, string) error {
+func (m *mockContractRepo) DeleteOperation(context.Context, bun.Tx, string) error {
 	panic("implement me")
 }
 func (m *mockContractRepo) GetOperations(_ context.Context) ([]*core.ContractOperation, error) {
@@ -134,13 +135,20 @@ func (m *mockContractRepo) GetOperationsByID(_ context.Context, _ core.MessageTy
 	panic("implement me")
 }
 
+func codeHash(t *testing.T, code []byte) []byte {
+	c, err := cell.FromBOC(code)
+	require.Nil(t, err)
+	return c.Hash()
+}
+
 func newService(t *testing.T) *Service {
 	walletV3R2Code, err := base64.StdEncoding.DecodeString("te6cckEBAQEAcQAA3v8AIN0gggFMl7ohggEznLqxn3Gw7UTQ0x/THzHXC//jBOCk8mCDCNcYINMf0x/TH/gjE7vyY+1E0NMf0x/T/9FRMrryoVFEuvKiBPkBVBBV+RDyo/gAkyDXSpbTB9QC+wDo0QGkyMsfyx/L/8ntVBC9ba0=")
 	require.Nil(t, err)
 
 	walletV3R2 := core.ContractInterface{
-		Name: "wallet_v3r2",
-		Code: walletV3R2Code,
+		Name:     "wallet_v3r2",
+		Code:     walletV3R2Code,
+		CodeHash: codeHash(t, walletV3R2Code),
 		GetMethodsDesc: []abi.GetMethodDesc{{
 			Name: "seqno",
 			ReturnValues: []abi.VmValueDesc{{
@@ -155,8 +163,9 @@ func newService(t *testing.T) *Service {
 	require.Nil(t, err)
 
 	walletV4R2 := core.ContractInterface{
-		Name: "wallet_v4r2",
-		Code: walletV4R2Code,
+		Name:     "wallet_v4r2",
+		Code:     walletV4R2Code,
+		CodeHash: codeHash(t, walletV4R2Code),
 		GetMethodsDesc: []abi.GetMethodDesc{{
 			Name: "seqno",
 			ReturnValues: []abi.VmValueDesc{{
@@ -184,15 +193,24 @@ func newService(t *testing.T) *Service {
 				Name:      "collection_address",
 				StackType: "slice",
 				Format:    "addr",
+				Column:    abi.ColumnMinterAddress,
 			}, {
 				Name:      "owner_address",
 				StackType: "slice",
 				Format:    "addr",
+				Column:    abi.ColumnOwnerAddress,
 			}, {
 				Name:      "individual_content",
 				StackType: "cell",
 			}},
 		}},
+		Verification: &abi.VerificationDesc{
+			ParentInterface: known.NFTCollection,
+			ParentAddress:   &abi.GetMethodReturnRef{GetMethod: "get_nft_data", ReturnValue: "collection_address"},
+			GetMethod:       "get_nft_address_by_index",
+			Arguments:       []abi.GetMethodReturnRef{{GetMethod: "get_nft_data", ReturnValue: "index"}},
+			ReturnValue:     "address",
+		},
 	}
 	for it := range nftItem.GetMethodsDesc {
 		nftItem.GetMethodHashes = append(nftItem.GetMethodHashes, abi.MethodNameHash(nftItem.GetMethodsDesc[it].Name))
@@ -206,19 +224,29 @@ func newService(t *testing.T) *Service {
 			ReturnValues: []abi.VmValueDesc{{
 				Name:      "balance",
 				StackType: "int",
+				Column:    abi.ColumnTokenBalance,
 			}, {
 				Name:      "owner_address",
 				StackType: "slice",
 				Format:    "addr",
+				Column:    abi.ColumnOwnerAddress,
 			}, {
 				Name:      "jetton_master_address",
 				StackType: "slice",
 				Format:    "addr",
+				Column:    abi.ColumnMinterAddress,
 			}, {
 				Name:      "jetton_wallet_code",
 				StackType: "cell",
 			}},
 		}},
+		Verification: &abi.VerificationDesc{
+			ParentInterface: known.JettonMinter,
+			ParentAddress:   &abi.GetMethodReturnRef{GetMethod: "get_wallet_data", ReturnValue: "jetton_master_address"},
+			GetMethod:       "get_wallet_address",
+			Arguments:       []abi.GetMethodReturnRef{{GetMethod: "get_wallet_data", ReturnValue: "owner_address"}},
+			ReturnValue:     "wallet_address",
+		},
 	}
 	for it := range jettonWallet.GetMethodsDesc {
 		jettonWallet.GetMethodHashes = append(jettonWallet.GetMethodHashes, abi.MethodNameHash(jettonWallet.GetMethodsDesc[it].Name))
@@ -7,12 +7,13 @@ import (
 	"github.com/pkg/errors"
 	"github.com/xssnick/tonutils-go/tvm/cell"
 
+	"github.com/stepandra/anton/abi"
 	"github.com/stepandra/anton/abi/known"
 	"github.com/stepandra/anton/internal/app"
 	"github.com/stepandra/anton/internal/core"
 )
 
-func parseOperationAttempt(msg *core.Message, op *core.ContractOperation) error {
+func parseOperationAttempt(r *abi.Registry, msg *core.Message, op *core.ContractOperation) error {
 	msg.OperationName = op.OperationName
 	if op.Outgoing {
 		msg.SrcContract = op.ContractName
@@ -25,7 +26,7 @@ func parseOperationAttempt(msg *core.Message, op *core.ContractOperation) error
 		return errors.Wrap(err, "msg body from boc")
 	}
 
-	msgParsed, err := op.Schema.FromCell(payloadCell)
+	msgParsed, err := op.Schema.FromCellIn(r, payloadCell)
 	if err != nil {
 		return errors.Wrap(err, "msg body from boc")
 	}
@@ -63,21 +64,21 @@ func (s *Service) parseDirectedMessage(ctx context.Context, acc *core.AccountSta
 	case 0:
 		return errors.Wrap(app.ErrImpossibleParsing, "unknown operation")
 	case 1:
-		return parseOperationAttempt(msg, operations[0])
+		return parseOperationAttempt(s.registry, msg, operations[0])
 	default:
 		for _, op := range operations {
 			switch op.ContractName {
 			case known.JettonMinter, known.JettonWallet:
 				// firstly, skip standard contracts
 			default:
-				if err := parseOperationAttempt(msg, op); err == nil {
+				if err := parseOperationAttempt(s.registry, msg, op); err == nil {
 					return nil
 				}
 			}
 		}
 		var err error
 		for _, op := range operations {
-			if err = parseOperationAttempt(msg, op); err == nil {
+			if err = parseOperationAttempt(s.registry, msg, op); err == nil {
 				return nil
 			}
 		}
@@ -112,3 +113,14 @@ func (s *Service) ParseMessagePayload(ctx context.Context, msg *core.Message) er
 
 	return err
 }
+
+func (s *Service) ParseMessageOperation(msg *core.Message, op *core.ContractOperation) error {
+	if len(msg.Body) == 0 {
+		return errors.Wrap(app.ErrImpossibleParsing, "no message body")
+	}
+	if msg.Type != op.MessageType || msg.OperationID != op.OperationID {
+		return errors.Wrapf(app.ErrImpossibleParsing, "message does not match '%s' operation", op.OperationName)
+	}
+
+	return parseOperationAttempt(s.registry, msg, op)
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/contract"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func TestService_ParseMessageOperation(t *testing.T) {
+	s := newService(t)
+
+	op, err := contract.ParseOperationDesc(abi.NewRegistry(), "test_contract", &abi.OperationDesc{
+		Name: "test_op",
+		Code: "0x1",
+		Body: abi.TLBFieldsDesc{{Name: "query_id", Type: "## 64", Format: "uint64"}},
+	})
+	require.Nil(t, err)
+
+	body := cell.BeginCell().MustStoreUInt(1, 32).MustStoreUInt(42, 64).EndCell().ToBOC()
+
+	msg := &core.Message{Type: core.Internal, OperationID: 1, Body: body}
+	err = s.ParseMessageOperation(msg, op)
+	require.Nil(t, err)
+	require.Equal(t, "test_op", msg.OperationName)
+	require.Equal(t, abi.ContractName("test_contract"), msg.DstContract)
+	require.JSONEq(t, `{"query_id": 42}`, string(msg.DataJSON))
+
+	msg = &core.Message{Type: core.Internal, OperationID: 2, Body: body}
+	err = s.ParseMessageOperation(msg, op)
+	require.ErrorIs(t, err, app.ErrImpossibleParsing)
+	require.Equal(t, "", msg.OperationName)
+}
@@ -2,10 +2,13 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/xssnick/tonutils-go/ton"
 
 	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
 	"github.com/stepandra/anton/internal/core"
 	"github.com/stepandra/anton/internal/core/aggregate"
 	"github.com/stepandra/anton/internal/core/aggregate/history"
@@ -17,6 +20,88 @@ type QueryConfig struct {
 	DB *repository.DB
 
 	API ton.APIClientWrapped
+
+	// BlockchainConfigTTL is the interval of blockchain config updates used for emulation.
+	BlockchainConfigTTL time.Duration
+	// GetMethodGasLimit limits the gas consumed by the emulated get-method.
+	GetMethodGasLimit int64
+}
+
+// GetMethodArgument is a get-method argument with the payload in JSON.
+// If the get-method is described by a contract interface,
+// the argument description is taken from it and only the payload is used.
+type GetMethodArgument struct {
+	abi.VmValueDesc
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// GetMethodReq is a request to execute the get-method on the stored account state.
+type GetMethodReq struct {
+	Address   addr.Address `json:"-"`
+	GetMethod string       `json:"-"`
+
+	// LastTxLT chooses the account state, the latest state is used by default.
+	LastTxLT *uint64 `json:"last_tx_lt,omitempty"`
+
+	// Interface is the name of the contract interface describing the get-method.
+	// Without it, get-method is executed with the ad-hoc typed arguments
+	// and the stack is decoded with the given return values
+	// or returned raw if they are omitted.
+	Interface    abi.ContractName    `json:"interface,omitempty"`
+	Arguments    []GetMethodArgument `json:"arguments,omitempty"`
+	ReturnValues []abi.VmValueDesc   `json:"return_values,omitempty"`
+}
+
+// EmulateMessageReq is a request to emulate the transaction
+// triggered by the incoming message on the stored account state.
+type EmulateMessageReq struct {
+	Address addr.Address `json:"-"`
+
+	// Message is BoC of the internal or external incoming message.
+	Message []byte `json:"message"`
+
+	// LastTxLT chooses the account state, the latest state is used by default.
+	LastTxLT *uint64 `json:"last_tx_lt,omitempty"`
+
+	IgnoreSignature bool `json:"ignore_signature,omitempty"`
+}
+
+// AccountStateChange is the account state field changed by the emulated transaction.
+type AccountStateChange struct {
+	Field  string          `json:"field"`
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+}
+
+// EmulateMessageRes is the outcome of the emulated transaction.
+type EmulateMessageRes struct {
+	// Success is false if the external message was not accepted by the account.
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+	ExitCode int32  `json:"exit_code"`
+	GasUsed  uint64 `json:"gas_used"`
+	VmLog    string `json:"vm_log,omitempty"`
+
+	// Transaction is the emulated transaction with decoded messages and the resulting account state.
+	Transaction *core.Transaction `json:"transaction,omitempty"`
+
+	StateDiff []*AccountStateChange `json:"state_diff,omitempty"`
+}
+
+// TraceNode is the transaction of the trace with the transactions caused by its outgoing messages.
+type TraceNode struct {
+	Transaction *core.Transaction `json:"transaction"`
+	Children    []*TraceNode      `json:"children,omitempty"`
+}
+
+type Trace struct {
+	ID []byte `json:"id"`
+
+	// Incomplete is set if some internal messages of the trace have not been received yet.
+	Incomplete        bool `json:"incomplete"`
+	TransactionsCount int  `json:"transactions_count"`
+
+	Root *TraceNode `json:"root"`
 }
 
 type QueryService interface {
@@ -26,18 +111,34 @@ type QueryService interface {
 	GetInterfaces(ctx context.Context) ([]*core.ContractInterface, error)
 	GetOperations(ctx context.Context) ([]*core.ContractOperation, error)
 
+	// GetRescanTasks returns unfinished rescan tasks with their progress, or all tasks if all is set.
+	GetRescanTasks(ctx context.Context, all bool) ([]*core.RescanTask, error)
+
 	filter.BlockRepository
 
 	GetLabelCategories(context.Context) ([]core.LabelCategory, error)
 
+	GetTokenMetadata(ctx context.Context, uri string, contentHash []byte) (*core.TokenMetadata, error)
+
+	ExecuteGetMethod(ctx context.Context, req *GetMethodReq) (*abi.GetMethodExecution, error)
+	EmulateMessage(ctx context.Context, req *EmulateMessageReq) (*EmulateMessageRes, error)
+
+	GetTrace(ctx context.Context, id []byte) (*Trace, error)
+
 	filter.AccountRepository
 	filter.TransactionRepository
 	filter.MessageRepository
+	filter.JettonTransferRepository
+	filter.SwapRepository
 
 	aggregate.AccountRepository
+	aggregate.HolderRepository
 	aggregate.MessageRepository
 
 	history.AccountRepository
 	history.TransactionRepository
 	history.MessageRepository
+	history.JettonTransferRepository
+	history.SwapRepository
+	history.PoolRepository
 }
@@ -0,0 +1,465 @@
+package query
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/tonkeeper/tongo/boc"
+	tgtlb "github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/txemulator"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/fetcher"
+	"github.com/stepandra/anton/internal/core"
+)
+
+// stateDiffSkipColumns are the account state fields,
+// which are changed by every transaction or are too big to be compared.
+var stateDiffSkipColumns = map[string]bool{
+	"workchain":      true,
+	"shard":          true,
+	"block_seq_no":   true,
+	"last_tx_lt":     true,
+	"last_tx_hash":   true,
+	"code":           true,
+	"data":           true,
+	"libraries":      true,
+	"label":          true,
+	"token_metadata": true,
+	"updated_at":     true,
+}
+
+func cellToTongo(c *cell.Cell, v any) error {
+	tgc, err := boc.DeserializeSinglRootBase64(base64.StdEncoding.EncodeToString(c.ToBOC()))
+	if err != nil {
+		return errors.Wrap(err, "tongo deserialize boc cell")
+	}
+	return tgtlb.Unmarshal(tgc, v)
+}
+
+func tongoToCell(v any) (*cell.Cell, error) {
+	tgc := boc.NewCell()
+	if err := tgtlb.Marshal(tgc, v); err != nil {
+		return nil, errors.Wrap(err, "tongo marshal")
+	}
+	raw, err := tgc.ToBoc()
+	if err != nil {
+		return nil, errors.Wrap(err, "tongo cell to boc")
+	}
+	return cell.FromBOC(raw)
+}
+
+// storageUsed approximates number of cells and bits used by the account code and data.
+func storageUsed(roots ...*cell.Cell) (cells, bits uint64) {
+	seen := map[string]bool{}
+
+	var walk func(c *cell.Cell)
+	walk = func(c *cell.Cell) {
+		h := string(c.Hash())
+		if seen[h] {
+			return
+		}
+		seen[h] = true
+		cells++
+		bits += uint64(c.BitsSize())
+		for i := 0; i < int(c.RefsNum()); i++ {
+			walk(c.MustPeekRef(i))
+		}
+	}
+
+	for _, r := range roots {
+		if r != nil {
+			walk(r)
+		}
+	}
+
+	return cells, bits
+}
+
+// makeShardAccount builds ShardAccount from the stored account state.
+// Storage statistics and extra currencies are not stored,
+// so storage is approximated with the size of code and data and the time of the last transaction.
+// The end logical time of the last transaction is not stored either, so it is taken as the next to the start one.
+func makeShardAccount(a *address.Address, acc *core.AccountState) (*cell.Cell, error) {
+	if acc == nil || acc.Status == core.NonExist {
+		// account_none$0 = Account;
+		none := cell.BeginCell().MustStoreBoolBit(false).EndCell()
+		return cell.BeginCell().
+			MustStoreRef(none).
+			MustStoreSlice(make([]byte, 32), 256).
+			MustStoreUInt(0, 64).
+			EndCell(), nil
+	}
+
+	var code, data *cell.Cell
+	var err error
+	if len(acc.Code) > 0 {
+		if code, err = cell.FromBOC(acc.Code); err != nil {
+			return nil, errors.Wrap(err, "account code from boc")
+		}
+	}
+	if len(acc.Data) > 0 {
+		if data, err = cell.FromBOC(acc.Data); err != nil {
+			return nil, errors.Wrap(err, "account data from boc")
+		}
+	}
+
+	// storage_used$_ cells:(VarUInteger 7) bits:(VarUInteger 7) public_cells:(VarUInteger 7) = StorageUsed;
+	// storage_info$_ used:StorageUsed last_paid:uint32 due_payment:(Maybe Grams) = StorageInfo;
+	cellsUsed, bitsUsed := storageUsed(code, data)
+	info := cell.BeginCell().
+		MustStoreVarUInt(cellsUsed, 7).
+		MustStoreVarUInt(bitsUsed, 7).
+		MustStoreVarUInt(0, 7).
+		MustStoreUInt(uint64(acc.UpdatedAt.Unix()), 32).
+		MustStoreBoolBit(false)
+
+	balance := big.NewInt(0)
+	if acc.Balance != nil {
+		balance = acc.Balance.ToMathBig()
+	}
+
+	// account$1 addr:MsgAddressInt storage_stat:StorageInfo storage:AccountStorage = Account;
+	// account_storage$_ last_trans_lt:uint64 balance:CurrencyCollection state:AccountState = AccountStorage;
+	b := cell.BeginCell().MustStoreBoolBit(true).MustStoreAddr(a).MustStoreBuilder(info).
+		MustStoreUInt(acc.LastTxLT+1, 64).
+		MustStoreBigCoins(balance).
+		MustStoreDict(nil)
+
+	switch acc.Status {
+	case core.Active:
+		state, err := tlb.ToCell(&tlb.StateInit{Code: code, Data: data})
+		if err != nil {
+			return nil, errors.Wrap(err, "state init to cell")
+		}
+		b.MustStoreBoolBit(true).MustStoreBuilder(state.ToBuilder())
+	case core.Frozen:
+		b.MustStoreUInt(0b01, 2).MustStoreSlice(acc.StateHash, 256)
+	default:
+		b.MustStoreUInt(0b00, 2)
+	}
+
+	lastTxHash := acc.LastTxHash
+	if len(lastTxHash) != 32 {
+		lastTxHash = make([]byte, 32)
+	}
+
+	return cell.BeginCell().
+		MustStoreRef(b.EndCell()).
+		MustStoreSlice(lastTxHash, 256).
+		MustStoreUInt(acc.LastTxLT, 64).
+		EndCell(), nil
+}
+
+// mapShardAccount maps emulated ShardAccount in the same way as the fetcher maps account from the lite server.
+func mapShardAccount(b *ton.BlockIDExt, sa *tgtlb.ShardAccount) (*core.AccountState, error) {
+	c, err := tongoToCell(sa)
+	if err != nil {
+		return nil, errors.Wrap(err, "shard account to cell")
+	}
+
+	var shardAcc tlb.ShardAccount
+	if err := tlb.LoadFromCell(&shardAcc, c.BeginParse()); err != nil {
+		return nil, errors.Wrap(err, "load shard account")
+	}
+
+	var st tlb.AccountState
+	if err := st.LoadFromCell(shardAcc.Account.BeginParse()); err != nil {
+		return nil, errors.Wrap(err, "load account state")
+	}
+	if !st.IsValid {
+		return fetcher.MapAccount(b, &tlb.Account{IsActive: false}), nil
+	}
+
+	acc := &tlb.Account{
+		IsActive:   true,
+		State:      &st,
+		LastTxLT:   shardAcc.LastTransLT,
+		LastTxHash: shardAcc.LastTransHash,
+	}
+	if st.Status == tlb.AccountStatusActive {
+		acc.Code = st.StateInit.Code
+		acc.Data = st.StateInit.Data
+	}
+
+	return fetcher.MapAccount(b, acc), nil
+}
+
+func mapEmulatedTransaction(b *ton.BlockIDExt, rawTxBase64 string) (*core.Transaction, uint64, error) {
+	raw, err := base64.StdEncoding.DecodeString(rawTxBase64)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "decode transaction boc")
+	}
+	c, err := cell.FromBOC(raw)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "transaction from boc")
+	}
+
+	var rawTx tlb.Transaction
+	if err := tlb.LoadFromCell(&rawTx, c.BeginParse()); err != nil {
+		return nil, 0, errors.Wrap(err, "load transaction")
+	}
+	rawTx.Hash = c.Hash()
+
+	tx, err := fetcher.MapTransaction(b, &rawTx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "map transaction")
+	}
+
+	var gasUsed uint64
+	if d, ok := rawTx.Description.Description.(tlb.TransactionDescriptionOrdinary); ok {
+		if p, ok := d.ComputePhase.Phase.(tlb.ComputePhaseVM); ok && p.Details.GasUsed != nil {
+			gasUsed = p.Details.GasUsed.Uint64()
+		}
+	}
+
+	return tx, gasUsed, nil
+}
+
+func accountStateDiff(before, after *core.AccountState) ([]*app.AccountStateChange, error) {
+	var b, a map[string]json.RawMessage
+
+	toMap := func(acc *core.AccountState, m *map[string]json.RawMessage) error {
+		if acc == nil {
+			return nil
+		}
+		raw, err := json.Marshal(acc)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(raw, m)
+	}
+	if err := toMap(before, &b); err != nil {
+		return nil, errors.Wrap(err, "marshal state before")
+	}
+	if err := toMap(after, &a); err != nil {
+		return nil, errors.Wrap(err, "marshal state after")
+	}
+
+	fields := map[string]bool{}
+	for f := range b {
+		fields[f] = true
+	}
+	for f := range a {
+		fields[f] = true
+	}
+
+	var ret []*app.AccountStateChange
+	for f := range fields {
+		if stateDiffSkipColumns[f] || bytes.Equal(b[f], a[f]) {
+			continue
+		}
+		ret = append(ret, &app.AccountStateChange{Field: f, Before: b[f], After: a[f]})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Field < ret[j].Field })
+
+	return ret, nil
+}
+
+func (s *Service) getOtherAccount(ctx context.Context, a addr.Address) (*core.AccountState, error) {
+	return s.getAccountState(ctx, a, nil)
+}
+
+func (s *Service) getLatestStateIfExists(ctx context.Context, a addr.Address) *core.AccountState {
+	acc, err := s.getAccountState(ctx, a, nil)
+	if err != nil {
+		return nil
+	}
+	return acc
+}
+
+func (s *Service) parseEmulatedMessage(ctx context.Context, p app.ParserService, msg *core.Message) {
+	err := p.ParseMessagePayload(ctx, msg)
+	if err != nil && !errors.Is(err, app.ErrImpossibleParsing) {
+		log.Error().Err(err).
+			Str("src_addr", msg.SrcAddress.String()).
+			Str("dst_addr", msg.DstAddress.String()).
+			Uint32("op_id", msg.OperationID).
+			Msg("parse emulated message payload")
+	}
+	msg.SrcState, msg.DstState = nil, nil
+}
+
+func (s *Service) parseEmulatedTransaction(ctx context.Context, p app.ParserService, tx *core.Transaction, before *core.AccountState) {
+	if tx.Account.Status == core.Active {
+		err := p.ParseAccountData(ctx, tx.Account, s.getOtherAccount)
+		if err != nil && !errors.Is(err, app.ErrImpossibleParsing) {
+			log.Error().Err(err).Str("addr", tx.Address.String()).Msg("parse emulated account state")
+		}
+	}
+
+	if in := tx.InMsg; in != nil {
+		in.DstState = before
+		if in.Type == core.Internal {
+			in.SrcState = s.getLatestStateIfExists(ctx, in.SrcAddress)
+		}
+		s.parseEmulatedMessage(ctx, p, in)
+	}
+
+	for _, out := range tx.OutMsg {
+		out.SrcState = tx.Account
+		if out.Type == core.Internal {
+			out.DstState = s.getLatestStateIfExists(ctx, out.DstAddress)
+		}
+		s.parseEmulatedMessage(ctx, p, out)
+	}
+}
+
+func parseEmulatedMessageBoc(req *app.EmulateMessageReq) (*cell.Cell, error) {
+	if len(req.Message) == 0 {
+		return nil, errors.Wrap(core.ErrInvalidArg, "empty message")
+	}
+
+	c, err := cell.FromBOC(req.Message)
+	if err != nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "message from boc: %s", err.Error())
+	}
+
+	var msg tlb.Message
+	if err := tlb.LoadFromCell(&msg, c.BeginParse()); err != nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "load message: %s", err.Error())
+	}
+	switch msg.MsgType {
+	case tlb.MsgTypeInternal, tlb.MsgTypeExternalIn:
+	default:
+		return nil, errors.Wrapf(core.ErrInvalidArg, "cannot emulate %s message", msg.MsgType)
+	}
+
+	dst, err := new(addr.Address).FromTonutils(msg.Msg.DestAddr())
+	if err != nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "message destination: %s", err.Error())
+	}
+	if *dst != req.Address {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "message destination %s does not match account %s", dst.Base64(), req.Address.Base64())
+	}
+
+	return c, nil
+}
+
+// EmulateMessage emulates the transaction triggered by the incoming message on the stored account state.
+// The transaction happens at the current time or, if the historical state is requested,
+// at the time of that state.
+// If account is not found, the message is emulated on the empty account.
+func (s *Service) EmulateMessage(ctx context.Context, req *app.EmulateMessageReq) (*app.EmulateMessageRes, error) {
+	msgCell, err := parseEmulatedMessageBoc(req)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.getAccountState(ctx, req.Address, req.LastTxLT)
+	if err != nil && !(errors.Is(err, core.ErrNotFound) && req.LastTxLT == nil) {
+		return nil, err
+	}
+
+	bcConfig, p, err := s.getEmulationConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shardAccCell, err := makeShardAccount(req.Address.MustToTonutils(), before)
+	if err != nil {
+		return nil, errors.Wrap(err, "make shard account")
+	}
+	var (
+		shardAcc tgtlb.ShardAccount
+		message  tgtlb.Message
+	)
+	if err := cellToTongo(shardAccCell, &shardAcc); err != nil {
+		return nil, errors.Wrap(err, "shard account to tongo")
+	}
+	if err := cellToTongo(msgCell, &message); err != nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "message to tongo: %s", err.Error())
+	}
+
+	// gas of the emulated transaction is limited by the blockchain config as on-chain
+	cfg, err := boc.DeserializeSinglRootBase64(bcConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "deserialize blockchain config")
+	}
+	e, err := txemulator.NewEmulator(cfg, txemulator.LogTruncated)
+	if err != nil {
+		return nil, errors.Wrap(err, "new emulator")
+	}
+	if err := e.SetIgnoreSignatureCheck(req.IgnoreSignature); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if req.LastTxLT != nil {
+		now = before.UpdatedAt
+	}
+	if err := e.SetUnixtime(uint32(now.Unix())); err != nil {
+		return nil, err
+	}
+	if before != nil && len(before.Libraries) > 0 {
+		libs, err := boc.DeserializeBoc(before.Libraries)
+		if err != nil {
+			return nil, errors.Wrap(err, "deserialize account libraries")
+		}
+		if err := e.SetLibs(libs[0]); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := e.Emulate(shardAcc, message)
+	if err != nil {
+		return nil, errors.Wrap(err, "emulate transaction")
+	}
+	if !res.Success || res.Emulation == nil {
+		ret := &app.EmulateMessageRes{VmLog: res.Logs}
+		if res.Error != nil {
+			ret.Error, ret.ExitCode = res.Error.Text, int32(res.Error.ExitCode)
+		}
+		return ret, nil
+	}
+
+	b := &ton.BlockIDExt{Workchain: int32(req.Address.Workchain())}
+	if before != nil {
+		b.Shard = before.Shard
+	}
+
+	tx, gasUsed, err := mapEmulatedTransaction(b, res.Emulation.RawTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Account, err = mapShardAccount(b, &res.Emulation.ShardAccount)
+	if err != nil {
+		return nil, err
+	}
+	tx.Account.Address = req.Address
+	tx.Account.UpdatedAt = tx.CreatedAt
+	if before != nil {
+		tx.Account.Libraries = before.Libraries
+	}
+
+	s.parseEmulatedTransaction(ctx, p, tx, before)
+	if err := s.addGetMethodDescription(ctx, []*core.AccountState{tx.Account}); err != nil {
+		return nil, err
+	}
+
+	diff, err := accountStateDiff(before, tx.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &app.EmulateMessageRes{
+		Success:     true,
+		ExitCode:    tx.ComputePhaseExitCode,
+		GasUsed:     gasUsed,
+		VmLog:       res.Logs,
+		Transaction: tx,
+		StateDiff:   diff,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package query
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tonkeeper/tongo/boc"
+	tgtlb "github.com/tonkeeper/tongo/tlb"
+	"github.com/tonkeeper/tongo/txemulator"
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/xssnick/tonutils-go/address"
+	"github.com/xssnick/tonutils-go/tlb"
+	"github.com/xssnick/tonutils-go/ton"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func TestEmulateMessage_EmptyCode(t *testing.T) {
+	a := address.MustParseAddr("EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg")
+	src := address.MustParseAddr("EQDj5AA8mQvM5wJEQsFFFof79y3ZsuX6wowktWQFhz_Anton")
+
+	// empty code cell executes implicit RET and accepts every internal message
+	code := cell.BeginCell().EndCell()
+	data := cell.BeginCell().MustStoreUInt(42, 32).EndCell()
+
+	before := &core.AccountState{
+		Address:    *addr.MustFromTonutils(a),
+		IsActive:   true,
+		Status:     core.Active,
+		Balance:    bunbig.FromInt64(1_000_000_000),
+		LastTxLT:   1000,
+		LastTxHash: make([]byte, 32),
+		Code:       code.ToBOC(),
+		Data:       data.ToBOC(),
+		UpdatedAt:  time.Now().Add(-time.Minute),
+	}
+
+	shardAccCell, err := makeShardAccount(a, before)
+	require.Nil(t, err)
+
+	msgCell, err := tlb.ToCell(&tlb.InternalMessage{
+		Bounce:    true,
+		SrcAddr:   src,
+		DstAddr:   a,
+		Amount:    tlb.MustFromTON("2"),
+		CreatedLT: 2000,
+		CreatedAt: uint32(time.Now().Unix()),
+		Body:      cell.BeginCell().EndCell(),
+	})
+	require.Nil(t, err)
+
+	var (
+		shardAcc tgtlb.ShardAccount
+		message  tgtlb.Message
+	)
+	require.Nil(t, cellToTongo(shardAccCell, &shardAcc))
+	require.Nil(t, cellToTongo(msgCell, &message))
+
+	cfg, err := boc.DeserializeSinglRootBase64(txemulator.DefaultConfig)
+	require.Nil(t, err)
+	e, err := txemulator.NewEmulator(cfg, txemulator.LogTruncated)
+	require.Nil(t, err)
+
+	res, err := e.Emulate(shardAcc, message)
+	require.Nil(t, err)
+	require.True(t, res.Success, "%+v %s", res.Error, res.Logs)
+	require.NotNil(t, res.Emulation)
+
+	b := &ton.BlockIDExt{Workchain: 0}
+
+	tx, gasUsed, err := mapEmulatedTransaction(b, res.Emulation.RawTransaction)
+	require.Nil(t, err)
+	require.Equal(t, int32(0), tx.ComputePhaseExitCode)
+	require.NotZero(t, gasUsed)
+	require.NotNil(t, tx.InMsg)
+	require.Equal(t, *addr.MustFromTonutils(src), tx.InMsg.SrcAddress)
+	require.Equal(t, big.NewInt(2_000_000_000), tx.InAmount.ToMathBig())
+
+	after, err := mapShardAccount(b, &res.Emulation.ShardAccount)
+	require.Nil(t, err)
+	require.Equal(t, before.Address, after.Address)
+	require.Equal(t, core.Active, after.Status)
+	require.Equal(t, before.CodeHash, []byte(nil))
+	require.Equal(t, code.Hash(), after.CodeHash)
+	require.Equal(t, data.Hash(), after.DataHash)
+	require.Equal(t, tx.CreatedLT, after.LastTxLT)
+	require.Equal(t, 1, after.Balance.ToMathBig().Cmp(before.Balance.ToMathBig()))
+
+	diff, err := accountStateDiff(before, after)
+	require.Nil(t, err)
+
+	var fields []string
+	for _, d := range diff {
+		fields = append(fields, d.Field)
+	}
+	require.Equal(t, []string{"balance", "code_hash", "data_hash"}, fields)
+
+	var balance json.Number
+	require.Nil(t, json.Unmarshal(diff[0].After, &balance))
+	require.Equal(t, after.Balance.String(), balance.String())
+}
+
+func TestMakeShardAccount_None(t *testing.T) {
+	a := address.MustParseAddr("EQBMy6CNgBk8PrT5LNjPELxCX_LXBaVSqtbzRToUHlG3t-fg")
+
+	c, err := makeShardAccount(a, nil)
+	require.Nil(t, err)
+
+	var shardAcc tgtlb.ShardAccount
+	require.Nil(t, cellToTongo(c, &shardAcc))
+	require.Equal(t, "AccountNone", string(shardAcc.Account.SumType))
+
+	acc, err := mapShardAccount(&ton.BlockIDExt{}, &shardAcc)
+	require.Nil(t, err)
+	require.Equal(t, core.NonExist, acc.Status)
+}
@@ -0,0 +1,185 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"runtime"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/parser"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+// getEmulationConfig returns blockchain config used for emulation and the parser created with it.
+// The config is fetched from the lite server again after BlockchainConfigTTL,
+// and the stale one is used while it cannot be updated.
+func (s *Service) getEmulationConfig(ctx context.Context) (string, app.ParserService, error) {
+	s.bcConfigLock.Lock()
+	defer s.bcConfigLock.Unlock()
+
+	if s.parser != nil && time.Since(s.bcConfigFetchedAt) < s.BlockchainConfigTTL {
+		return s.bcConfigBase64, s.parser, nil
+	}
+	if s.API == nil {
+		return "", nil, errors.New("no lite server api client to get blockchain config")
+	}
+
+	cfg, err := app.GetBlockchainConfig(ctx, s.API)
+	if err != nil {
+		if s.parser == nil {
+			return "", nil, errors.Wrap(err, "get blockchain config")
+		}
+		log.Error().Err(err).Msg("cannot update blockchain config, using the stale one")
+		s.bcConfigFetchedAt = time.Now().Add(time.Minute - s.BlockchainConfigTTL) // retry in a minute
+		return s.bcConfigBase64, s.parser, nil
+	}
+
+	s.bcConfigBase64 = base64.StdEncoding.EncodeToString(cfg.ToBOC())
+	s.bcConfigFetchedAt = time.Now()
+	s.parser = parser.NewService(&app.ParserConfig{
+		BlockchainConfig:         cfg,
+		ContractRepo:             s.contractRepo,
+		MaxAccountParsingWorkers: runtime.NumCPU(),
+	})
+
+	return s.bcConfigBase64, s.parser, nil
+}
+
+func (s *Service) getMethodDescription(ctx context.Context, req *app.GetMethodReq) (desc abi.GetMethodDesc, err error) {
+	if req.Interface == "" {
+		desc.Name = req.GetMethod
+		for it := range req.Arguments {
+			desc.Arguments = append(desc.Arguments, req.Arguments[it].VmValueDesc)
+		}
+		desc.ReturnValues = req.ReturnValues
+		return desc, nil
+	}
+
+	interfaces, err := s.contractRepo.GetInterfaces(ctx) // to fill in the cache with contract interfaces
+	if err != nil {
+		return desc, errors.Wrap(err, "get contract interfaces")
+	}
+	var found bool
+	for _, i := range interfaces {
+		if i.Name == req.Interface {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return desc, errors.Wrapf(core.ErrNotFound, "cannot find %s contract interface", req.Interface)
+	}
+
+	desc, err = s.contractRepo.GetMethodDescription(ctx, req.Interface, req.GetMethod)
+	if err != nil {
+		return desc, errors.Wrapf(err, "get %s get-method description of contract %s", req.GetMethod, req.Interface)
+	}
+	if len(desc.Arguments) != len(req.Arguments) {
+		return desc, errors.Wrapf(core.ErrInvalidArg, "%s get-method has %d arguments, but %d are passed",
+			req.GetMethod, len(desc.Arguments), len(req.Arguments))
+	}
+
+	return desc, nil
+}
+
+func (s *Service) getAccountState(ctx context.Context, a addr.Address, lastTxLT *uint64) (*core.AccountState, error) {
+	req := &filter.AccountsReq{
+		WithCodeData: true,
+		Addresses:    []*addr.Address{&a},
+		LatestState:  lastTxLT == nil,
+		Limit:        1,
+	}
+	if lastTxLT != nil {
+		req.StateIDs = []*core.AccountStateID{{Address: a, LastTxLT: *lastTxLT}}
+	}
+
+	res, err := s.FilterAccounts(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "filter accounts")
+	}
+	if len(res.Rows) == 0 {
+		return nil, errors.Wrapf(core.ErrNotFound, "cannot find %s account state", a.Base64())
+	}
+
+	return res.Rows[0], nil
+}
+
+// ExecuteGetMethod emulates the get-method on the stored account state.
+// Errors of TVM execution are returned in the Error field of the get-method execution.
+func (s *Service) ExecuteGetMethod(ctx context.Context, req *app.GetMethodReq) (*abi.GetMethodExecution, error) {
+	if req.GetMethod == "" {
+		return nil, errors.Wrap(core.ErrInvalidArg, "empty get-method name")
+	}
+
+	desc, err := s.getMethodDescription(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var args abi.VmStack
+	for it := range desc.Arguments {
+		d := desc.Arguments[it]
+		p, err := abi.PayloadFromJSON(&d, req.Arguments[it].Payload)
+		if err != nil {
+			return nil, errors.Wrapf(core.ErrInvalidArg, "argument %d: %s", it, err.Error())
+		}
+		args = append(args, abi.VmValue{VmValueDesc: d, Payload: p})
+	}
+
+	acc, err := s.getAccountState(ctx, req.Address, req.LastTxLT)
+	if err != nil {
+		return nil, err
+	}
+	if len(acc.Code) == 0 || len(acc.Data) == 0 {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "no code or data in %s account state (%d)", acc.Address.Base64(), acc.LastTxLT)
+	}
+
+	bcConfig, _, err := s.getEmulationConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := abi.NewEmulatorBase64(acc.Address.MustToTonutils(),
+		base64.StdEncoding.EncodeToString(acc.Code),
+		base64.StdEncoding.EncodeToString(acc.Data),
+		bcConfig,
+		base64.StdEncoding.EncodeToString(acc.Libraries))
+	if err != nil {
+		return nil, errors.Wrap(err, "new emulator")
+	}
+	if err := e.Emulator.SetGasLimit(s.GetMethodGasLimit); err != nil {
+		return nil, errors.Wrap(err, "set gas limit")
+	}
+
+	var retStack abi.VmStack
+	if len(desc.ReturnValues) == 0 {
+		retStack, err = e.RunGetMethodRaw(ctx, desc.Name, args)
+	} else {
+		retStack, err = e.RunGetMethod(ctx, desc.Name, args, desc.ReturnValues)
+	}
+
+	ret := &abi.GetMethodExecution{
+		Name:      desc.Name,
+		Address:   &acc.Address,
+		Arguments: desc.Arguments,
+	}
+	for i := range args {
+		ret.Receives = append(ret.Receives, args[i].Payload)
+	}
+	for i := range retStack {
+		ret.ReturnValues = append(ret.ReturnValues, retStack[i].VmValueDesc)
+		ret.Returns = append(ret.Returns, retStack[i].Payload)
+	}
+	if err != nil {
+		ret.Error = err.Error()
+	}
+
+	return ret, nil
+}
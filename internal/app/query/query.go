@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/xssnick/tonutils-go/ton"
@@ -19,7 +20,11 @@ import (
 	"github.com/stepandra/anton/internal/core/repository/account"
 	"github.com/stepandra/anton/internal/core/repository/block"
 	"github.com/stepandra/anton/internal/core/repository/contract"
+	"github.com/stepandra/anton/internal/core/repository/metadata"
 	"github.com/stepandra/anton/internal/core/repository/msg"
+	"github.com/stepandra/anton/internal/core/repository/rescan"
+	"github.com/stepandra/anton/internal/core/repository/swap"
+	"github.com/stepandra/anton/internal/core/repository/transfer"
 	"github.com/stepandra/anton/internal/core/repository/tx"
 )
 
@@ -33,22 +38,44 @@ type Service struct {
 	txRepo       repository.Transaction
 	msgRepo      repository.Message
 	accountRepo  repository.Account
+	metadataRepo core.MetadataRepository
+	rescanRepo   core.RescanRepository
+	transferRepo repository.JettonTransfer
+	swapRepo     repository.Swap
 
 	statsLastBlock uint32
 	statsCached    *aggregate.Statistics
 	statsLock      sync.RWMutex
+
+	bcConfigBase64    string
+	bcConfigFetchedAt time.Time
+	parser            app.ParserService
+	bcConfigLock      sync.Mutex
 }
 
 func NewService(_ context.Context, cfg *app.QueryConfig) (*Service, error) {
 	var s = new(Service)
 
 	s.QueryConfig = cfg
+
+	// validate config
+	if s.BlockchainConfigTTL <= 0 {
+		s.BlockchainConfigTTL = time.Hour
+	}
+	if s.GetMethodGasLimit <= 0 {
+		s.GetMethodGasLimit = 1_000_000
+	}
+
 	ch, pg := s.DB.CH, s.DB.PG
 	s.txRepo = tx.NewRepository(ch, pg)
 	s.msgRepo = msg.NewRepository(ch, pg)
 	s.blockRepo = block.NewRepository(ch, pg)
 	s.accountRepo = account.NewRepository(ch, pg)
 	s.contractRepo = contract.NewRepository(pg)
+	s.metadataRepo = metadata.NewRepository(pg)
+	s.rescanRepo = rescan.NewRepository(pg)
+	s.transferRepo = transfer.NewRepository(ch, pg)
+	s.swapRepo = swap.NewRepository(ch, pg)
 
 	return s, nil
 }
@@ -89,6 +116,10 @@ func (s *Service) GetOperations(ctx context.Context) ([]*core.ContractOperation,
 	return s.contractRepo.GetOperations(ctx)
 }
 
+func (s *Service) GetRescanTasks(ctx context.Context, all bool) ([]*core.RescanTask, error) {
+	return s.rescanRepo.GetRescanTasks(ctx, all)
+}
+
 func (s *Service) FilterBlocks(ctx context.Context, req *filter.BlocksReq) (*filter.BlocksRes, error) {
 	return s.blockRepo.FilterBlocks(ctx, req)
 }
@@ -97,6 +128,10 @@ func (s *Service) GetLabelCategories(_ context.Context) ([]core.LabelCategory, e
 	return []core.LabelCategory{core.Scam, core.CentralizedExchange}, nil
 }
 
+func (s *Service) GetTokenMetadata(ctx context.Context, uri string, contentHash []byte) (*core.TokenMetadata, error) {
+	return s.metadataRepo.GetMetadata(ctx, uri, contentHash)
+}
+
 func (s *Service) FilterLabels(ctx context.Context, req *filter.LabelsReq) (*filter.LabelsRes, error) {
 	return s.accountRepo.FilterLabels(ctx, req)
 }
@@ -191,6 +226,28 @@ func (s *Service) addGetMethodDescription(ctx context.Context, rows []*core.Acco
 	return nil
 }
 
+func (s *Service) addTokenMetadata(ctx context.Context, rows []*core.AccountState) error {
+	var uris []string
+	for _, r := range rows {
+		if r.ContentURI != "" {
+			uris = append(uris, r.ContentURI)
+		}
+	}
+	if len(uris) == 0 {
+		return nil
+	}
+
+	docs, err := s.metadataRepo.GetLatestMetadata(ctx, uris)
+	if err != nil {
+		return errors.Wrap(err, "get token metadata")
+	}
+	for _, r := range rows {
+		r.TokenMetadata = docs[r.ContentURI]
+	}
+
+	return nil
+}
+
 func (s *Service) FilterAccounts(ctx context.Context, req *filter.AccountsReq) (*filter.AccountsRes, error) {
 	res, err := s.accountRepo.FilterAccounts(ctx, req)
 	if err != nil {
@@ -202,6 +259,9 @@ func (s *Service) FilterAccounts(ctx context.Context, req *filter.AccountsReq) (
 	if err := s.addGetMethodDescription(ctx, res.Rows); err != nil {
 		return nil, err
 	}
+	if err := s.addTokenMetadata(ctx, res.Rows); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
@@ -209,6 +269,10 @@ func (s *Service) AggregateAccounts(ctx context.Context, req *aggregate.Accounts
 	return s.accountRepo.AggregateAccounts(ctx, req)
 }
 
+func (s *Service) AggregateHolders(ctx context.Context, req *aggregate.HoldersReq) (*aggregate.HoldersRes, error) {
+	return s.accountRepo.AggregateHolders(ctx, req)
+}
+
 func (s *Service) AggregateAccountsHistory(ctx context.Context, req *history.AccountsReq) (*history.AccountsRes, error) {
 	return s.accountRepo.AggregateAccountsHistory(ctx, req)
 }
@@ -235,3 +299,23 @@ func (s *Service) AggregateMessages(ctx context.Context, req *aggregate.Messages
 func (s *Service) AggregateMessagesHistory(ctx context.Context, req *history.MessagesReq) (*history.MessagesRes, error) {
 	return s.msgRepo.AggregateMessagesHistory(ctx, req)
 }
+
+func (s *Service) FilterJettonTransfers(ctx context.Context, req *filter.JettonTransfersReq) (*filter.JettonTransfersRes, error) {
+	return s.transferRepo.FilterJettonTransfers(ctx, req)
+}
+
+func (s *Service) AggregateJettonTransfersHistory(ctx context.Context, req *history.JettonTransfersReq) (*history.JettonTransfersRes, error) {
+	return s.transferRepo.AggregateJettonTransfersHistory(ctx, req)
+}
+
+func (s *Service) FilterSwaps(ctx context.Context, req *filter.SwapsReq) (*filter.SwapsRes, error) {
+	return s.swapRepo.FilterSwaps(ctx, req)
+}
+
+func (s *Service) AggregateSwapCandles(ctx context.Context, req *history.SwapCandlesReq) (*history.SwapCandlesRes, error) {
+	return s.swapRepo.AggregateSwapCandles(ctx, req)
+}
+
+func (s *Service) AggregatePoolHistory(ctx context.Context, req *history.PoolReq) (*history.PoolRes, error) {
+	return s.accountRepo.AggregatePoolHistory(ctx, req)
+}
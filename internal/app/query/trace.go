@@ -0,0 +1,93 @@
+package query
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+const maxTraceTransactions = 10000
+
+// buildTrace links transactions of the trace by their incoming and outgoing messages.
+// Transactions are expected to be sorted by logical time, so the root goes first.
+func buildTrace(id []byte, transactions []*core.Transaction) *app.Trace {
+	ret := &app.Trace{
+		ID:                id,
+		TransactionsCount: len(transactions),
+	}
+	if len(transactions) == 0 {
+		return ret
+	}
+
+	byInMsg := make(map[string]*core.Transaction, len(transactions))
+	for _, tx := range transactions {
+		if len(tx.InMsgHash) > 0 {
+			byInMsg[string(tx.InMsgHash)] = tx
+		}
+	}
+
+	var walk func(tx *core.Transaction) *app.TraceNode
+	walk = func(tx *core.Transaction) *app.TraceNode {
+		node := &app.TraceNode{Transaction: tx}
+		for _, out := range tx.OutMsg {
+			if out.Type != core.Internal {
+				continue
+			}
+			child, ok := byInMsg[string(out.Hash)]
+			if !ok {
+				ret.Incomplete = true
+				continue
+			}
+			node.Children = append(node.Children, walk(child))
+		}
+		sort.Slice(node.Children, func(i, j int) bool {
+			return node.Children[i].Transaction.CreatedLT < node.Children[j].Transaction.CreatedLT
+		})
+		return node
+	}
+
+	root := transactions[0]
+	for _, tx := range transactions {
+		if string(tx.Hash) == string(id) {
+			root = tx
+			break
+		}
+	}
+	ret.Root = walk(root)
+
+	return ret
+}
+
+// GetTrace returns the tree of transactions caused by the root transaction with the given hash.
+// Traces are completed by the indexer as new blocks arrive,
+// so the trace can be incomplete if some of its messages are not delivered yet.
+func (s *Service) GetTrace(ctx context.Context, id []byte) (*app.Trace, error) {
+	if len(id) == 0 {
+		return nil, errors.Wrap(core.ErrInvalidArg, "empty trace id")
+	}
+
+	res, err := s.txRepo.FilterTransactions(ctx, &filter.TransactionsReq{
+		TraceID:      id,
+		WithMessages: true,
+		Order:        "ASC",
+		Limit:        maxTraceTransactions,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "filter transactions")
+	}
+	if len(res.Rows) == 0 {
+		return nil, errors.Wrapf(core.ErrNotFound, "cannot find %x trace", id)
+	}
+
+	ret := buildTrace(id, res.Rows)
+	if len(res.Rows) == maxTraceTransactions {
+		ret.Incomplete = true
+	}
+
+	return ret, nil
+}
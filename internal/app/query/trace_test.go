@@ -0,0 +1,55 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func TestBuildTrace(t *testing.T) {
+	msg := func(typ core.MessageType) *core.Message {
+		return &core.Message{Type: typ, Hash: rndm.Bytes(32)}
+	}
+	tx := func(lt uint64, in *core.Message, out ...*core.Message) *core.Transaction {
+		ret := &core.Transaction{Hash: rndm.Bytes(32), CreatedLT: lt, InMsg: in, OutMsg: out}
+		if in != nil {
+			ret.InMsgHash = in.Hash
+		}
+		return ret
+	}
+
+	transfer, internal := msg(core.Internal), msg(core.Internal)
+	notification, excesses, log := msg(core.Internal), msg(core.Internal), msg(core.ExternalOut)
+
+	wallet := tx(100, msg(core.ExternalIn), transfer)
+	sender := tx(102, transfer, internal)
+	receiver := tx(104, internal, excesses, notification, log)
+	excess := tx(106, excesses)
+
+	trace := buildTrace(wallet.Hash, []*core.Transaction{wallet, sender, receiver, excess})
+	require.Equal(t, 4, trace.TransactionsCount)
+	require.True(t, trace.Incomplete) // notification is not received yet
+
+	require.Equal(t, wallet, trace.Root.Transaction)
+	require.Len(t, trace.Root.Children, 1)
+	require.Equal(t, sender, trace.Root.Children[0].Transaction)
+	require.Len(t, trace.Root.Children[0].Children, 1)
+
+	r := trace.Root.Children[0].Children[0]
+	require.Equal(t, receiver, r.Transaction)
+	require.Len(t, r.Children, 1)
+	require.Equal(t, excess, r.Children[0].Transaction)
+
+	// the notification has arrived
+	owner := tx(105, notification)
+	trace = buildTrace(wallet.Hash, []*core.Transaction{wallet, sender, receiver, owner, excess})
+	require.False(t, trace.Incomplete)
+
+	r = trace.Root.Children[0].Children[0]
+	require.Len(t, r.Children, 2)
+	require.Equal(t, owner, r.Children[0].Transaction)
+	require.Equal(t, excess, r.Children[1].Transaction)
+}
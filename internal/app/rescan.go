@@ -1,6 +1,11 @@
 package app
 
 import (
+	"context"
+	"encoding/json"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
 	"github.com/stepandra/anton/internal/core"
 	"github.com/stepandra/anton/internal/core/repository"
 )
@@ -14,12 +19,83 @@ type RescanConfig struct {
 
 	Parser ParserService
 
+	// Tasks is the number of rescan tasks run in parallel.
+	Tasks int
+	// Workers is the number of goroutines parsing rows of each task.
 	Workers int
 
 	SelectLimit int
 }
 
+// DryRunReq describes the proposed contract interface, which is not saved in the database.
+type DryRunReq struct {
+	ContractName abi.ContractName
+	// Desc must contain the proposed interface, it has the same format as abi/known json files
+	Desc []*abi.InterfaceDesc
+	// Limit is the number of sampled account states and messages of each operation
+	Limit int
+	// Examples is the maximum number of diffs included in the report
+	Examples int
+}
+
+// ParsedAccountData is the account state data parsed with the rescanned interface.
+type ParsedAccountData struct {
+	Types              []abi.ContractName       `json:"types,omitempty"`
+	ExecutedGetMethods []abi.GetMethodExecution `json:"executed_get_methods,omitempty"`
+	ContractData       json.RawMessage          `json:"contract_data,omitempty"`
+}
+
+type AccountStateDiff struct {
+	Address  addr.Address       `json:"address"`
+	LastTxLT uint64             `json:"last_tx_lt"`
+	Before   *ParsedAccountData `json:"before"`
+	After    *ParsedAccountData `json:"after"`
+}
+
+// ParsedMessageData is the message data parsed with the rescanned operation.
+type ParsedMessageData struct {
+	OperationName string          `json:"operation_name,omitempty"`
+	DataJSON      json.RawMessage `json:"data,omitempty"`
+	Error         string          `json:"error,omitempty"`
+}
+
+type MessageDiff struct {
+	Hash        []byte             `json:"hash"`
+	OperationID uint32             `json:"operation_id"`
+	Before      *ParsedMessageData `json:"before"`
+	After       *ParsedMessageData `json:"after"`
+}
+
+// DryRunOperationStats counts sampled messages of the operation by the result of parsing.
+type DryRunOperationStats struct {
+	Sampled     int `json:"sampled"`
+	NewlyParsed int `json:"newly_parsed"` // messages which were not parsed as this operation before
+	Changed     int `json:"changed"`      // messages with changed parsed data
+	Failed      int `json:"failed"`       // messages which cannot be parsed with the proposed schema
+	Removed     int `json:"removed"`      // messages of the deleted operation
+}
+
+// DryRunReport shows the effect of the proposed interface on the sampled account states and messages.
+type DryRunReport struct {
+	ContractName abi.ContractName `json:"contract_name"`
+	NewInterface bool             `json:"new_interface"`
+
+	SampledAccounts   int             `json:"sampled_accounts"`
+	MatchedAccounts   []*addr.Address `json:"matched_accounts"`   // accounts newly matched by the interface
+	UnmatchedAccounts []*addr.Address `json:"unmatched_accounts"` // accounts no longer matched by the interface
+	// ChangedGetMethods is the number of account states with changed results of the get-method
+	ChangedGetMethods map[string]int      `json:"changed_get_methods"`
+	AccountDiffs      []*AccountStateDiff `json:"account_diffs"`
+
+	Operations   map[string]*DryRunOperationStats `json:"operations"`
+	MessageDiffs []*MessageDiff                   `json:"message_diffs"`
+}
+
 type RescanService interface {
 	Start() error
 	Stop()
+
+	// DryRun reparses a sample of account states and messages with the proposed contract interface in memory
+	// and reports the difference without updating them in the database.
+	DryRun(ctx context.Context, req *DryRunReq) (*DryRunReport, error)
 }
@@ -2,6 +2,7 @@ package rescan
 
 import (
 	"context"
+	"encoding/json"
 	"reflect"
 	"time"
 
@@ -55,9 +56,26 @@ func copyAccountState(state *core.AccountState) *core.AccountState {
 		copy(update.ExecutedGetMethods[n], e)
 	}
 
+	if state.ContractData != nil {
+		update.ContractData = make(map[abi.ContractName]json.RawMessage, len(state.ContractData))
+		for n, d := range state.ContractData {
+			update.ContractData[n] = d
+		}
+	}
+
 	return &update
 }
 
+func clearAllColumns(acc *core.AccountState) {
+	for _, c := range []abi.AccountColumn{
+		abi.ColumnOwnerAddress, abi.ColumnMinterAddress, abi.ColumnTokenBalance, abi.ColumnContent,
+		abi.ColumnTokenSupply, abi.ColumnAsset0Address, abi.ColumnAsset1Address, abi.ColumnReserve0, abi.ColumnReserve1,
+	} {
+		acc.ClearColumn(c)
+	}
+	acc.Fake = false
+}
+
 func (s *Service) clearParsedAccountsData(task *core.RescanTask, acc *core.AccountState) {
 	for it := range acc.Types {
 		if acc.Types[it] != task.ContractName {
@@ -69,6 +87,11 @@ func (s *Service) clearParsedAccountsData(task *core.RescanTask, acc *core.Accou
 		break
 	}
 
+	delete(acc.ContractData, task.ContractName)
+	if len(acc.ContractData) == 0 {
+		acc.ContractData = nil
+	}
+
 	_, ok := acc.ExecutedGetMethods[task.ContractName]
 	if !ok {
 		return
@@ -76,15 +99,23 @@ func (s *Service) clearParsedAccountsData(task *core.RescanTask, acc *core.Accou
 
 	delete(acc.ExecutedGetMethods, task.ContractName)
 
-	switch task.ContractName {
-	case known.JettonMinter, known.JettonWallet:
-		acc.MinterAddress = nil
-		acc.OwnerAddress = nil
-
+	if task.Contract == nil {
+		// interface is deleted, so we do not know which columns were filled by its get-methods
+		clearAllColumns(acc)
+		return
+	}
 
+	for it := range task.Contract.GetMethodsDesc {
+		for _, c := range task.Contract.GetMethodsDesc[it].MappedColumns() {
+			acc.ClearColumn(c)
+		}
+	}
+	if task.Contract.Verification != nil {
 		acc.Fake = false
-
-		acc.JettonBalance = nil
+	}
+	if task.ContractName == known.NFTItem {
+		// nft item content is taken from the collection get-method
+		acc.NFTContentData = core.NFTContentData{}
 	}
 }
 
@@ -115,6 +146,19 @@ func (s *Service) rescanInterface(ctx context.Context, task *core.RescanTask, ac
 	s.parseAccountData(ctx, task, acc)
 }
 
+func (s *Service) rescanContractData(task *core.RescanTask, acc *core.AccountState) {
+	err := s.Parser.ParseAccountContractState(task.Contract, acc)
+	if err != nil && !errors.Is(err, app.ErrImpossibleParsing) {
+		log.Error().Err(err).
+			Str("contract_name", string(task.ContractName)).
+			Str("addr", acc.Address.Base64()).
+			Msg("parse contract data")
+	}
+	if len(acc.ContractData) == 0 {
+		acc.ContractData = nil
+	}
+}
+
 func (s *Service) clearExecutedGetMethod(task *core.RescanTask, acc *core.AccountState, gm string) {
 	_, ok := acc.ExecutedGetMethods[task.ContractName]
 	if !ok {
@@ -131,30 +175,26 @@ func (s *Service) clearExecutedGetMethod(task *core.RescanTask, acc *core.Accoun
 		break
 	}
 
-	switch task.ContractName {
-	case known.JettonMinter, known.JettonWallet:
-	default:
-		return
+	var desc *abi.GetMethodDesc
+	for it := range task.Contract.GetMethodsDesc {
+		if task.Contract.GetMethodsDesc[it].Name == gm {
+			desc = &task.Contract.GetMethodsDesc[it]
+			break
+		}
 	}
-
-	switch gm {
-	case "get_nft_content", "get_collection_data", "get_jetton_data":
+	// if the get-method is deleted from the interface, its columns are unknown,
+	// so they are left as is until the account is parsed again
+	if desc != nil {
+		for _, c := range desc.MappedColumns() {
+			acc.ClearColumn(c)
+		}
 	}
-
-	switch gm {
-	case "get_collection_data":
-		acc.OwnerAddress = nil
-	case "get_nft_data", "get_wallet_data":
-		acc.OwnerAddress = nil
-		acc.MinterAddress = nil
+	if task.Contract.Verification != nil && task.Contract.Verification.References(gm) {
 		acc.Fake = false
-
-		acc.JettonBalance = nil
 	}
-
-	switch gm {
-	case "get_wallet_address", "get_nft_address_by_index":
-		acc.Fake = false
+	if task.ContractName == known.NFTItem && gm == "get_nft_data" {
+		// nft item content is taken from the collection get-method
+		acc.NFTContentData = core.NFTContentData{}
 	}
 }
 
@@ -226,6 +266,8 @@ func (s *Service) rescanAccountsWorker(ctx context.Context, task *core.RescanTas
 			for _, gm := range task.ChangedGetMethods {
 				s.rescanGetMethod(ctx, task, update, gm)
 			}
+		case core.UpdContractData:
+			s.rescanContractData(task, update)
 		}
 
 		if reflect.DeepEqual(acc, update) {
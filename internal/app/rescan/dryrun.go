@@ -0,0 +1,354 @@
+package rescan
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/contract"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+func hasType(types []abi.ContractName, t abi.ContractName) bool {
+	for _, x := range types {
+		if x == t {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonEqual compares json documents ignoring formatting, as it can be changed by the database.
+func jsonEqual(a, b json.RawMessage) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+
+	var x, y any
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return string(a) == string(b)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func parsedAccountData(acc *core.AccountState, name abi.ContractName) *app.ParsedAccountData {
+	return &app.ParsedAccountData{
+		Types:              acc.Types,
+		ExecutedGetMethods: acc.ExecutedGetMethods[name],
+		ContractData:       acc.ContractData[name],
+	}
+}
+
+func parsedMessageData(msg *core.Message) *app.ParsedMessageData {
+	return &app.ParsedMessageData{
+		OperationName: msg.OperationName,
+		DataJSON:      msg.DataJSON,
+		Error:         msg.Error,
+	}
+}
+
+// changedGetMethods returns names of get-methods with different executions in the given account states.
+func changedGetMethods(before, after *core.AccountState, name abi.ContractName) (ret []string) {
+	executions := func(acc *core.AccountState) map[string]abi.GetMethodExecution {
+		m := map[string]abi.GetMethodExecution{}
+		for _, e := range acc.ExecutedGetMethods[name] {
+			m[e.Name] = e
+		}
+		return m
+	}
+
+	b, a := executions(before), executions(after)
+	for gm, e := range b {
+		if x, ok := a[gm]; !ok || !reflect.DeepEqual(e, x) {
+			ret = append(ret, gm)
+		}
+	}
+	for gm := range a {
+		if _, ok := b[gm]; !ok {
+			ret = append(ret, gm)
+		}
+	}
+	return ret
+}
+
+// withParser returns a copy of the service using the given parser, which is not able to run rescan tasks.
+func (s *Service) withParser(p app.ParserService) *Service {
+	cfg := *s.RescanConfig
+	cfg.Parser = p
+
+	return &Service{
+		RescanConfig:     &cfg,
+		interfacesCache:  s.interfacesCache,
+		minterStateCache: s.minterStateCache,
+	}
+}
+
+// dryRunAccounts reparses sampled account states in the same way as interface rescan task does.
+// It returns addresses of the sampled accounts matching either the old or the new interface description.
+func (s *Service) dryRunAccounts(ctx context.Context, req *app.DryRunReq, report *app.DryRunReport, desc *core.ContractInterface) ([]*addr.Address, error) {
+	task := &core.RescanTask{Type: core.UpdInterface, ContractName: req.ContractName, Contract: desc}
+	matchName := req.ContractName
+	if report.NewInterface {
+		task.Type, matchName = core.AddInterface, ""
+	}
+
+	var codeHash []byte
+	if desc.Code != nil {
+		codeCell, err := cell.FromBOC(desc.Code)
+		if err != nil {
+			return nil, errors.Wrapf(err, "making %s code cell from boc", desc.Name)
+		}
+		codeHash = codeCell.Hash()
+	}
+
+	// the latest state of every account is sampled, so the sample is not taken by the history of a few accounts
+	ids, err := s.AccountRepo.MatchLatestStatesByInterfaceDesc(ctx, matchName, desc.Addresses, codeHash, desc.GetMethodHashes, req.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "match states by interface description")
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	accRet, err := s.AccountRepo.FilterAccounts(ctx, &filter.AccountsReq{WithCodeData: true, StateIDs: ids})
+	if err != nil {
+		return nil, errors.Wrap(err, "filter accounts")
+	}
+
+	updates, _ := rescanStartWorkers(
+		ctx, task, accRet.Rows,
+		func(v *core.AccountState) core.AccountStateID {
+			return core.AccountStateID{Address: v.Address, LastTxLT: v.LastTxLT}
+		},
+		s.rescanAccountsWorker, s.Workers)
+
+	updated := make(map[core.AccountStateID]*core.AccountState, len(updates))
+	for _, u := range updates {
+		updated[core.AccountStateID{Address: u.Address, LastTxLT: u.LastTxLT}] = u
+	}
+
+	var (
+		addresses []*addr.Address
+		seen      = map[addr.Address]bool{}
+		matched   = map[addr.Address]bool{}
+		unmatched = map[addr.Address]bool{}
+	)
+
+	report.SampledAccounts = len(accRet.Rows)
+
+	for _, acc := range accRet.Rows {
+		after, ok := updated[core.AccountStateID{Address: acc.Address, LastTxLT: acc.LastTxLT}]
+		if !ok {
+			after = acc
+		}
+
+		matchedBefore, matchedAfter := hasType(acc.Types, req.ContractName), hasType(after.Types, req.ContractName)
+		if (matchedBefore || matchedAfter) && !seen[acc.Address] {
+			seen[acc.Address] = true
+			addresses = append(addresses, &acc.Address)
+		}
+		switch {
+		case !matchedBefore && matchedAfter && !matched[acc.Address]:
+			matched[acc.Address] = true
+			report.MatchedAccounts = append(report.MatchedAccounts, &acc.Address)
+		case matchedBefore && !matchedAfter && !unmatched[acc.Address]:
+			unmatched[acc.Address] = true
+			report.UnmatchedAccounts = append(report.UnmatchedAccounts, &acc.Address)
+		}
+
+		if !ok {
+			continue
+		}
+		for _, gm := range changedGetMethods(acc, after, req.ContractName) {
+			report.ChangedGetMethods[gm]++
+		}
+		if len(report.AccountDiffs) < req.Examples {
+			report.AccountDiffs = append(report.AccountDiffs, &app.AccountStateDiff{
+				Address:  acc.Address,
+				LastTxLT: acc.LastTxLT,
+				Before:   parsedAccountData(acc, req.ContractName),
+				After:    parsedAccountData(after, req.ContractName),
+			})
+		}
+	}
+
+	return addresses, nil
+}
+
+type operationKey struct {
+	MessageType core.MessageType
+	Outgoing    bool
+	OperationID uint32
+}
+
+// dryRunOperation reparses sampled messages of the given accounts with the new operation description.
+// If the operation is deleted, its parsed data is removed in the same way as operation rescan task does.
+func (s *Service) dryRunOperation(ctx context.Context, req *app.DryRunReq, report *app.DryRunReport, addresses []*addr.Address, key operationKey, oldOp, newOp *core.ContractOperation) error {
+	op := oldOp
+	if newOp != nil {
+		op = newOp
+	}
+
+	stats, ok := report.Operations[op.OperationName]
+	if !ok {
+		stats = new(app.DryRunOperationStats)
+		report.Operations[op.OperationName] = stats
+	}
+
+	f := &filter.MessagesReq{OperationID: &key.OperationID, Order: "DESC", Limit: req.Limit}
+	if key.Outgoing {
+		f.SrcAddresses = addresses
+	} else {
+		f.DstAddresses = addresses
+	}
+	res, err := s.MessageRepo.FilterMessages(ctx, f)
+	if err != nil {
+		return errors.Wrapf(err, "filter '%s' operation messages", op.OperationName)
+	}
+
+	for _, msg := range res.Rows {
+		if msg.Type != key.MessageType {
+			continue
+		}
+		stats.Sampled++
+
+		upd := *msg
+		switch {
+		case newOp == nil:
+			upd.SrcContract, upd.DstContract, upd.OperationName, upd.DataJSON, upd.Error = "", "", "", nil, ""
+			if msg.OperationName != "" {
+				stats.Removed++
+			}
+		default:
+			if err := s.Parser.ParseMessageOperation(&upd, newOp); err != nil {
+				upd.DataJSON, upd.Error = nil, err.Error()
+				stats.Failed++
+				break
+			}
+			upd.Error = ""
+			if msg.OperationName != newOp.OperationName {
+				stats.NewlyParsed++
+			} else if !jsonEqual(msg.DataJSON, upd.DataJSON) {
+				stats.Changed++
+			}
+		}
+
+		if msg.OperationName == upd.OperationName && msg.Error == upd.Error && jsonEqual(msg.DataJSON, upd.DataJSON) {
+			continue
+		}
+		if len(report.MessageDiffs) < req.Examples {
+			report.MessageDiffs = append(report.MessageDiffs, &app.MessageDiff{
+				Hash:        msg.Hash,
+				OperationID: msg.OperationID,
+				Before:      parsedMessageData(msg),
+				After:       parsedMessageData(&upd),
+			})
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) dryRunMessages(ctx context.Context, req *app.DryRunReq, report *app.DryRunReport, addresses []*addr.Address, oldOps, newOps []*core.ContractOperation) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	var (
+		keys []operationKey
+		ops  = map[operationKey][2]*core.ContractOperation{}
+	)
+	add := func(op *core.ContractOperation, it int) {
+		k := operationKey{MessageType: op.MessageType, Outgoing: op.Outgoing, OperationID: op.OperationID}
+		v, ok := ops[k]
+		if !ok {
+			keys = append(keys, k)
+		}
+		v[it] = op
+		ops[k] = v
+	}
+	for _, op := range oldOps {
+		add(op, 0)
+	}
+	for _, op := range newOps {
+		add(op, 1)
+	}
+
+	for _, k := range keys {
+		if err := s.dryRunOperation(ctx, req, report, addresses, k, ops[k][0], ops[k][1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) DryRun(ctx context.Context, req *app.DryRunReq) (*app.DryRunReport, error) {
+	if req.ContractName == "" {
+		return nil, errors.Wrap(core.ErrInvalidArg, "contract interface name is not set")
+	}
+	if req.Limit < 1 {
+		return nil, errors.Wrap(core.ErrInvalidArg, "sample limit must be positive")
+	}
+
+	// the description is checked with a local registry, so definitions are not registered globally;
+	// accounts and messages are parsed with the proposed definitions as well
+	reg := abi.NewRegistry()
+	_, interfaces, operations, err := contract.ParseInterfacesDesc(reg, req.Desc)
+	if err != nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, err.Error())
+	}
+
+	var newInterface *core.ContractInterface
+	for _, i := range interfaces {
+		if i.Name == req.ContractName {
+			newInterface = i
+		}
+	}
+	if newInterface == nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "contract interface '%s' is not found in abi description", req.ContractName)
+	}
+
+	report := &app.DryRunReport{
+		ContractName:      req.ContractName,
+		ChangedGetMethods: map[string]int{},
+		Operations:        map[string]*app.DryRunOperationStats{},
+	}
+
+	var oldOps, newOps []*core.ContractOperation
+
+	oldInterface, err := s.ContractRepo.GetInterface(ctx, req.ContractName)
+	switch {
+	case errors.Is(err, core.ErrNotFound):
+		report.NewInterface = true
+	case err != nil:
+		return nil, errors.Wrapf(err, "get '%s' interface", req.ContractName)
+	default:
+		oldOps = oldInterface.Operations
+	}
+	for _, op := range operations {
+		if op.ContractName == req.ContractName {
+			newOps = append(newOps, op)
+		}
+	}
+
+	ds := s.withParser(s.Parser.WithRegistry(reg))
+
+	addresses, err := ds.dryRunAccounts(ctx, req, report, newInterface)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ds.dryRunMessages(ctx, req, report, addresses, oldOps, newOps); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
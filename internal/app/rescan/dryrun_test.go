@@ -0,0 +1,256 @@
+package rescan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xssnick/tonutils-go/tvm/cell"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/app/parser"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+	"github.com/stepandra/anton/internal/core/repository"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+type mockAccountRepo struct {
+	repository.Account
+
+	states []*core.AccountState
+	limit  int
+}
+
+func (r *mockAccountRepo) MatchLatestStatesByInterfaceDesc(_ context.Context, _ abi.ContractName, addresses []*addr.Address, _ []byte, _ []int32, limit int) (ret []*core.AccountStateID, _ error) {
+	r.limit = limit
+
+	latest := map[addr.Address]uint64{}
+	for _, s := range r.states {
+		for _, a := range addresses {
+			if s.Address == *a && s.LastTxLT > latest[s.Address] {
+				latest[s.Address] = s.LastTxLT
+			}
+		}
+	}
+	for a, lt := range latest {
+		ret = append(ret, &core.AccountStateID{Address: a, LastTxLT: lt})
+	}
+	sort.Slice(ret, func(i, j int) bool { return bytes.Compare(ret[i].Address[:], ret[j].Address[:]) < 0 })
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+	return ret, nil
+}
+
+func (r *mockAccountRepo) FilterAccounts(_ context.Context, f *filter.AccountsReq) (*filter.AccountsRes, error) {
+	res := new(filter.AccountsRes)
+	for _, id := range f.StateIDs {
+		for _, s := range r.states {
+			if s.Address == id.Address && s.LastTxLT == id.LastTxLT {
+				res.Rows = append(res.Rows, s)
+			}
+		}
+	}
+	return res, nil
+}
+
+type mockContractRepo struct {
+	core.ContractRepository
+}
+
+func (r *mockContractRepo) GetInterface(context.Context, abi.ContractName) (*core.ContractInterface, error) {
+	return nil, core.ErrNotFound
+}
+
+type mockMessageRepo struct {
+	repository.Message
+
+	messages []*core.Message
+}
+
+func (r *mockMessageRepo) FilterMessages(_ context.Context, f *filter.MessagesReq) (*filter.MessagesRes, error) {
+	res := new(filter.MessagesRes)
+	for _, msg := range r.messages {
+		if f.OperationID != nil && msg.OperationID != *f.OperationID {
+			continue
+		}
+		for _, a := range f.DstAddresses {
+			if msg.DstAddress == *a {
+				res.Rows = append(res.Rows, msg)
+			}
+		}
+	}
+	return res, nil
+}
+
+type mockParser struct {
+	app.ParserService
+}
+
+func (p *mockParser) ParseAccountContractData(_ context.Context, desc *core.ContractInterface, acc *core.AccountState, _ func(context.Context, addr.Address) (*core.AccountState, error)) error {
+	for _, a := range desc.Addresses {
+		if acc.Address == *a {
+			acc.Types = append(acc.Types, desc.Name)
+			return nil
+		}
+	}
+	return app.ErrUnmatchedContractInterface
+}
+
+func (p *mockParser) ParseMessageOperation(msg *core.Message, op *core.ContractOperation) error {
+	msg.OperationName = op.OperationName
+	msg.DataJSON = json.RawMessage(`{"query_id": "1"}`)
+	return nil
+}
+
+func (p *mockParser) WithRegistry(*abi.Registry) app.ParserService {
+	return p
+}
+
+// messageParser matches accounts in the same way as mockParser and parses messages with the given parser.
+type messageParser struct {
+	mockParser
+
+	messages app.ParserService
+}
+
+func (p *messageParser) ParseMessageOperation(msg *core.Message, op *core.ContractOperation) error {
+	return p.messages.ParseMessageOperation(msg, op)
+}
+
+func (p *messageParser) WithRegistry(r *abi.Registry) app.ParserService {
+	return &messageParser{messages: p.messages.WithRegistry(r)}
+}
+
+func TestService_DryRun(t *testing.T) {
+	a1, a2, a3 := rndm.Address(), rndm.Address(), rndm.Address()
+
+	var states []*core.AccountState
+	states = append(states, rndm.AddressStates(a1, 3)...)
+	states = append(states, rndm.AddressStates(a2, 1)...)
+	states = append(states, rndm.AddressStates(a3, 2)...)
+	for _, s := range states {
+		s.Types = nil
+	}
+
+	var messages []*core.Message
+	messages = append(messages, rndm.MessagesTo(a1, 2)...)
+	messages = append(messages, rndm.MessagesTo(a3, 1)...)
+	for _, msg := range messages {
+		msg.OperationID = 1
+	}
+
+	accountRepo := &mockAccountRepo{states: states}
+
+	s := NewService(&app.RescanConfig{
+		ContractRepo: &mockContractRepo{},
+		AccountRepo:  accountRepo,
+		MessageRepo:  &mockMessageRepo{messages: messages},
+		Parser:       &mockParser{},
+		Workers:      2,
+	})
+
+	report, err := s.DryRun(context.Background(), &app.DryRunReq{
+		ContractName: "dry_run_test",
+		Desc: []*abi.InterfaceDesc{{
+			Name:      "dry_run_test",
+			Addresses: []*addr.Address{a1, a2},
+			InMessages: []abi.OperationDesc{{
+				Name: "dry_run_test_op",
+				Code: "0x1",
+				Body: abi.TLBFieldsDesc{{Name: "query_id", Type: "## 64", Format: "uint64"}},
+			}},
+		}},
+		Limit:    10,
+		Examples: 1,
+	})
+	require.Nil(t, err)
+
+	require.Equal(t, 10, accountRepo.limit)
+	require.True(t, report.NewInterface)
+
+	// only the latest state of every account is sampled
+	require.Equal(t, 2, report.SampledAccounts)
+	require.ElementsMatch(t, []*addr.Address{a1, a2}, report.MatchedAccounts)
+	require.Len(t, report.UnmatchedAccounts, 0)
+	require.Len(t, report.AccountDiffs, 1)
+
+	stats := report.Operations["dry_run_test_op"]
+	require.NotNil(t, stats)
+	require.Equal(t, 2, stats.Sampled)
+	require.Equal(t, 2, stats.NewlyParsed)
+	require.Len(t, report.MessageDiffs, 1)
+}
+
+func TestService_DryRun_ChangedDefinition(t *testing.T) {
+	err := abi.RegisterDefinitions(map[abi.TLBType]abi.TLBFieldsDesc{
+		"dry_run_test_params": {{Name: "amount", Type: "## 8", Format: "uint8"}},
+	})
+	require.Nil(t, err)
+
+	a := rndm.Address()
+
+	states := rndm.AddressStates(a, 1)
+	states[0].Types = nil
+
+	msg := rndm.MessageTo(a)
+	msg.Type, msg.OperationID = core.Internal, 2
+	msg.Body = cell.BeginCell().MustStoreUInt(2, 32).MustStoreUInt(0x0102, 16).EndCell().ToBOC()
+	// parsed with the registered definition
+	msg.OperationName, msg.DataJSON = "dry_run_test_op", json.RawMessage(`{"params": {"amount": 1}}`)
+
+	s := NewService(&app.RescanConfig{
+		ContractRepo: &mockContractRepo{},
+		AccountRepo:  &mockAccountRepo{states: states},
+		MessageRepo:  &mockMessageRepo{messages: []*core.Message{msg}},
+		Parser: &messageParser{messages: parser.NewService(&app.ParserConfig{
+			BlockchainConfig:         cell.BeginCell().EndCell(),
+			MaxAccountParsingWorkers: 1,
+		})},
+		Workers: 1,
+	})
+
+	report, err := s.DryRun(context.Background(), &app.DryRunReq{
+		ContractName: "dry_run_test",
+		Desc: []*abi.InterfaceDesc{{
+			Name:      "dry_run_test",
+			Addresses: []*addr.Address{a},
+			Definitions: map[abi.TLBType]abi.TLBFieldsDesc{
+				"dry_run_test_params": {{Name: "amount", Type: "## 16", Format: "uint16"}},
+			},
+			InMessages: []abi.OperationDesc{{
+				Name: "dry_run_test_op",
+				Code: "0x2",
+				Body: abi.TLBFieldsDesc{{Name: "params", Type: ".", Format: "dry_run_test_params"}},
+			}},
+		}},
+		Limit:    10,
+		Examples: 1,
+	})
+	require.Nil(t, err)
+
+	// the message is parsed with the proposed definition
+	stats := report.Operations["dry_run_test_op"]
+	require.NotNil(t, stats)
+	require.Equal(t, 1, stats.Sampled)
+	require.Equal(t, 1, stats.Changed)
+	require.Equal(t, 0, stats.Failed)
+	require.Len(t, report.MessageDiffs, 1)
+	require.JSONEq(t, `{"params": {"amount": 258}}`, string(report.MessageDiffs[0].After.DataJSON))
+
+	// the proposed definition is not registered globally
+	parsed, err := (&abi.OperationDesc{
+		Code: "0x2",
+		Body: abi.TLBFieldsDesc{{Name: "params", Type: ".", Format: "dry_run_test_params"}},
+	}).FromCell(cell.BeginCell().MustStoreUInt(2, 32).MustStoreUInt(1, 8).EndCell())
+	require.Nil(t, err)
+	raw, err := json.Marshal(parsed)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"params": {"amount": 1}}`, string(raw))
+}
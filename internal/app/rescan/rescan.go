@@ -9,6 +9,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
 	"github.com/xssnick/tonutils-go/tvm/cell"
 
 	"github.com/stepandra/anton/abi"
@@ -27,6 +28,10 @@ type Service struct {
 	interfacesCache  *lru.Cache[addr.Address, map[uint64][]abi.ContractName]
 	minterStateCache *mintersCache
 
+	// contracts of the tasks being run, tasks of the same contract are not run in parallel
+	runningTasks   map[abi.ContractName]struct{}
+	runningTasksMx sync.Mutex
+
 	run bool
 	mx  sync.RWMutex
 	wg  sync.WaitGroup
@@ -38,10 +43,15 @@ func NewService(cfg *app.RescanConfig) *Service {
 	s.RescanConfig = cfg
 
 	// validate config
+	if s.Tasks < 1 {
+		s.Tasks = 1
+	}
 	if s.Workers < 1 {
 		s.Workers = 1
 	}
 
+	s.runningTasks = map[abi.ContractName]struct{}{}
+
 	s.interfacesCache = lru.New[addr.Address, map[uint64][]abi.ContractName](16384) // number of addresses
 	s.minterStateCache = newMinterStateCache(2048)                                  // number of addresses
 
@@ -60,10 +70,13 @@ func (s *Service) Start() error {
 	s.run = true
 	s.mx.Unlock()
 
-	s.wg.Add(1)
-	go s.rescanLoop()
+	for i := 0; i < s.Tasks; i++ {
+		s.wg.Add(1)
+		go s.rescanLoop()
+	}
 
 	log.Info().
+		Int("tasks", s.Tasks).
 		Int("workers", s.Workers).
 		Msg("rescan started")
 
@@ -78,11 +91,37 @@ func (s *Service) Stop() {
 	s.wg.Wait()
 }
 
+func (s *Service) takeTask(ctx context.Context) (bun.Tx, *core.RescanTask, error) {
+	s.runningTasksMx.Lock()
+	defer s.runningTasksMx.Unlock()
+
+	exclude := make([]abi.ContractName, 0, len(s.runningTasks))
+	for name := range s.runningTasks {
+		exclude = append(exclude, name)
+	}
+
+	tx, task, err := s.RescanRepo.GetUnfinishedRescanTask(ctx, exclude)
+	if err != nil {
+		return bun.Tx{}, nil, err
+	}
+
+	s.runningTasks[task.ContractName] = struct{}{}
+
+	return tx, task, nil
+}
+
+func (s *Service) releaseTask(task *core.RescanTask) {
+	s.runningTasksMx.Lock()
+	defer s.runningTasksMx.Unlock()
+
+	delete(s.runningTasks, task.ContractName)
+}
+
 func (s *Service) rescanLoop() {
 	defer s.wg.Done()
 
 	for s.running() {
-		tx, task, err := s.RescanRepo.GetUnfinishedRescanTask(context.Background())
+		tx, task, err := s.takeTask(context.Background())
 		if err != nil {
 			if !(errors.Is(err, core.ErrNotFound) && strings.Contains(err.Error(), "no unfinished tasks")) {
 				log.Error().Err(err).Msg("get rescan task")
@@ -91,16 +130,24 @@ func (s *Service) rescanLoop() {
 			continue
 		}
 
-		if err := s.rescanRunTask(context.Background(), task); err != nil {
+		err = s.rescanRunTask(context.Background(), task)
+		if err != nil {
 			_ = tx.Rollback()
+			s.releaseTask(task)
 			log.Error().Err(err).
 				Int("id", task.ID).
 				Msg("run rescan task")
+			if err := s.RescanRepo.SetRescanTaskError(context.Background(), task.ID, err); err != nil {
+				log.Error().Err(err).Int("id", task.ID).Msg("set rescan task error")
+			}
 			time.Sleep(time.Second)
 			continue
 		}
 
-		if err := s.RescanRepo.SetRescanTask(context.Background(), tx, task); err != nil {
+		task.Error = ""
+		err = s.RescanRepo.SetRescanTask(context.Background(), tx, task)
+		s.releaseTask(task)
+		if err != nil {
 			log.Error().Err(err).Msg("update rescan task")
 			time.Sleep(time.Second)
 			continue
@@ -108,6 +155,23 @@ func (s *Service) rescanLoop() {
 	}
 }
 
+// startTask sets the start time and the number of matched rows on the first run of the task.
+func startTask(task *core.RescanTask, count func() (int, error)) error {
+	if !task.StartedAt.IsZero() {
+		return nil
+	}
+
+	total, err := count()
+	if err != nil {
+		return errors.Wrap(err, "count matched rows")
+	}
+
+	task.StartedAt = time.Now()
+	task.Total = total
+
+	return nil
+}
+
 func (s *Service) rescanRunTask(ctx context.Context, task *core.RescanTask) error { //nolint:gocyclo,gocognit // yeah, it's a bit long
 	var codeHash []byte
 	if task.Contract != nil && task.Contract.Code != nil {
@@ -118,9 +182,29 @@ func (s *Service) rescanRunTask(ctx context.Context, task *core.RescanTask) erro
 		codeHash = codeCell.Hash()
 	}
 
+	if task.Scope.HasBlocks() {
+		blocks, err := s.BlockRepo.GetMasterRangeBlocks(ctx, task.Scope.FromMasterSeqNo, task.Scope.ToMasterSeqNo)
+		if err != nil {
+			return errors.Wrap(err, "get blocks of the task scope")
+		}
+		if len(blocks) == 0 {
+			// no blocks are indexed in the given range, so nothing to rescan
+			task.Finished = true
+			return nil
+		}
+		task.Scope.Blocks = blocks
+	}
+
 	switch task.Type {
 	case core.AddInterface:
-		ids, err := s.AccountRepo.MatchStatesByInterfaceDesc(ctx, "", task.Contract.Addresses, codeHash, task.Contract.GetMethodHashes, task.LastAddress, task.LastTxLt, s.SelectLimit)
+		err := startTask(task, func() (int, error) {
+			return s.AccountRepo.CountStatesByInterfaceDesc(ctx, "", task.Contract.Addresses, codeHash, task.Contract.GetMethodHashes, &task.Scope)
+		})
+		if err != nil {
+			return err
+		}
+
+		ids, err := s.AccountRepo.MatchStatesByInterfaceDesc(ctx, "", task.Contract.Addresses, codeHash, task.Contract.GetMethodHashes, &task.Scope, task.LastAddress, task.LastTxLt, s.SelectLimit)
 		if err != nil {
 			return errors.Wrapf(err, "match states by interface description")
 		}
@@ -136,7 +220,14 @@ func (s *Service) rescanRunTask(ctx context.Context, task *core.RescanTask) erro
 		return nil
 
 	case core.DelInterface:
-		ids, err := s.AccountRepo.MatchStatesByInterfaceDesc(ctx, task.ContractName, nil, nil, nil, task.LastAddress, task.LastTxLt, s.SelectLimit)
+		err := startTask(task, func() (int, error) {
+			return s.AccountRepo.CountStatesByInterfaceDesc(ctx, task.ContractName, nil, nil, nil, &task.Scope)
+		})
+		if err != nil {
+			return err
+		}
+
+		ids, err := s.AccountRepo.MatchStatesByInterfaceDesc(ctx, task.ContractName, nil, nil, nil, &task.Scope, task.LastAddress, task.LastTxLt, s.SelectLimit)
 		if err != nil {
 			return errors.Wrapf(err, "match states by interface description")
 		}
@@ -151,8 +242,15 @@ func (s *Service) rescanRunTask(ctx context.Context, task *core.RescanTask) erro
 
 		return nil
 
-	case core.UpdInterface, core.AddGetMethod, core.DelGetMethod, core.UpdGetMethod:
-		ids, err := s.AccountRepo.MatchStatesByInterfaceDesc(ctx, task.ContractName, task.Contract.Addresses, codeHash, task.Contract.GetMethodHashes, task.LastAddress, task.LastTxLt, s.SelectLimit)
+	case core.UpdInterface, core.AddGetMethod, core.DelGetMethod, core.UpdGetMethod, core.UpdContractData:
+		err := startTask(task, func() (int, error) {
+			return s.AccountRepo.CountStatesByInterfaceDesc(ctx, task.ContractName, task.Contract.Addresses, codeHash, task.Contract.GetMethodHashes, &task.Scope)
+		})
+		if err != nil {
+			return err
+		}
+
+		ids, err := s.AccountRepo.MatchStatesByInterfaceDesc(ctx, task.ContractName, task.Contract.Addresses, codeHash, task.Contract.GetMethodHashes, &task.Scope, task.LastAddress, task.LastTxLt, s.SelectLimit)
 		if err != nil {
 			return errors.Wrapf(err, "match states by interface description")
 		}
@@ -168,7 +266,14 @@ func (s *Service) rescanRunTask(ctx context.Context, task *core.RescanTask) erro
 		return nil
 
 	case core.DelOperation, core.UpdOperation:
-		hashes, err := s.MessageRepo.MatchMessagesByOperationDesc(ctx, task.ContractName, task.MessageType, task.Outgoing, task.OperationID, task.LastAddress, task.LastTxLt, s.SelectLimit)
+		err := startTask(task, func() (int, error) {
+			return s.MessageRepo.CountMessagesByOperationDesc(ctx, task.ContractName, task.MessageType, task.Outgoing, task.OperationID, &task.Scope)
+		})
+		if err != nil {
+			return err
+		}
+
+		hashes, err := s.MessageRepo.MatchMessagesByOperationDesc(ctx, task.ContractName, task.MessageType, task.Outgoing, task.OperationID, &task.Scope, task.LastAddress, task.LastTxLt, s.SelectLimit)
 		if err != nil {
 			return errors.Wrapf(err, "get addresses by contract name")
 		}
@@ -208,6 +313,7 @@ func (s *Service) rescanAccounts(ctx context.Context, task *core.RescanTask, ids
 
 	task.LastAddress = &lastScanned.Address
 	task.LastTxLt = lastScanned.LastTxLT
+	task.Scanned += len(ids)
 
 	return nil
 }
@@ -237,6 +343,7 @@ func (s *Service) rescanMessages(ctx context.Context, task *core.RescanTask, has
 
 	task.LastAddress = &lastScanned.Address
 	task.LastTxLt = lastScanned.LastTxLT
+	task.Scanned += len(hashes)
 
 	return nil
 }
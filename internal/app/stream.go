@@ -0,0 +1,25 @@
+package app
+
+import (
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+type StreamConfig struct {
+	// SubscriberBuffer is the number of events queued for a subscriber.
+	// Subscribers, which do not keep up with new data, are disconnected.
+	SubscriberBuffer int
+}
+
+type StreamService interface {
+	// Publish delivers committed transactions and messages to subscribers.
+	Publish(tx []*core.Transaction, msg []*core.Message)
+
+	// SubscribeTransactions returns a channel of new transactions matching the given filter.
+	// The channel is closed after cancel is called or if the subscriber is too slow.
+	SubscribeTransactions(req *filter.TransactionsReq) (events <-chan *core.Transaction, cancel func())
+
+	// SubscribeMessages returns a channel of new messages matching the given filter.
+	// The channel is closed after cancel is called or if the subscriber is too slow.
+	SubscribeMessages(req *filter.MessagesReq) (events <-chan *core.Message, cancel func())
+}
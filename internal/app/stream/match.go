@@ -0,0 +1,76 @@
+package stream
+
+import (
+	"bytes"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+func matchAddress(a *addr.Address, addresses []*addr.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, x := range addresses {
+		if addr.Equal(a, x) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchString(s string, values []string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTransaction applies the same conditions as transaction repository filter does.
+func matchTransaction(req *filter.TransactionsReq, tx *core.Transaction) bool {
+	if len(req.Hash) > 0 && !bytes.Equal(req.Hash, tx.Hash) {
+		return false
+	}
+	if len(req.InMsgHash) > 0 && !bytes.Equal(req.InMsgHash, tx.InMsgHash) {
+		return false
+	}
+	if !matchAddress(&tx.Address, req.Addresses) {
+		return false
+	}
+	if req.Workchain != nil && *req.Workchain != tx.Workchain {
+		return false
+	}
+	return true
+}
+
+// matchMessage applies the same conditions as message repository filter does.
+func matchMessage(req *filter.MessagesReq, msg *core.Message) bool {
+	if len(req.Hash) > 0 && !bytes.Equal(req.Hash, msg.Hash) {
+		return false
+	}
+	if !matchAddress(&msg.SrcAddress, req.SrcAddresses) || !matchAddress(&msg.DstAddress, req.DstAddresses) {
+		return false
+	}
+	if req.SrcWorkchain != nil && *req.SrcWorkchain != msg.SrcWorkchain {
+		return false
+	}
+	if req.DstWorkchain != nil && *req.DstWorkchain != msg.DstWorkchain {
+		return false
+	}
+	if req.OperationID != nil && *req.OperationID != msg.OperationID {
+		return false
+	}
+	if !matchString(string(msg.SrcContract), req.SrcContracts) || !matchString(string(msg.DstContract), req.DstContracts) {
+		return false
+	}
+	if !matchString(msg.OperationName, req.OperationNames) {
+		return false
+	}
+	return true
+}
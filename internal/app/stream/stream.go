@@ -0,0 +1,131 @@
+package stream
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+var _ app.StreamService = (*Service)(nil)
+
+type subscriber[T any] struct {
+	match  func(T) bool
+	events chan T
+}
+
+// send returns false if the subscriber queue is full.
+func (sub *subscriber[T]) send(events []T) bool {
+	for _, e := range events {
+		if !sub.match(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+type Service struct {
+	*app.StreamConfig
+
+	mx      sync.RWMutex
+	lastID  uint64
+	txSubs  map[uint64]*subscriber[*core.Transaction]
+	msgSubs map[uint64]*subscriber[*core.Message]
+}
+
+func NewService(cfg *app.StreamConfig) *Service {
+	var s = new(Service)
+
+	s.StreamConfig = cfg
+
+	// validate config
+	if s.SubscriberBuffer < 1 {
+		s.SubscriberBuffer = 1024
+	}
+
+	s.txSubs = make(map[uint64]*subscriber[*core.Transaction])
+	s.msgSubs = make(map[uint64]*subscriber[*core.Message])
+
+	return s
+}
+
+func subscribe[T any](s *Service, subs map[uint64]*subscriber[T], match func(T) bool) (<-chan T, func()) {
+	sub := &subscriber[T]{match: match, events: make(chan T, s.SubscriberBuffer)}
+
+	s.mx.Lock()
+	s.lastID++
+	id := s.lastID
+	subs[id] = sub
+	s.mx.Unlock()
+
+	cancel := func() {
+		s.mx.Lock()
+		defer s.mx.Unlock()
+
+		unsubscribe(subs, []uint64{id})
+	}
+
+	return sub.events, cancel
+}
+
+func unsubscribe[T any](subs map[uint64]*subscriber[T], ids []uint64) {
+	for _, id := range ids {
+		sub, ok := subs[id]
+		if !ok {
+			continue
+		}
+		delete(subs, id)
+		close(sub.events)
+	}
+}
+
+func publish[T any](s *Service, subs map[uint64]*subscriber[T], events []T) (slow []uint64) {
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mx.RLock()
+	for id, sub := range subs {
+		if !sub.send(events) {
+			slow = append(slow, id)
+		}
+	}
+	s.mx.RUnlock()
+
+	if len(slow) > 0 {
+		s.mx.Lock()
+		unsubscribe(subs, slow)
+		s.mx.Unlock()
+	}
+
+	return slow
+}
+
+func (s *Service) Publish(tx []*core.Transaction, msg []*core.Message) {
+	if slow := publish(s, s.txSubs, tx); len(slow) > 0 {
+		log.Warn().Int("count", len(slow)).Msg("disconnected slow transaction subscribers")
+	}
+	if slow := publish(s, s.msgSubs, msg); len(slow) > 0 {
+		log.Warn().Int("count", len(slow)).Msg("disconnected slow message subscribers")
+	}
+}
+
+func (s *Service) SubscribeTransactions(req *filter.TransactionsReq) (<-chan *core.Transaction, func()) {
+	return subscribe(s, s.txSubs, func(tx *core.Transaction) bool {
+		return matchTransaction(req, tx)
+	})
+}
+
+func (s *Service) SubscribeMessages(req *filter.MessagesReq) (<-chan *core.Message, func()) {
+	return subscribe(s, s.msgSubs, func(msg *core.Message) bool {
+		return matchMessage(req, msg)
+	})
+}
@@ -0,0 +1,81 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/abi/known"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func TestService_SubscribeTransactions(t *testing.T) {
+	s := NewService(&app.StreamConfig{})
+
+	a := rndm.Address()
+	workchain := int32(0)
+
+	events, cancel := s.SubscribeTransactions(&filter.TransactionsReq{
+		Addresses: []*addr.Address{a},
+		Workchain: &workchain,
+	})
+
+	matched := &core.Transaction{Address: *a, Workchain: 0, CreatedLT: 1}
+	s.Publish([]*core.Transaction{
+		{Address: *rndm.Address(), Workchain: 0},
+		{Address: *a, Workchain: -1},
+		matched,
+	}, nil)
+
+	require.Equal(t, 1, len(events))
+	require.Equal(t, matched, <-events)
+
+	cancel()
+	cancel()
+
+	_, ok := <-events
+	require.False(t, ok)
+
+	// publishing after unsubscribe must not panic
+	s.Publish([]*core.Transaction{matched}, nil)
+}
+
+func TestService_SubscribeMessages(t *testing.T) {
+	s := NewService(&app.StreamConfig{})
+
+	events, cancel := s.SubscribeMessages(&filter.MessagesReq{
+		DstContracts:   []string{string(known.JettonWallet)},
+		OperationNames: []string{"jetton_transfer"},
+	})
+	defer cancel()
+
+	matched := &core.Message{DstContract: known.JettonWallet, OperationName: "jetton_transfer"}
+	s.Publish(nil, []*core.Message{
+		{DstContract: known.JettonWallet, OperationName: "jetton_burn"},
+		{DstContract: known.JettonMinter, OperationName: "jetton_transfer"},
+		matched,
+	})
+
+	require.Equal(t, 1, len(events))
+	require.Equal(t, matched, <-events)
+}
+
+func TestService_SlowSubscriber(t *testing.T) {
+	s := NewService(&app.StreamConfig{SubscriberBuffer: 2})
+
+	events, cancel := s.SubscribeMessages(&filter.MessagesReq{})
+	defer cancel()
+
+	s.Publish(nil, []*core.Message{{}, {}, {}})
+
+	require.Equal(t, 2, len(events))
+	<-events
+	<-events
+
+	_, ok := <-events
+	require.False(t, ok)
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+type WebhookConfig struct {
+	WebhookRepo core.WebhookRepository
+
+	Client *http.Client
+
+	// Workers is the number of concurrent deliveries.
+	Workers int
+	// QueueSize is the number of batches waiting for delivery.
+	// If the queue is full, payloads go directly to the dead-letter table.
+	QueueSize int
+
+	// MaxRetries is the number of delivery attempts after the first failed one.
+	MaxRetries int
+	// Backoff is the delay before the first retry, it doubles on every next attempt.
+	Backoff time.Duration
+	// MaxBackoff limits the delay between retries.
+	MaxBackoff time.Duration
+
+	// ReloadInterval is how often registered webhooks are fetched from the database.
+	ReloadInterval time.Duration
+}
+
+type WebhookService interface {
+	Start() error
+	Stop()
+
+	// Publish sends committed transactions and messages to the matching webhooks.
+	// It does not wait for the delivery.
+	Publish(tx []*core.Transaction, msg []*core.Message)
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func matchEvent(w *core.Webhook, e core.WebhookEvent) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, x := range w.Events {
+		if x == e {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAddress(w *core.Webhook, addresses ...*addr.Address) bool {
+	if len(w.Addresses) == 0 {
+		return true
+	}
+	for _, a := range addresses {
+		for _, x := range w.Addresses {
+			if addr.Equal(a, x) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchContract(w *core.Webhook, c abi.ContractName) bool {
+	if len(w.DstContracts) == 0 {
+		return true
+	}
+	for _, x := range w.DstContracts {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
+
+func matchOperation(w *core.Webhook, op string) bool {
+	if len(w.OperationNames) == 0 {
+		return true
+	}
+	for _, x := range w.OperationNames {
+		if x == op {
+			return true
+		}
+	}
+	return false
+}
+
+func matchMessage(w *core.Webhook, msg *core.Message) bool {
+	return matchEvent(w, core.WebhookMessage) &&
+		matchAddress(w, &msg.SrcAddress, &msg.DstAddress) &&
+		matchContract(w, msg.DstContract) &&
+		matchOperation(w, msg.OperationName)
+}
+
+// matchTransaction checks transaction against the webhook filter.
+// Contract and operation filters are defined only for messages,
+// so webhooks having them do not receive transactions.
+func matchTransaction(w *core.Webhook, tx *core.Transaction) bool {
+	if len(w.DstContracts) > 0 || len(w.OperationNames) > 0 {
+		return false
+	}
+	return matchEvent(w, core.WebhookTransaction) &&
+		matchAddress(w, &tx.Address)
+}
@@ -0,0 +1,287 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ app.WebhookService = (*Service)(nil)
+
+const SignatureHeader = "X-Anton-Signature"
+
+// Payload is the body of a webhook request.
+type Payload struct {
+	WebhookID    int                 `json:"webhook_id"`
+	Transactions []*core.Transaction `json:"transactions,omitempty"`
+	Messages     []*core.Message     `json:"messages,omitempty"`
+}
+
+type delivery struct {
+	webhook *core.Webhook
+	body    []byte
+}
+
+type Service struct {
+	*app.WebhookConfig
+
+	webhooks []*core.Webhook
+	mx       sync.RWMutex
+
+	queue chan *delivery
+
+	// ctx is canceled on Stop to abort requests in flight
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+func NewService(cfg *app.WebhookConfig) *Service {
+	var s = new(Service)
+
+	s.WebhookConfig = cfg
+
+	// validate config
+	if s.Client == nil {
+		s.Client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if s.Workers < 1 {
+		s.Workers = 4
+	}
+	if s.QueueSize < 1 {
+		s.QueueSize = 1024
+	}
+	if s.MaxRetries < 0 {
+		s.MaxRetries = 0
+	}
+	if s.Backoff <= 0 {
+		s.Backoff = time.Second
+	}
+	if s.MaxBackoff < s.Backoff {
+		s.MaxBackoff = time.Minute
+	}
+	if s.ReloadInterval <= 0 {
+		s.ReloadInterval = 10 * time.Second
+	}
+
+	s.queue = make(chan *delivery, s.QueueSize)
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.done = make(chan struct{})
+
+	return s
+}
+
+// Sign returns signature of the request body, which is sent in X-Anton-Signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *Service) Start() error {
+	if err := s.reloadWebhooks(context.Background()); err != nil {
+		return err
+	}
+
+	s.wg.Add(1)
+	go s.reloadLoop()
+
+	for i := 0; i < s.Workers; i++ {
+		s.wg.Add(1)
+		go s.deliveryLoop()
+	}
+
+	log.Info().
+		Int("workers", s.Workers).
+		Int("max_retries", s.MaxRetries).
+		Msg("webhooks started")
+
+	return nil
+}
+
+// Stop aborts deliveries in flight and saves them together with
+// the queued ones as dead letters, so no payload is lost on shutdown.
+func (s *Service) Stop() {
+	close(s.done)
+	s.cancel()
+	s.wg.Wait()
+
+	for {
+		select {
+		case d := <-s.queue:
+			s.addDeadLetter(d.webhook, d.body, 0, errors.New("service stopped"))
+		default:
+			return
+		}
+	}
+}
+
+func (s *Service) reloadWebhooks(ctx context.Context) error {
+	webhooks, err := s.WebhookRepo.GetWebhooks(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get webhooks")
+	}
+
+	s.mx.Lock()
+	s.webhooks = webhooks
+	s.mx.Unlock()
+
+	return nil
+}
+
+func (s *Service) reloadLoop() {
+	defer s.wg.Done()
+
+	t := time.NewTicker(s.ReloadInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-t.C:
+			if err := s.reloadWebhooks(context.Background()); err != nil {
+				log.Error().Err(err).Msg("reload webhooks")
+			}
+		}
+	}
+}
+
+func (s *Service) Publish(tx []*core.Transaction, msg []*core.Message) {
+	s.mx.RLock()
+	webhooks := s.webhooks
+	s.mx.RUnlock()
+
+	for _, w := range webhooks {
+		p := Payload{WebhookID: w.ID}
+		for _, t := range tx {
+			if matchTransaction(w, t) {
+				p.Transactions = append(p.Transactions, t)
+			}
+		}
+		for _, m := range msg {
+			if matchMessage(w, m) {
+				p.Messages = append(p.Messages, m)
+			}
+		}
+		if len(p.Transactions) == 0 && len(p.Messages) == 0 {
+			continue
+		}
+
+		body, err := json.Marshal(&p)
+		if err != nil {
+			log.Error().Err(err).Int("webhook_id", w.ID).Msg("marshal webhook payload")
+			continue
+		}
+
+		select {
+		case s.queue <- &delivery{webhook: w, body: body}:
+		default:
+			s.addDeadLetter(w, body, 0, errors.New("delivery queue is full"))
+		}
+	}
+}
+
+func (s *Service) deliveryLoop() {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case d := <-s.queue:
+			s.deliver(d)
+		}
+	}
+}
+
+func (s *Service) post(w *core.Webhook, body []byte) error {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *Service) deliver(d *delivery) {
+	var (
+		err     error
+		backoff = s.Backoff
+	)
+
+	for attempt := 1; ; attempt++ {
+		if err = s.post(d.webhook, d.body); err == nil {
+			return
+		}
+
+		if attempt > s.MaxRetries {
+			s.addDeadLetter(d.webhook, d.body, attempt, err)
+			return
+		}
+
+		log.Warn().Err(err).
+			Int("webhook_id", d.webhook.ID).
+			Int("attempt", attempt).
+			Dur("backoff", backoff).
+			Msg("webhook delivery failed")
+
+		select {
+		case <-s.done:
+			s.addDeadLetter(d.webhook, d.body, attempt, errors.Wrap(err, "service stopped"))
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > s.MaxBackoff {
+			backoff = s.MaxBackoff
+		}
+	}
+}
+
+func (s *Service) addDeadLetter(w *core.Webhook, body []byte, attempts int, cause error) {
+	log.Error().Err(cause).
+		Int("webhook_id", w.ID).
+		Int("attempts", attempts).
+		Msg("webhook delivery failed, saving dead letter")
+
+	err := s.WebhookRepo.AddDeadLetter(context.Background(), &core.WebhookDeadLetter{
+		WebhookID: w.ID,
+		Payload:   body,
+		Attempts:  attempts,
+		Error:     cause.Error(),
+	})
+	if err != nil {
+		log.Error().Err(err).Int("webhook_id", w.ID).Msg("add webhook dead letter")
+	}
+}
@@ -0,0 +1,232 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/abi/known"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/app"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+type mockRepo struct {
+	mx          sync.Mutex
+	webhooks    []*core.Webhook
+	deadLetters []*core.WebhookDeadLetter
+}
+
+var _ core.WebhookRepository = (*mockRepo)(nil)
+
+func (m *mockRepo) AddWebhook(_ context.Context, w *core.Webhook) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	w.ID = len(m.webhooks) + 1
+	m.webhooks = append(m.webhooks, w)
+	return nil
+}
+
+func (m *mockRepo) GetWebhooks(context.Context) ([]*core.Webhook, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return m.webhooks, nil
+}
+
+func (m *mockRepo) DeleteWebhook(context.Context, int) error {
+	return nil
+}
+
+func (m *mockRepo) AddDeadLetter(_ context.Context, l *core.WebhookDeadLetter) error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.deadLetters = append(m.deadLetters, l)
+	return nil
+}
+
+func (m *mockRepo) GetDeadLetters(context.Context, int, int) ([]*core.WebhookDeadLetter, error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return m.deadLetters, nil
+}
+
+func (m *mockRepo) getDeadLetters() []*core.WebhookDeadLetter {
+	ret, _ := m.GetDeadLetters(context.Background(), 0, 0)
+	return ret
+}
+
+func newTestService(t *testing.T, url string, retries int) (*Service, *mockRepo) {
+	repo := new(mockRepo)
+	_ = repo.AddWebhook(context.Background(), &core.Webhook{
+		URL:            url,
+		Secret:         "secret",
+		DstContracts:   []abi.ContractName{known.JettonWallet},
+		OperationNames: []string{"jetton_transfer"},
+	})
+
+	s := NewService(&app.WebhookConfig{
+		WebhookRepo: repo,
+		MaxRetries:  retries,
+		Backoff:     time.Millisecond,
+	})
+	require.Nil(t, s.Start())
+	t.Cleanup(s.Stop)
+
+	return s, repo
+}
+
+func TestService_Publish(t *testing.T) {
+	requests := make(chan *Payload, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.Nil(t, err)
+		require.Equal(t, Sign("secret", body), r.Header.Get(SignatureHeader))
+
+		var p Payload
+		require.Nil(t, json.Unmarshal(body, &p))
+		requests <- &p
+	}))
+	defer srv.Close()
+
+	s, repo := newTestService(t, srv.URL, 0)
+
+	matched := &core.Message{
+		Hash:          rndm.Bytes(32),
+		DstAddress:    *rndm.Address(),
+		DstContract:   known.JettonWallet,
+		OperationName: "jetton_transfer",
+	}
+	s.Publish([]*core.Transaction{{Address: *rndm.Address()}}, []*core.Message{
+		{DstContract: known.JettonWallet, OperationName: "jetton_burn"},
+		matched,
+	})
+
+	select {
+	case p := <-requests:
+		require.Equal(t, 1, p.WebhookID)
+		require.Equal(t, 0, len(p.Transactions))
+		require.Equal(t, 1, len(p.Messages))
+		require.Equal(t, matched.Hash, p.Messages[0].Hash)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+
+	// nothing matches, so nothing is sent
+	s.Publish(nil, []*core.Message{{DstContract: known.JettonMinter}})
+
+	select {
+	case <-requests:
+		t.Fatal("unexpected webhook delivery")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	require.Equal(t, 0, len(repo.getDeadLetters()))
+}
+
+func TestService_Publish_Retry(t *testing.T) {
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	s, repo := newTestService(t, srv.URL, 2)
+
+	s.Publish(nil, []*core.Message{{DstContract: known.JettonWallet, OperationName: "jetton_transfer"}})
+
+	require.Eventually(t, func() bool { return calls.Load() == 3 }, 5*time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, int32(3), calls.Load())
+	require.Equal(t, 0, len(repo.getDeadLetters()))
+}
+
+func TestService_Publish_DeadLetter(t *testing.T) {
+	var calls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	s, repo := newTestService(t, srv.URL, 2)
+
+	s.Publish(nil, []*core.Message{{DstContract: known.JettonWallet, OperationName: "jetton_transfer"}})
+
+	require.Eventually(t, func() bool { return len(repo.getDeadLetters()) == 1 }, 5*time.Second, 5*time.Millisecond)
+	require.Equal(t, int32(3), calls.Load())
+
+	l := repo.getDeadLetters()[0]
+	require.Equal(t, 1, l.WebhookID)
+	require.Equal(t, 3, l.Attempts)
+	require.Contains(t, l.Error, "500")
+
+	var p Payload
+	require.Nil(t, json.Unmarshal(l.Payload, &p))
+	require.Equal(t, 1, len(p.Messages))
+}
+
+func TestService_Stop(t *testing.T) {
+	started, release := make(chan struct{}, 1), make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release // hang until the end of the test
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	repo := new(mockRepo)
+	_ = repo.AddWebhook(context.Background(), &core.Webhook{URL: srv.URL, Secret: "secret"})
+
+	s := NewService(&app.WebhookConfig{WebhookRepo: repo, Workers: 1, Backoff: time.Millisecond})
+	require.Nil(t, s.Start())
+
+	for i := 0; i < 3; i++ {
+		s.Publish(nil, []*core.Message{{DstAddress: *rndm.Address()}})
+	}
+
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+
+	s.Stop()
+
+	// one delivery in flight is aborted, and two queued deliveries are saved
+	require.Equal(t, 3, len(repo.getDeadLetters()))
+}
+
+func TestMatchTransaction(t *testing.T) {
+	a := rndm.Address()
+
+	w := &core.Webhook{Addresses: []*addr.Address{a}, Events: []core.WebhookEvent{core.WebhookTransaction}}
+	require.True(t, matchTransaction(w, &core.Transaction{Address: *a}))
+	require.False(t, matchTransaction(w, &core.Transaction{Address: *rndm.Address()}))
+	require.False(t, matchMessage(w, &core.Message{SrcAddress: *a}))
+
+	w.Events = nil
+	require.True(t, matchMessage(w, &core.Message{SrcAddress: *a}))
+	require.True(t, matchMessage(w, &core.Message{DstAddress: *a}))
+
+	w.OperationNames = []string{"jetton_transfer"}
+	require.False(t, matchTransaction(w, &core.Transaction{Address: *a}))
+}
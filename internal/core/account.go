@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/uptrace/bun"
@@ -38,8 +39,14 @@ type AddressLabel struct {
 	Categories []LabelCategory `ch:",lc" bun:"type:label_category[]" json:"categories,omitempty"`
 }
 
-type FTWalletData struct {
-	JettonBalance *bunbig.Int `ch:"type:UInt256" bun:"type:numeric" json:"jetton_balance,omitempty" swaggertype:"string"`
+type NFTContentData struct {
+	ContentURI         string `ch:"type:String" bun:",nullzero" json:"content_uri,omitempty"`
+	ContentName        string `ch:"type:String" bun:",nullzero" json:"content_name,omitempty"`
+	ContentDescription string `ch:"type:String" bun:",nullzero" json:"content_description,omitempty"`
+	ContentImage       string `ch:"type:String" bun:",nullzero" json:"content_image,omitempty"`
+	ContentImageData   []byte `ch:"type:String" bun:",nullzero" json:"content_image_data,omitempty"`
+	ContentSymbol      string `ch:"type:String" bun:",nullzero" json:"content_symbol,omitempty"`
+	ContentDecimals    *uint8 `ch:"type:Nullable(UInt8)" bun:"type:smallint" json:"content_decimals,omitempty"`
 }
 
 type AccountStateID struct {
@@ -88,12 +95,26 @@ type AccountState struct {
 	OwnerAddress  *addr.Address `ch:"type:String" bun:"type:bytea" json:"owner_address,omitempty"` // universal column for many contracts
 	MinterAddress *addr.Address `ch:"type:String" bun:"type:bytea" json:"minter_address,omitempty"`
 
+	TokenBalance *bunbig.Int `ch:"jetton_balance,type:UInt256" bun:"jetton_balance,type:numeric" json:"jetton_balance,omitempty" swaggertype:"string"` // fungible token balance, such as jetton wallet balance
+	TokenSupply  *bunbig.Int `ch:"type:UInt256" bun:"type:numeric" json:"token_supply,omitempty" swaggertype:"string"`                                 // fungible token total supply, such as jetton minter supply
+
+	// liquidity pool assets and reserves
+	Asset0Address *addr.Address `ch:"type:String" bun:"type:bytea" json:"asset0_address,omitempty"`
+	Asset1Address *addr.Address `ch:"type:String" bun:"type:bytea" json:"asset1_address,omitempty"`
+	Reserve0      *bunbig.Int   `ch:"type:UInt256" bun:"type:numeric" json:"reserve0,omitempty" swaggertype:"string"`
+	Reserve1      *bunbig.Int   `ch:"type:UInt256" bun:"type:numeric" json:"reserve1,omitempty" swaggertype:"string"`
+
 	Fake bool `ch:"type:Bool" bun:"type:boolean" json:"fake"`
 
 	ExecutedGetMethods map[abi.ContractName][]abi.GetMethodExecution `ch:"type:String" bun:"type:jsonb" json:"executed_get_methods,omitempty"`
 
+	// ContractData is the persistent data of the account decoded with the contract_data schemas of matched interfaces.
+	ContractData map[abi.ContractName]json.RawMessage `ch:"type:String" bun:"type:jsonb" json:"contract_data,omitempty" swaggertype:"object"`
+
 	// TODO: remove this
-	FTWalletData
+	NFTContentData
+
+	TokenMetadata *TokenMetadata `ch:"-" bun:"-" json:"token_metadata,omitempty"` // off-chain metadata fetched from content_uri
 
 	UpdatedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"updated_at"`
 }
@@ -187,15 +208,36 @@ type AccountRepository interface {
 	AddAccountStates(ctx context.Context, tx bun.Tx, states []*AccountState) error
 	UpdateAccountStates(ctx context.Context, states []*AccountState) error
 
+	// DeleteAccountStates removes account states from the given blocks
+	// and moves the latest account states to the previous ones.
+	DeleteAccountStates(ctx context.Context, tx bun.Tx, blocks []BlockID) error
+
 	// MatchStatesByInterfaceDesc returns (address, last_tx_lt) pairs for suitable account states.
+	// If the scope is given, only account states within it are returned.
 	MatchStatesByInterfaceDesc(ctx context.Context,
 		contractName abi.ContractName,
 		addresses []*addr.Address,
 		codeHash []byte,
 		getMethodHashes []int32,
+		scope *RescanScope,
 		afterAddress *addr.Address,
 		afterTxLt uint64,
 		limit int) ([]*AccountStateID, error)
+	// MatchLatestStatesByInterfaceDesc returns the latest suitable account state of every matched account,
+	// so the sample of the given limit consists of different accounts.
+	MatchLatestStatesByInterfaceDesc(ctx context.Context,
+		contractName abi.ContractName,
+		addresses []*addr.Address,
+		codeHash []byte,
+		getMethodHashes []int32,
+		limit int) ([]*AccountStateID, error)
+	// CountStatesByInterfaceDesc returns the number of account states matched by MatchStatesByInterfaceDesc.
+	CountStatesByInterfaceDesc(ctx context.Context,
+		contractName abi.ContractName,
+		addresses []*addr.Address,
+		codeHash []byte,
+		getMethodHashes []int32,
+		scope *RescanScope) (int, error)
 
 	// GetAllAccountInterfaces returns transaction LT, on which contract interface was updated.
 	// It also considers, that contract can be both upgraded and downgraded.
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/xssnick/tonutils-go/address"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+)
+
+func columnAddress(v any) (*addr.Address, error) {
+	switch a := v.(type) {
+	case *address.Address:
+		if a == nil {
+			return nil, nil
+		}
+		return addr.MustFromTonutils(a), nil
+	case *addr.Address:
+		return a, nil
+	case *abi.DedustAsset:
+		if a == nil {
+			return nil, nil
+		}
+		switch asset := a.Asset.(type) {
+		case *abi.DedustAssetNative:
+			return nil, nil
+		case *abi.DedustAssetJetton:
+			return addr.MustFromTonutils(address.NewAddress(0, byte(asset.Workchain), asset.Address)), nil
+		default:
+			return nil, fmt.Errorf("unsupported dedust asset %T", a.Asset)
+		}
+	default:
+		return nil, fmt.Errorf("expected address, got %T", v)
+	}
+}
+
+// columnBigInt converts integer into the value of numeric column,
+// all of them are stored as UInt256 in ClickHouse, so negative integers are rejected.
+func columnBigInt(v any) (*bunbig.Int, error) {
+	if b, ok := v.(*big.Int); ok {
+		if b == nil {
+			return nil, nil
+		}
+		if b.Sign() < 0 {
+			return nil, fmt.Errorf("negative integer %s", b)
+		}
+		return bunbig.FromMathBig(b), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return nil, fmt.Errorf("negative integer %d", rv.Int())
+		}
+		return bunbig.FromInt64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return bunbig.FromMathBig(new(big.Int).SetUint64(rv.Uint())), nil
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", v)
+	}
+}
+
+// SetColumn fills the universal indexed account state column with the get-method return value.
+func (a *AccountState) SetColumn(c abi.AccountColumn, v any) (err error) {
+	switch c {
+	case abi.ColumnOwnerAddress:
+		a.OwnerAddress, err = columnAddress(v)
+	case abi.ColumnMinterAddress:
+		a.MinterAddress, err = columnAddress(v)
+	case abi.ColumnTokenBalance:
+		a.TokenBalance, err = columnBigInt(v)
+	case abi.ColumnTokenSupply:
+		a.TokenSupply, err = columnBigInt(v)
+	case abi.ColumnAsset0Address:
+		a.Asset0Address, err = columnAddress(v)
+	case abi.ColumnAsset1Address:
+		a.Asset1Address, err = columnAddress(v)
+	case abi.ColumnReserve0:
+		a.Reserve0, err = columnBigInt(v)
+	case abi.ColumnReserve1:
+		a.Reserve1, err = columnBigInt(v)
+	case abi.ColumnContent:
+		content, ok := v.(*abi.TokenData)
+		if !ok {
+			return fmt.Errorf("expected token data, got %T", v)
+		}
+		if content == nil {
+			return nil
+		}
+		a.ContentURI = content.URI
+		a.ContentName = content.Name
+		a.ContentDescription = content.Description
+		a.ContentImage = content.Image
+		a.ContentImageData = content.ImageData
+		a.ContentSymbol = content.Symbol
+		a.ContentDecimals = content.Decimals
+	default:
+		return fmt.Errorf("unknown '%s' account column", c)
+	}
+	if err != nil {
+		return fmt.Errorf("%s column: %w", c, err)
+	}
+	return nil
+}
+
+// ClearColumn resets the universal indexed account state column.
+func (a *AccountState) ClearColumn(c abi.AccountColumn) {
+	switch c {
+	case abi.ColumnOwnerAddress:
+		a.OwnerAddress = nil
+	case abi.ColumnMinterAddress:
+		a.MinterAddress = nil
+	case abi.ColumnTokenBalance:
+		a.TokenBalance = nil
+	case abi.ColumnTokenSupply:
+		a.TokenSupply = nil
+	case abi.ColumnAsset0Address:
+		a.Asset0Address = nil
+	case abi.ColumnAsset1Address:
+		a.Asset1Address = nil
+	case abi.ColumnReserve0:
+		a.Reserve0 = nil
+	case abi.ColumnReserve1:
+		a.Reserve1 = nil
+	case abi.ColumnContent:
+		a.NFTContentData = NFTContentData{}
+	}
+}
@@ -0,0 +1,54 @@
+package core_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/internal/core"
+)
+
+func TestAccountState_SetColumn_BigInt(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		value any
+		want  string
+		err   bool
+	}{
+		{name: "big int", value: big.NewInt(1000), want: "1000"},
+		{name: "uint64", value: uint64(42), want: "42"},
+		{name: "int8", value: int8(7), want: "7"},
+		{name: "negative big int", value: big.NewInt(-1), err: true},
+		{name: "negative int64", value: int64(-5), err: true},
+		{name: "not an integer", value: "1", err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, c := range []abi.AccountColumn{abi.ColumnTokenBalance, abi.ColumnTokenSupply, abi.ColumnReserve0, abi.ColumnReserve1} {
+				var acc core.AccountState
+
+				err := acc.SetColumn(c, tc.value)
+				if tc.err {
+					require.NotNil(t, err, c)
+					continue
+				}
+				require.Nil(t, err, c)
+
+				got := map[abi.AccountColumn]*bunbig.Int{
+					abi.ColumnTokenBalance: acc.TokenBalance,
+					abi.ColumnTokenSupply:  acc.TokenSupply,
+					abi.ColumnReserve0:     acc.Reserve0,
+					abi.ColumnReserve1:     acc.Reserve1,
+				}[c]
+				require.Equal(t, tc.want, got.String(), c)
+			}
+		})
+	}
+
+	var acc core.AccountState
+	err := acc.SetColumn(abi.ColumnTokenBalance, big.NewInt(-1))
+	require.ErrorContains(t, err, "token_balance column: negative integer -1")
+	require.Nil(t, acc.TokenBalance)
+}
@@ -11,6 +11,8 @@ type AccountMetric string
 
 const (
 	ActiveAddresses AccountMetric = "active_addresses"
+	JettonBalance   AccountMetric = "jetton_balance" // balance of the owner's jetton wallets
+	HolderCount     AccountMetric = "holder_count"   // number of jetton wallets with non-zero balance
 )
 
 type AccountsReq struct {
@@ -18,12 +20,14 @@ type AccountsReq struct {
 
 	ContractTypes []abi.ContractName `form:"interface"`
 	MinterAddress *addr.Address      // NFT or FT minter
+	OwnerAddress  *addr.Address      // jetton wallet owner
 
 	ReqParams
 }
 
 type AccountsRes struct {
-	CountRes `json:"count_results,omitempty"`
+	CountRes  `json:"count_results,omitempty"`
+	BigIntRes `json:"sum_results,omitempty" swaggertype:"object"`
 }
 
 type AccountRepository interface {
@@ -0,0 +1,42 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/addr"
+)
+
+type PoolReq struct {
+	PoolAddress addr.Address // path parameter
+
+	ReqParams
+}
+
+// PoolState is the latest state of the liquidity pool in the interval.
+type PoolState struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	Reserve0 *bunbig.Int `ch:"type:UInt256" json:"reserve0" swaggertype:"string"`
+	Reserve1 *bunbig.Int `ch:"type:UInt256" json:"reserve1" swaggertype:"string"`
+	LPSupply *bunbig.Int `ch:"type:UInt256" json:"lp_supply" swaggertype:"string"`
+
+	TVL *bunbig.Int `ch:"-" json:"tvl,omitempty" swaggertype:"string"` // in nanoTON
+}
+
+type PoolRes struct {
+	// Asset0 and Asset1 are jetton minters of the pool assets, empty for TON.
+	Asset0 *addr.Address `json:"asset0,omitempty"`
+	Asset1 *addr.Address `json:"asset1,omitempty"`
+
+	// PricingPool is TON-paired pool used to price the pool asset, if none of the pool assets is TON.
+	PricingPool *addr.Address `json:"pricing_pool,omitempty"`
+
+	States []*PoolState `json:"results"`
+}
+
+type PoolRepository interface {
+	AggregatePoolHistory(ctx context.Context, req *PoolReq) (*PoolRes, error)
+}
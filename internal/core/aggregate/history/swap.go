@@ -0,0 +1,40 @@
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/addr"
+)
+
+type SwapCandlesReq struct {
+	PoolAddress addr.Address // path parameter
+
+	ReqParams
+}
+
+// SwapCandle is OHLCV candle of pool swaps.
+// Prices are in the same units as core.Swap price, volumes are summed for both assets of the pair.
+type SwapCandle struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	Open  float64 `json:"open"`
+	High  float64 `json:"high"`
+	Low   float64 `json:"low"`
+	Close float64 `json:"close"`
+
+	Volume0 *bunbig.Int `ch:"type:UInt256" json:"volume0" swaggertype:"string"`
+	Volume1 *bunbig.Int `ch:"type:UInt256" json:"volume1" swaggertype:"string"`
+
+	SwapCount int `json:"swap_count"`
+}
+
+type SwapCandlesRes struct {
+	Candles []*SwapCandle `json:"candles"`
+}
+
+type SwapRepository interface {
+	AggregateSwapCandles(ctx context.Context, req *SwapCandlesReq) (*SwapCandlesRes, error)
+}
@@ -0,0 +1,36 @@
+package history
+
+import (
+	"context"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+)
+
+type JettonTransferMetric string
+
+var (
+	JettonTransferCount     JettonTransferMetric = "transfer_count"
+	JettonTransferAmountSum JettonTransferMetric = "transfer_amount_sum"
+)
+
+type JettonTransfersReq struct {
+	Metric JettonTransferMetric `form:"metric"`
+
+	MinterAddress *addr.Address   // `form:"minter_address"`
+	SrcOwners     []*addr.Address // `form:"src_owner"`
+	DstOwners     []*addr.Address // `form:"dst_owner"`
+
+	Status core.JettonTransferStatus `form:"status"`
+
+	ReqParams
+}
+
+type JettonTransfersRes struct {
+	CountRes  `json:"count_results,omitempty" swaggertype:"object"`
+	BigIntRes `json:"sum_results,omitempty" swaggertype:"object"`
+}
+
+type JettonTransferRepository interface {
+	AggregateJettonTransfersHistory(ctx context.Context, req *JettonTransfersReq) (*JettonTransfersRes, error)
+}
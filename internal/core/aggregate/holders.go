@@ -0,0 +1,38 @@
+package aggregate
+
+import (
+	"context"
+
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/addr"
+)
+
+type HoldersReq struct {
+	MinterAddress *addr.Address `json:"minter_address"`
+
+	// MasterSeqNo is the masterchain block, at which holders are taken.
+	// If it is zero, the latest holders are returned.
+	MasterSeqNo uint32 `form:"master_seq_no" json:"master_seq_no"`
+
+	Offset int `form:"offset" json:"offset"`
+	Limit  int `form:"limit" json:"limit"`
+}
+
+type Holder struct {
+	OwnerAddress  addr.Address `ch:"type:String" json:"owner_address" swaggertype:"string"`
+	WalletAddress addr.Address `ch:"type:String" json:"wallet_address" swaggertype:"string"`
+	Balance       *bunbig.Int  `ch:"type:UInt256" json:"balance" swaggertype:"string"`
+}
+
+type HoldersRes struct {
+	MasterSeqNo uint32      `json:"master_seq_no,omitempty"`
+	Total       int         `json:"total"`
+	TotalSupply *bunbig.Int `json:"total_supply" swaggertype:"string"`
+	Holders     []*Holder   `json:"holders"`
+}
+
+type HolderRepository interface {
+	// AggregateHolders returns jetton wallets with non-zero balance ordered by balance.
+	AggregateHolders(ctx context.Context, req *HoldersReq) (*HoldersRes, error)
+}
@@ -23,6 +23,14 @@ func GetBlockID(b *ton.BlockIDExt) BlockID {
 	}
 }
 
+// BlockRange is the range of block sequence numbers in the shard.
+type BlockRange struct {
+	Workchain int32  `json:"workchain"`
+	Shard     int64  `json:"shard"`
+	FromSeqNo uint32 `json:"from_seq_no"`
+	ToSeqNo   uint32 `json:"to_seq_no"`
+}
+
 type Block struct {
 	ch.CHModel    `ch:"block_info" json:"-"`
 	bun.BaseModel `bun:"table:block_info" json:"-"`
@@ -41,6 +49,11 @@ type Block struct {
 	Transactions      []*Transaction  `ch:"-" bun:"rel:has-many,join:workchain=workchain,join:shard=shard,join:seq_no=block_seq_no" json:"transactions,omitempty"`
 	Accounts          []*AccountState `ch:"-" bun:"rel:has-many,join:workchain=workchain,join:shard=shard,join:seq_no=block_seq_no" json:"accounts,omitempty"`
 
+	// hashes of the previous masterchain block referenced by this one,
+	// they are used only to check chain consistency before saving
+	PrevFileHash []byte `ch:"-" bun:"-" json:"-"`
+	PrevRootHash []byte `ch:"-" bun:"-" json:"-"`
+
 	// TODO: block info data
 
 	ScannedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"scanned_at"`
@@ -59,4 +72,14 @@ type BlockRepository interface {
 	GetLastMasterBlock(ctx context.Context) (*Block, error)
 	CountMasterBlocks(ctx context.Context) (int, error)
 	GetMissedMasterBlocks(ctx context.Context) ([]uint32, error)
+	// GetSavedMasterBlocks returns seq_no of saved masterchain blocks in the given range.
+	GetSavedMasterBlocks(ctx context.Context, from, to uint32) ([]uint32, error)
+
+	// GetBlocksAfterMaster returns identifiers of masterchain blocks with seq_no greater than the given one
+	// and identifiers of shard blocks committed in them.
+	GetBlocksAfterMaster(ctx context.Context, masterSeqNo uint32) ([]BlockID, error)
+	// GetMasterRangeBlocks returns ranges of masterchain blocks in the given seq_no range
+	// and of shard blocks committed in them, one range per shard. Zero to means no upper bound.
+	GetMasterRangeBlocks(ctx context.Context, from, to uint32) ([]*BlockRange, error)
+	DeleteBlocks(ctx context.Context, tx bun.Tx, ids []BlockID) error
 }
@@ -19,13 +19,15 @@ type ContractDefinition struct {
 type ContractInterface struct {
 	bun.BaseModel `bun:"table:contract_interfaces" json:"-"`
 
-	Name            abi.ContractName     `bun:",pk" json:"name"`
-	Addresses       []*addr.Address      `bun:"type:bytea[],unique" json:"addresses,omitempty"`
-	Code            []byte               `bun:"type:bytea,unique" json:"code,omitempty"`
-	CodeHash        []byte               `bun:"-" json:"code_hash,omitempty"`
-	GetMethodsDesc  []abi.GetMethodDesc  `bun:"type:text" json:"get_methods_descriptors,omitempty"`
-	GetMethodHashes []int32              `bun:"type:integer[]" json:"get_method_hashes,omitempty"`
-	Operations      []*ContractOperation `ch:"-" bun:"rel:has-many,join:name=contract_name" json:"operations,omitempty"`
+	Name            abi.ContractName      `bun:",pk" json:"name"`
+	Addresses       []*addr.Address       `bun:"type:bytea[],unique" json:"addresses,omitempty"`
+	Code            []byte                `bun:"type:bytea,unique" json:"code,omitempty"`
+	CodeHash        []byte                `bun:"-" json:"code_hash,omitempty"`
+	GetMethodsDesc  []abi.GetMethodDesc   `bun:"type:text" json:"get_methods_descriptors,omitempty"`
+	GetMethodHashes []int32               `bun:"type:integer[]" json:"get_method_hashes,omitempty"`
+	ContractData    abi.TLBFieldsDesc     `bun:"type:jsonb" json:"contract_data,omitempty"`
+	Verification    *abi.VerificationDesc `bun:"type:jsonb" json:"verification,omitempty"`
+	Operations      []*ContractOperation  `ch:"-" bun:"rel:has-many,join:name=contract_name" json:"operations,omitempty"`
 }
 
 type ContractOperation struct {
@@ -40,21 +42,21 @@ type ContractOperation struct {
 }
 
 type ContractRepository interface {
-	AddDefinition(context.Context, abi.TLBType, abi.TLBFieldsDesc) error
-	UpdateDefinition(ctx context.Context, dn abi.TLBType, d abi.TLBFieldsDesc) error
-	DeleteDefinition(ctx context.Context, dn abi.TLBType) error
+	AddDefinition(context.Context, bun.Tx, abi.TLBType, abi.TLBFieldsDesc) error
+	UpdateDefinition(ctx context.Context, tx bun.Tx, dn abi.TLBType, d abi.TLBFieldsDesc) error
+	DeleteDefinition(ctx context.Context, tx bun.Tx, dn abi.TLBType) error
 	GetDefinitions(context.Context) (map[abi.TLBType]abi.TLBFieldsDesc, error)
 
-	AddInterface(context.Context, *ContractInterface) error
-	UpdateInterface(ctx context.Context, i *ContractInterface) error
-	DeleteInterface(ctx context.Context, name abi.ContractName) error
+	AddInterface(context.Context, bun.Tx, *ContractInterface) error
+	UpdateInterface(ctx context.Context, tx bun.Tx, i *ContractInterface) error
+	DeleteInterface(ctx context.Context, tx bun.Tx, name abi.ContractName) error
 	GetInterface(ctx context.Context, name abi.ContractName) (*ContractInterface, error)
 	GetInterfaces(context.Context) ([]*ContractInterface, error)
 	GetMethodDescription(ctx context.Context, name abi.ContractName, method string) (abi.GetMethodDesc, error)
 
-	AddOperation(context.Context, *ContractOperation) error
-	UpdateOperation(ctx context.Context, op *ContractOperation) error
-	DeleteOperation(ctx context.Context, opName string) error
+	AddOperation(context.Context, bun.Tx, *ContractOperation) error
+	UpdateOperation(ctx context.Context, tx bun.Tx, op *ContractOperation) error
+	DeleteOperation(ctx context.Context, tx bun.Tx, opName string) error
 	GetOperations(context.Context) ([]*ContractOperation, error)
 	GetOperationsByID(ctx context.Context, t MessageType, interfaces []abi.ContractName, outgoing bool, id uint32) ([]*ContractOperation, error)
 }
@@ -38,6 +38,7 @@ type AccountsReq struct {
 	ContractTypes []abi.ContractName `form:"interface"`
 	OwnerAddress  *addr.Address      // `form:"owner_address"`
 	MinterAddress *addr.Address      // `form:"minter_address"`
+	ContentSymbol string             `form:"content_symbol"`
 
 	ExcludeColumn []string // TODO: support relations
 
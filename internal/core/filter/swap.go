@@ -0,0 +1,33 @@
+package filter
+
+import (
+	"context"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+)
+
+type SwapsReq struct {
+	TxHash  []byte // `form:"tx_hash"`
+	TraceID []byte // `form:"trace_id"`
+
+	DEX           core.DEX        `form:"dex"`
+	PoolAddresses []*addr.Address // `form:"pool_address"`
+	Traders       []*addr.Address // `form:"trader"`
+	Assets        []*addr.Address // `form:"asset"`, either sold or bought
+
+	Order string `form:"order"` // ASC, DESC
+
+	AfterTxLT *uint64 `form:"after"`
+	Limit     int     `form:"limit"`
+	Count     bool    `form:"count"`
+}
+
+type SwapsRes struct {
+	Total int          `json:"total,omitempty"`
+	Rows  []*core.Swap `json:"results"`
+}
+
+type SwapRepository interface {
+	FilterSwaps(context.Context, *SwapsReq) (*SwapsRes, error)
+}
@@ -0,0 +1,36 @@
+package filter
+
+import (
+	"context"
+
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+)
+
+type JettonTransfersReq struct {
+	MsgHash []byte // `form:"msg_hash"`
+	TraceID []byte // `form:"trace_id"`
+
+	MinterAddress *addr.Address   // `form:"minter_address"`
+	SrcOwners     []*addr.Address // `form:"src_owner"`
+	DstOwners     []*addr.Address // `form:"dst_owner"`
+	Owners        []*addr.Address // `form:"owner"`, either sender or receiver
+	QueryID       *uint64         `form:"query_id"`
+
+	Status core.JettonTransferStatus `form:"status"`
+
+	Order string `form:"order"` // ASC, DESC
+
+	AfterTxLT *uint64 `form:"after"`
+	Limit     int     `form:"limit"`
+	Count     bool    `form:"count"`
+}
+
+type JettonTransfersRes struct {
+	Total int                    `json:"total,omitempty"`
+	Rows  []*core.JettonTransfer `json:"results"`
+}
+
+type JettonTransferRepository interface {
+	FilterJettonTransfers(context.Context, *JettonTransfersReq) (*JettonTransfersRes, error)
+}
@@ -10,6 +10,7 @@ import (
 type TransactionsReq struct {
 	Hash      []byte // `form:"hash"`
 	InMsgHash []byte // `form:"in_msg_hash"`
+	TraceID   []byte // `form:"trace_id"`
 
 	Addresses []*addr.Address //
 
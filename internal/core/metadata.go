@@ -0,0 +1,63 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// TokenMetadata is an off-chain token metadata document, which is referenced by TEP-64 content URI.
+// Every version of the document is kept, as it is identified by URI and content hash.
+type TokenMetadata struct {
+	bun.BaseModel `bun:"table:token_metadata" json:"-"`
+
+	URI         string          `bun:",pk,notnull" json:"uri"`
+	ContentHash []byte          `bun:"type:bytea,pk,notnull" json:"content_hash"` // sha256 of the document
+	Document    json.RawMessage `bun:"type:jsonb,notnull" json:"document" swaggertype:"object"`
+
+	// fields parsed from the document
+	Name        string `bun:",nullzero" json:"name,omitempty"`
+	Description string `bun:",nullzero" json:"description,omitempty"`
+	Image       string `bun:",nullzero" json:"image,omitempty"`
+	Symbol      string `bun:",nullzero" json:"symbol,omitempty"`
+	Decimals    *uint8 `bun:"type:smallint" json:"decimals,omitempty"` // nil if the document has no decimals
+
+	FetchedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"fetched_at"`
+}
+
+// TokenMetadataFetch is the fetching state of content URI.
+type TokenMetadataFetch struct {
+	bun.BaseModel `bun:"table:token_metadata_fetches" json:"-"`
+
+	URI string `bun:",pk,notnull" json:"uri"`
+
+	// the latest successfully fetched document
+	ContentHash []byte         `bun:"type:bytea" json:"content_hash,omitempty"`
+	Metadata    *TokenMetadata `bun:"rel:has-one,join:uri=uri,join:content_hash=content_hash" json:"metadata,omitempty"`
+
+	// Attempts is the number of failed fetches in a row.
+	Attempts int    `bun:",notnull" json:"attempts"`
+	Error    string `bun:",nullzero" json:"error,omitempty"`
+
+	FetchedAt   time.Time `bun:"type:timestamp without time zone,nullzero" json:"fetched_at,omitempty"`
+	NextFetchAt time.Time `bun:"type:timestamp without time zone,notnull" json:"next_fetch_at"`
+}
+
+type MetadataRepository interface {
+	// GetNewURIs returns content URIs of the latest account states, which were never scheduled for fetching.
+	GetNewURIs(ctx context.Context, limit int) ([]string, error)
+	// AddURIs schedules fetching of the given URIs. Already scheduled URIs are skipped.
+	AddURIs(ctx context.Context, uris []string) error
+	// GetURIsToFetch returns URIs, which next fetch time has come.
+	GetURIsToFetch(ctx context.Context, limit int) ([]*TokenMetadataFetch, error)
+	// SaveFetch updates fetching state and stores the document if it was fetched.
+	SaveFetch(ctx context.Context, f *TokenMetadataFetch, m *TokenMetadata) error
+
+	// GetMetadata returns the document by URI and content hash.
+	// If the content hash is empty, the latest fetched document is returned.
+	GetMetadata(ctx context.Context, uri string, contentHash []byte) (*TokenMetadata, error)
+	// GetLatestMetadata returns the latest fetched documents mapped by URI.
+	GetLatestMetadata(ctx context.Context, uris []string) (map[string]*TokenMetadata, error)
+}
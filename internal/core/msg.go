@@ -30,6 +30,8 @@ type Message struct {
 
 	Hash []byte `ch:",pk" bun:"type:bytea,pk,notnull"  json:"hash"`
 
+	TraceID []byte `bun:"type:bytea" json:"trace_id,omitempty"`
+
 	// TODO: migrate src/dst blocks to nullable fields
 	// TODO: null addresses in clickhouse
 
@@ -81,15 +83,28 @@ type MessageRepository interface {
 	AddMessages(ctx context.Context, tx bun.Tx, messages []*Message) error
 	UpdateMessages(ctx context.Context, messages []*Message) error
 
+	// DeleteMessages removes messages created in the given blocks
+	// and clears destination transaction of messages received in them.
+	DeleteMessages(ctx context.Context, tx bun.Tx, blocks []BlockID) error
+
 	GetMessages(ctx context.Context, hash [][]byte) ([]*Message, error)
 
 	// MatchMessagesByOperationDesc returns hashes of suitable messages for the given contract operation.
+	// If the scope is given, only messages within it are returned.
 	MatchMessagesByOperationDesc(ctx context.Context,
 		contractName abi.ContractName,
 		msgType MessageType,
 		outgoing bool,
 		operationId uint32,
+		scope *RescanScope,
 		afterAddress *addr.Address,
 		afterTxLT uint64,
 		limit int) ([][]byte, error)
+	// CountMessagesByOperationDesc returns the number of messages matched by MatchMessagesByOperationDesc.
+	CountMessagesByOperationDesc(ctx context.Context,
+		contractName abi.ContractName,
+		msgType MessageType,
+		outgoing bool,
+		operationId uint32,
+		scope *RescanScope) (int, error)
 }
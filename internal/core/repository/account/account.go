@@ -55,6 +55,26 @@ func createIndexes(ctx context.Context, pgDB *bun.DB) error {
 		return errors.Wrap(err, "address state minter pg create index")
 	}
 
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.AccountState{}).
+		Using("HASH").
+		Column("asset0_address").
+		Where("asset0_address IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "address state pool asset0 pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.AccountState{}).
+		Using("HASH").
+		Column("asset1_address").
+		Where("asset1_address IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "address state pool asset1 pg create index")
+	}
+
 	_, err = pgDB.NewCreateIndex().
 		Model(&core.AccountState{}).
 		Using("GIN").
@@ -277,6 +297,55 @@ func (r *Repository) AddAccountStates(ctx context.Context, tx bun.Tx, accounts [
 	return nil
 }
 
+func (r *Repository) DeleteAccountStates(ctx context.Context, tx bun.Tx, blocks []core.BlockID) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	in := repository.BlockTuples(blocks)
+
+	var latest []*core.LatestAccountState
+	err := tx.NewRaw(`
+DELETE FROM latest_account_states AS l
+USING account_states AS a
+WHERE l.address = a.address AND l.last_tx_lt = a.last_tx_lt AND (a.workchain, a.shard, a.block_seq_no) IN (?)
+RETURNING l.address, l.last_tx_lt`, bun.Safe(in)).
+		Scan(ctx, &latest)
+	if err != nil {
+		return errors.Wrap(err, "delete pg latest account states")
+	}
+
+	_, err = tx.NewDelete().Model((*core.AccountState)(nil)).
+		Where("(workchain, shard, block_seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete pg account states")
+	}
+
+	if len(latest) > 0 {
+		var addresses []*addr.Address
+		for _, l := range latest {
+			addresses = append(addresses, &l.Address)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+INSERT INTO latest_account_states (address, last_tx_lt)
+SELECT address, max(last_tx_lt) FROM account_states
+WHERE address IN (?)
+GROUP BY address`, bun.In(addresses))
+		if err != nil {
+			return errors.Wrap(err, "restore pg latest account states")
+		}
+	}
+
+	_, err = r.ch.ExecContext(ctx, "ALTER TABLE account_states DELETE WHERE (workchain, shard, block_seq_no) IN (?)", ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "delete ch account states")
+	}
+
+	return nil
+}
+
 func logAccountStateDataUpdate(acc *core.AccountState) {
 	types, _ := json.Marshal(acc.Types)                   //nolint:errchkjson // no need
 	getMethods, _ := json.Marshal(acc.ExecutedGetMethods) //nolint:errchkjson // no need
@@ -307,12 +376,20 @@ func (r *Repository) UpdateAccountStates(ctx context.Context, accounts []*core.A
 			Set("minter_address = ?minter_address").
 			Set("fake = ?fake").
 			Set("executed_get_methods = ?executed_get_methods").
+			Set("contract_data = ?contract_data").
 			Set("content_uri = ?content_uri").
 			Set("content_name = ?content_name").
 			Set("content_description = ?content_description").
 			Set("content_image = ?content_image").
 			Set("content_image_data = ?content_image_data").
+			Set("content_symbol = ?content_symbol").
+			Set("content_decimals = ?content_decimals").
 			Set("jetton_balance = ?jetton_balance").
+			Set("token_supply = ?token_supply").
+			Set("asset0_address = ?asset0_address").
+			Set("asset1_address = ?asset1_address").
+			Set("reserve0 = ?reserve0").
+			Set("reserve1 = ?reserve1").
 			WherePK().
 			Exec(ctx)
 		if err != nil {
@@ -328,19 +405,14 @@ func (r *Repository) UpdateAccountStates(ctx context.Context, accounts []*core.A
 	return nil
 }
 
-func (r *Repository) MatchStatesByInterfaceDesc(ctx context.Context,
+func (r *Repository) matchStatesQuery(
 	contractName abi.ContractName,
 	addresses []*addr.Address,
 	codeHash []byte,
 	getMethodHashes []int32,
-	afterAddress *addr.Address,
-	afterTxLt uint64,
-	limit int,
-) ([]*core.AccountStateID, error) {
-	var ids []*core.AccountStateID
-
+	scope *core.RescanScope,
+) *ch.SelectQuery {
 	q := r.ch.NewSelect().Model((*core.AccountState)(nil)).
-		ColumnExpr("DISTINCT address, last_tx_lt").
 		WhereGroup(" AND ", func(q *ch.SelectQuery) *ch.SelectQuery {
 			if contractName != "" {
 				q = q.WhereOr("hasAny(types, [?])", string(contractName))
@@ -357,6 +429,23 @@ func (r *Repository) MatchStatesByInterfaceDesc(ctx context.Context,
 			}
 			return q
 		})
+	return repository.WhereRescanScope(q, scope, "", "updated_at")
+}
+
+func (r *Repository) MatchStatesByInterfaceDesc(ctx context.Context,
+	contractName abi.ContractName,
+	addresses []*addr.Address,
+	codeHash []byte,
+	getMethodHashes []int32,
+	scope *core.RescanScope,
+	afterAddress *addr.Address,
+	afterTxLt uint64,
+	limit int,
+) ([]*core.AccountStateID, error) {
+	var ids []*core.AccountStateID
+
+	q := r.matchStatesQuery(contractName, addresses, codeHash, getMethodHashes, scope).
+		ColumnExpr("DISTINCT address, last_tx_lt")
 	if afterAddress != nil && afterTxLt != 0 {
 		q = q.Where("(address, last_tx_lt) > (?, ?)", afterAddress, afterTxLt)
 	}
@@ -371,6 +460,47 @@ func (r *Repository) MatchStatesByInterfaceDesc(ctx context.Context,
 	return ids, nil
 }
 
+func (r *Repository) MatchLatestStatesByInterfaceDesc(ctx context.Context,
+	contractName abi.ContractName,
+	addresses []*addr.Address,
+	codeHash []byte,
+	getMethodHashes []int32,
+	limit int,
+) ([]*core.AccountStateID, error) {
+	var ids []*core.AccountStateID
+
+	err := r.matchStatesQuery(contractName, addresses, codeHash, getMethodHashes, nil).
+		ColumnExpr("address, max(last_tx_lt) AS last_tx_lt").
+		Group("address").
+		OrderExpr("address ASC").
+		Limit(limit).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) CountStatesByInterfaceDesc(ctx context.Context,
+	contractName abi.ContractName,
+	addresses []*addr.Address,
+	codeHash []byte,
+	getMethodHashes []int32,
+	scope *core.RescanScope,
+) (int, error) {
+	var count int
+
+	err := r.matchStatesQuery(contractName, addresses, codeHash, getMethodHashes, scope).
+		ColumnExpr("count(DISTINCT address, last_tx_lt)").
+		Scan(ctx, &count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (r *Repository) GetAllAccountInterfaces(ctx context.Context, a addr.Address) (map[uint64][]abi.ContractName, error) {
 	var ret []struct {
 		ChangeTxLT  int64
@@ -43,6 +43,10 @@ func (r *Repository) aggregateAddressStatistics(ctx context.Context, req *aggreg
 	for _, x := range countByInterfaces {
 		for _, t := range x.Types {
 			switch t {
+			case known.NFTItem:
+				res.OwnedNFTItems += x.Count
+			case known.NFTCollection:
+				res.OwnedNFTCollections += x.Count
 			case known.JettonWallet:
 				res.OwnedJettonWallets += x.Count
 			}
@@ -160,6 +164,10 @@ func (r *Repository) aggregateMinterStatistics(ctx context.Context, req *aggrega
 
 	for _, t := range interfaces {
 		switch t {
+		case known.NFTCollection:
+			if err := r.aggregateNFTMinter(ctx, req, res); err != nil {
+				return err
+			}
 		case known.JettonMinter:
 			if err := r.aggregateFTMinter(ctx, req, res); err != nil {
 				return err
@@ -112,8 +112,8 @@ func TestRepository_AggregateAccounts_JettonMinter(t *testing.T) {
 			walletsStates = append(walletsStates, walletStates...)
 
 			walletLatestData := walletsStates[len(walletsStates)-1]
-			totalSupply = totalSupply.Add(walletLatestData.JettonBalance)
-			ownedBalance[*walletLatestData.OwnerAddress] = walletLatestData.JettonBalance
+			totalSupply = totalSupply.Add(walletLatestData.TokenBalance)
+			ownedBalance[*walletLatestData.OwnerAddress] = walletLatestData.TokenBalance
 		}
 
 		err = repo.AddAccountStates(ctx, tx, append(walletsStates, minterStates...))
@@ -130,10 +130,76 @@ func TestRepository_AggregateAccounts_JettonMinter(t *testing.T) {
 		})
 		require.Nil(t, err)
 		require.Equal(t, walletsCount, res.Wallets)
-		require.Equal(t, totalSupply, res.TotalSupply)
+		require.NotNil(t, res.TotalSupply)
+		require.Equal(t, totalSupply.String(), *res.TotalSupply)
 		require.Equal(t, walletsCount, len(res.OwnedBalance))
 		for _, b := range res.OwnedBalance {
-			require.Equal(t, ownedBalance[*b.OwnerAddress], b.Balance)
+			require.NotNil(t, b.Balance)
+			require.Equal(t, ownedBalance[b.OwnerAddress].String(), *b.Balance)
+		}
+	})
+
+	t.Run("drop tables again", func(t *testing.T) {
+		dropTables(t)
+	})
+}
+
+func TestRepository_AggregateHolders(t *testing.T) {
+	var (
+		walletsCount = 10
+
+		totalSupply = new(bunbig.Int)
+		balances    = make(map[addr.Address]*bunbig.Int)
+
+		minter = rndm.Address()
+	)
+
+	initdb(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	t.Run("drop tables", func(t *testing.T) {
+		dropTables(t)
+	})
+
+	t.Run("create tables", func(t *testing.T) {
+		createTables(t)
+	})
+
+	t.Run("insert test jetton data", func(t *testing.T) {
+		var states []*core.AccountState
+
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		for i := 0; i < walletsCount; i++ {
+			walletStates := rndm.AccountStatesContract(5, known.JettonWallet, minter)
+			states = append(states, walletStates...)
+
+			latest := walletStates[len(walletStates)-1]
+			totalSupply = totalSupply.Add(latest.TokenBalance)
+			balances[latest.Address] = latest.TokenBalance
+		}
+
+		err = repo.AddAccountStates(ctx, tx, states)
+		require.Nil(t, err)
+
+		err = tx.Commit()
+		require.Nil(t, err)
+	})
+
+	t.Run("aggregate latest holders", func(t *testing.T) {
+		res, err := repo.AggregateHolders(ctx, &aggregate.HoldersReq{
+			MinterAddress: minter,
+			Limit:         3,
+		})
+		require.Nil(t, err)
+		require.Equal(t, walletsCount, res.Total)
+		require.Equal(t, totalSupply.String(), res.TotalSupply.String())
+		require.Equal(t, 3, len(res.Holders))
+		for _, h := range res.Holders {
+			require.Equal(t, balances[h.WalletAddress].String(), h.Balance.String())
 		}
 	})
 
@@ -137,6 +137,9 @@ func (r *Repository) filterAccountStates(ctx context.Context, f *filter.Accounts
 	if f.MinterAddress != nil {
 		q = q.Where(prefix+"minter_address = ?", f.MinterAddress)
 	}
+	if f.ContentSymbol != "" {
+		q = q.Where(prefix+"content_symbol = ?", f.ContentSymbol)
+	}
 
 	if f.AfterTxLT != nil {
 		if f.Order == "ASC" {
@@ -200,6 +203,9 @@ func (r *Repository) countAccountStates(ctx context.Context, f *filter.AccountsR
 	if f.MinterAddress != nil {
 		q = q.Where("minter_address = ?", f.MinterAddress)
 	}
+	if f.ContentSymbol != "" {
+		q = q.Where("content_symbol = ?", f.ContentSymbol)
+	}
 
 	if f.LatestState {
 		q = q.ColumnExpr("argMax(address, last_tx_lt)")
@@ -23,9 +23,102 @@ func getContractTypes(types []abi.ContractName) (ret []abi.ContractName) {
 	return
 }
 
+// makeWalletBalanceQuery selects the latest balance of jetton wallets at the end of each interval,
+// in which the balance was changed.
+func (r *Repository) makeWalletBalanceQuery(req *history.AccountsReq, rounding string) *ch.SelectQuery {
+	q := r.ch.NewSelect().Model((*core.AccountState)(nil)).
+		ColumnExpr("address").
+		ColumnExpr(fmt.Sprintf(rounding, "updated_at")+" AS timestamp").
+		ColumnExpr("argMax(jetton_balance, last_tx_lt) AS balance").
+		Where("minter_address = ?", req.MinterAddress).
+		Where("fake = false").
+		Group("address", "timestamp")
+
+	if req.OwnerAddress != nil {
+		q = q.Where("owner_address = ?", req.OwnerAddress)
+	}
+	if !req.To.IsZero() {
+		q = q.Where("updated_at < ?", req.To)
+	}
+
+	return q
+}
+
+// aggregateJettonHistory accumulates changes of wallet balances from the first account state,
+// so every point contains the value at the end of the interval.
+func (r *Repository) aggregateJettonHistory(ctx context.Context, req *history.AccountsReq) (*history.AccountsRes, error) {
+	const window = "OVER (PARTITION BY address ORDER BY timestamp ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)"
+
+	var (
+		res                  history.AccountsRes
+		deltaExpr, valueExpr string
+	)
+
+	if req.MinterAddress == nil {
+		return nil, errors.Wrapf(core.ErrInvalidArg, "minter address must be set for %s metric", req.Metric)
+	}
+
+	rounding, err := history.GetRoundingFunction(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Metric {
+	case history.JettonBalance:
+		if req.OwnerAddress == nil {
+			return nil, errors.Wrapf(core.ErrInvalidArg, "owner address must be set for %s metric", req.Metric)
+		}
+		deltaExpr = "toInt256(balance) - toInt256(lagInFrame(balance, 1, toUInt256(0)) " + window + ")"
+		valueExpr = "toUInt256(sum(delta) OVER (ORDER BY timestamp))"
+	case history.HolderCount:
+		deltaExpr = "toInt64(balance > 0) - toInt64(lagInFrame(balance, 1, toUInt256(0)) " + window + " > 0)"
+		valueExpr = "toInt64(sum(delta) OVER (ORDER BY timestamp))"
+	}
+
+	deltas := r.ch.NewSelect().
+		ColumnExpr("timestamp").
+		ColumnExpr(deltaExpr+" AS delta").
+		TableExpr("(?) AS b", r.makeWalletBalanceQuery(req, rounding))
+
+	values := r.ch.NewSelect().
+		ColumnExpr("timestamp").
+		ColumnExpr(valueExpr+" AS value").
+		TableExpr("(?) AS d",
+			r.ch.NewSelect().
+				ColumnExpr("timestamp").
+				ColumnExpr("sum(delta) AS delta").
+				TableExpr("(?) AS q", deltas).
+				Group("timestamp"))
+
+	q := r.ch.NewSelect().
+		ColumnExpr("timestamp").
+		ColumnExpr("value").
+		TableExpr("(?) AS v", values)
+	if !req.From.IsZero() {
+		q = q.Where("timestamp >= "+fmt.Sprintf(rounding, "toDateTime(?)"), req.From)
+	}
+	q = q.Order("timestamp ASC")
+
+	if req.Metric == history.JettonBalance {
+		err = q.Scan(ctx, &res.BigIntRes)
+	} else {
+		err = q.Scan(ctx, &res.CountRes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 func (r *Repository) AggregateAccountsHistory(ctx context.Context, req *history.AccountsReq) (*history.AccountsRes, error) {
 	var res history.AccountsRes
 
+	switch req.Metric {
+	case history.JettonBalance, history.HolderCount:
+		return r.aggregateJettonHistory(ctx, req)
+	}
+
 	q := r.ch.NewSelect().Model((*core.AccountState)(nil))
 
 	if len(req.ContractTypes) > 0 {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/abi/known"
 	"github.com/stepandra/anton/internal/core/aggregate/history"
 	"github.com/stepandra/anton/internal/core/rndm"
 )
@@ -64,6 +65,62 @@ func TestRepository_AggregateAccountsHistory(t *testing.T) {
 		}
 	})
 
+	t.Run("count jetton holders", func(t *testing.T) {
+		minter := rndm.Address()
+
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		for i := 0; i < 5; i++ {
+			states := rndm.AccountStatesContract(3, known.JettonWallet, minter)
+			err = repo.AddAccountStates(ctx, tx, states)
+			require.Nil(t, err)
+		}
+
+		err = tx.Commit()
+		require.Nil(t, err)
+
+		res, err := repo.AggregateAccountsHistory(ctx, &history.AccountsReq{
+			Metric:        history.HolderCount,
+			MinterAddress: minter,
+			ReqParams: history.ReqParams{
+				Interval: 24 * time.Hour,
+			},
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, res.CountRes)
+		require.Equal(t, 5, res.CountRes[len(res.CountRes)-1].Value)
+	})
+
+	t.Run("jetton balance history", func(t *testing.T) {
+		minter, owner := rndm.Address(), rndm.Address()
+
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		states := rndm.AccountStatesContract(3, known.JettonWallet, minter)
+		for _, s := range states {
+			s.OwnerAddress = owner
+		}
+		err = repo.AddAccountStates(ctx, tx, states)
+		require.Nil(t, err)
+
+		err = tx.Commit()
+		require.Nil(t, err)
+
+		res, err := repo.AggregateAccountsHistory(ctx, &history.AccountsReq{
+			Metric:        history.JettonBalance,
+			MinterAddress: minter,
+			OwnerAddress:  owner,
+			ReqParams: history.ReqParams{
+				Interval: 24 * time.Hour,
+			},
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, res.BigIntRes)
+		require.Equal(t, states[len(states)-1].TokenBalance.String(), res.BigIntRes[len(res.BigIntRes)-1].Value.String())
+	})
+
 	t.Run("drop tables again", func(t *testing.T) {
 		dropTables(t)
 	})
@@ -0,0 +1,120 @@
+package account
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate"
+)
+
+type shardSeqNo struct {
+	Workchain int32
+	Shard     int64
+	SeqNo     uint32
+}
+
+// getShardSeqNo returns the last block of every shard committed to the masterchain up to the given master block.
+func (r *Repository) getShardSeqNo(ctx context.Context, masterSeqNo uint32) ([]*shardSeqNo, error) {
+	var ret []*shardSeqNo
+
+	exists, err := r.pg.NewSelect().Model((*core.Block)(nil)).
+		Where("workchain = -1").
+		Where("seq_no = ?", masterSeqNo).
+		Exists(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "check master block")
+	}
+	if !exists {
+		return nil, errors.Wrapf(core.ErrNotFound, "master block %d is not indexed", masterSeqNo)
+	}
+
+	err = r.pg.NewSelect().Model((*core.Block)(nil)).
+		ColumnExpr("workchain").
+		ColumnExpr("shard").
+		ColumnExpr("max(seq_no) AS seq_no").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("workchain = -1 AND seq_no <= ?", masterSeqNo).
+				WhereOr("master_workchain = -1 AND master_seq_no <= ?", masterSeqNo)
+		}).
+		Group("workchain", "shard").
+		Scan(ctx, &ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "get shard blocks")
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) makeHoldersQuery(req *aggregate.HoldersReq, shards []*shardSeqNo) *ch.SelectQuery {
+	q := r.ch.NewSelect().Model((*core.AccountState)(nil)).
+		ColumnExpr("address AS wallet_address").
+		ColumnExpr("argMax(owner_address, last_tx_lt) AS owner_address").
+		ColumnExpr("argMax(jetton_balance, last_tx_lt) AS balance").
+		Where("minter_address = ?", req.MinterAddress).
+		Where("fake = false").
+		Group("address")
+
+	if req.MasterSeqNo != 0 {
+		q = q.WhereGroup(" AND ", func(q *ch.SelectQuery) *ch.SelectQuery {
+			for _, s := range shards {
+				q = q.WhereOr("workchain = ? AND shard = ? AND block_seq_no <= ?", s.Workchain, s.Shard, s.SeqNo)
+			}
+			return q
+		})
+	}
+
+	return r.ch.NewSelect().
+		ColumnExpr("wallet_address, owner_address, balance").
+		TableExpr("(?) AS q", q).
+		Where("balance > 0")
+}
+
+func (r *Repository) AggregateHolders(ctx context.Context, req *aggregate.HoldersReq) (*aggregate.HoldersRes, error) {
+	var (
+		res    = aggregate.HoldersRes{MasterSeqNo: req.MasterSeqNo}
+		shards []*shardSeqNo
+		err    error
+	)
+
+	if req.MinterAddress == nil {
+		return nil, errors.Wrap(core.ErrInvalidArg, "minter address must be set")
+	}
+
+	if req.MasterSeqNo != 0 {
+		shards, err = r.getShardSeqNo(ctx, req.MasterSeqNo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var total struct {
+		Total       int
+		TotalSupply *bunbig.Int `ch:"type:UInt256"`
+	}
+	err = r.ch.NewSelect().
+		ColumnExpr("count() AS total").
+		ColumnExpr("sum(balance) AS total_supply").
+		TableExpr("(?) AS h", r.makeHoldersQuery(req, shards)).
+		Scan(ctx, &total)
+	if err != nil {
+		return nil, errors.Wrap(err, "count jetton holders")
+	}
+	res.Total, res.TotalSupply = total.Total, total.TotalSupply
+
+	err = r.makeHoldersQuery(req, shards).
+		Order("balance DESC", "wallet_address").
+		Offset(req.Offset).
+		Limit(req.Limit).
+		Scan(ctx, &res.Holders)
+	if err != nil {
+		return nil, errors.Wrap(err, "get jetton holders")
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,259 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/abi/known"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+// pTONMinter is STON.fi proxy TON minter, its jetton wallets stand for TON in STON.fi pools.
+var pTONMinter = addr.MustFromBase64("EQCM3B12QK1e4yZSf8GtBRT0aLMNyEsBc_DhVfRRtOEffLez")
+
+// maxPricingPools limits the number of pools considered for the asset pricing.
+const maxPricingPools = 100
+
+// verifiedPools are pool interfaces, which are verified by the factory or router of the DEX.
+var verifiedPools = []abi.ContractName{known.DedustV2Pool, known.StonFiPool}
+
+// getLatestPools returns the latest states of liquidity pools with the given addresses or with the given asset.
+// Only pools confirmed by their DEX factory or router are returned, so fake pools cannot set prices.
+func (r *Repository) getLatestPools(ctx context.Context, addresses []*addr.Address, asset *addr.Address) (ret []*core.AccountState, err error) {
+	var latest []*core.LatestAccountState
+
+	q := r.pg.NewSelect().Model(&latest).
+		Relation("AccountState", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.ExcludeColumn("code", "data")
+		}).
+		Where("account_state.types && ?", pgdialect.Array(verifiedPools)).
+		Where("account_state.fake = false").
+		Where("account_state.reserve0 IS NOT NULL").
+		Where("account_state.reserve1 IS NOT NULL")
+
+	if len(addresses) > 0 {
+		q = q.Where("latest_account_state.address in (?)", bun.In(addresses))
+	}
+	if asset != nil {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("account_state.asset0_address = ?", asset).
+				WhereOr("account_state.asset1_address = ?", asset)
+		})
+	}
+
+	if err := q.Limit(maxPricingPools).Scan(ctx); err != nil {
+		return nil, err
+	}
+	for _, l := range latest {
+		ret = append(ret, l.AccountState)
+	}
+	return ret, nil
+}
+
+type poolAssetResolver struct {
+	r     *Repository
+	cache map[addr.Address]*addr.Address
+}
+
+// resolve returns jetton minter of the pool asset or nil for TON.
+// STON.fi pools refer to jettons by the router wallets, so minter is taken from the wallet state.
+func (res *poolAssetResolver) resolve(ctx context.Context, a *addr.Address) (*addr.Address, error) {
+	if a == nil || *a == *pTONMinter {
+		return nil, nil
+	}
+	if m, ok := res.cache[*a]; ok {
+		return m, nil
+	}
+
+	states, err := res.r.filterAccountStates(ctx, &filter.AccountsReq{
+		Addresses:     []*addr.Address{a},
+		LatestState:   true,
+		ExcludeColumn: []string{"code", "data"},
+		Limit:         1,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get %s asset state", a.Base64())
+	}
+
+	minter := a
+	if len(states) > 0 && states[0].MinterAddress != nil {
+		minter = states[0].MinterAddress
+	}
+	if *minter == *pTONMinter {
+		minter = nil
+	}
+
+	res.cache[*a] = minter
+	return minter, nil
+}
+
+func (r *Repository) getPoolStates(ctx context.Context, pool *addr.Address, req *history.ReqParams) (ret []*history.PoolState, err error) {
+	rounding, err := history.GetRoundingFunction(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	q := r.ch.NewSelect().Model((*core.AccountState)(nil)).
+		ColumnExpr(fmt.Sprintf(rounding, "updated_at")+" AS timestamp").
+		ColumnExpr("argMax(reserve0, last_tx_lt) AS reserve0").
+		ColumnExpr("argMax(reserve1, last_tx_lt) AS reserve1").
+		ColumnExpr("argMax(token_supply, last_tx_lt) AS lp_supply").
+		Where("address = ?", pool)
+
+	if !req.From.IsZero() {
+		q = q.Where("updated_at > ?", req.From)
+	}
+	if !req.To.IsZero() {
+		q = q.Where("updated_at < ?", req.To)
+	}
+
+	q = q.Group("timestamp").Order("timestamp ASC")
+
+	if err := q.Scan(ctx, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// findPricingPool looks for TON-paired pool of the given asset with the largest TON reserve.
+// It returns the pool and the index of the asset in the pool.
+func (r *Repository) findPricingPool(ctx context.Context, res *poolAssetResolver, asset *addr.Address) (*core.AccountState, int, error) {
+	pools, err := r.getLatestPools(ctx, nil, asset)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "get asset pools")
+	}
+
+	var (
+		best    *core.AccountState
+		bestIdx int
+		bestTON *big.Int
+	)
+	for _, p := range pools {
+		idx, other, otherReserve := 0, p.Asset1Address, p.Reserve1
+		if !addr.Equal(p.Asset0Address, asset) {
+			idx, other, otherReserve = 1, p.Asset0Address, p.Reserve0
+		}
+
+		minter, err := res.resolve(ctx, other)
+		if err != nil {
+			return nil, 0, err
+		}
+		if minter != nil {
+			continue // not paired with TON
+		}
+
+		if bestTON == nil || otherReserve.ToMathBig().Cmp(bestTON) > 0 {
+			best, bestIdx, bestTON = p, idx, otherReserve.ToMathBig()
+		}
+	}
+
+	return best, bestIdx, nil
+}
+
+func reserve(s *history.PoolState, idx int) *big.Int {
+	r := s.Reserve0
+	if idx == 1 {
+		r = s.Reserve1
+	}
+	if r == nil {
+		return new(big.Int)
+	}
+	return r.ToMathBig()
+}
+
+// setPricedTVL computes TVL of pool states through the pricing pool states.
+// The latest known pricing pool state is used for every pool state.
+func setPricedTVL(states []*history.PoolState, idx int, pricing []*history.PoolState, pricingIdx int) {
+	var (
+		it   int
+		last *history.PoolState
+	)
+	for _, s := range states {
+		for ; it < len(pricing) && !pricing[it].Timestamp.After(s.Timestamp); it++ {
+			last = pricing[it]
+		}
+		if last == nil {
+			continue
+		}
+
+		assetReserve, tonReserve := reserve(last, pricingIdx), reserve(last, 1-pricingIdx)
+		if assetReserve.Sign() == 0 {
+			continue
+		}
+
+		tvl := new(big.Int).Mul(reserve(s, idx), tonReserve)
+		tvl.Quo(tvl.Lsh(tvl, 1), assetReserve)
+		s.TVL = bunbig.FromMathBig(tvl)
+	}
+}
+
+func (r *Repository) AggregatePoolHistory(ctx context.Context, req *history.PoolReq) (*history.PoolRes, error) {
+	var (
+		res      history.PoolRes
+		resolver = &poolAssetResolver{r: r, cache: map[addr.Address]*addr.Address{}}
+		err      error
+	)
+
+	pools, err := r.getLatestPools(ctx, []*addr.Address{&req.PoolAddress}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pool state")
+	}
+	if len(pools) == 0 {
+		return nil, errors.Wrapf(core.ErrNotFound, "cannot find %s pool", req.PoolAddress.Base64())
+	}
+	pool := pools[0]
+
+	if res.Asset0, err = resolver.resolve(ctx, pool.Asset0Address); err != nil {
+		return nil, err
+	}
+	if res.Asset1, err = resolver.resolve(ctx, pool.Asset1Address); err != nil {
+		return nil, err
+	}
+
+	res.States, err = r.getPoolStates(ctx, &req.PoolAddress, &req.ReqParams)
+	if err != nil {
+		return nil, errors.Wrap(err, "get pool states")
+	}
+
+	// pool paired with TON holds the same value in both assets
+	for idx, ton := range []bool{res.Asset0 == nil, res.Asset1 == nil} {
+		if !ton {
+			continue
+		}
+		for _, s := range res.States {
+			s.TVL = bunbig.FromMathBig(new(big.Int).Lsh(reserve(s, idx), 1))
+		}
+		return &res, nil
+	}
+
+	for idx, asset := range []*addr.Address{pool.Asset0Address, pool.Asset1Address} {
+		pricing, pricingIdx, err := r.findPricingPool(ctx, resolver, asset)
+		if err != nil {
+			return nil, err
+		}
+		if pricing == nil {
+			continue
+		}
+
+		pricingStates, err := r.getPoolStates(ctx, &pricing.Address, &req.ReqParams)
+		if err != nil {
+			return nil, errors.Wrap(err, "get pricing pool states")
+		}
+
+		res.PricingPool = &pricing.Address
+		setPricedTVL(res.States, idx, pricingStates, pricingIdx)
+		break
+	}
+
+	return &res, nil
+}
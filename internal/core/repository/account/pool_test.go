@@ -0,0 +1,114 @@
+package account_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun/extra/bunbig"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+	"github.com/stepandra/anton/internal/core/rndm"
+)
+
+func poolState(pool, asset0, asset1 *addr.Address, reserve0, reserve1 int64, at time.Time) *core.AccountState {
+	s := rndm.AddressStateContract(pool, "dedust_v2_pool", nil)
+	s.Asset0Address, s.Asset1Address = asset0, asset1
+	s.Reserve0, s.Reserve1 = bunbig.FromInt64(reserve0), bunbig.FromInt64(reserve1)
+	s.TokenSupply = bunbig.FromInt64(1000)
+	s.UpdatedAt = at
+	return s
+}
+
+func TestRepository_AggregatePoolHistory(t *testing.T) {
+	initdb(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tonPool, jettonPool, fakePool, unknownPool := rndm.Address(), rndm.Address(), rndm.Address(), rndm.Address()
+	jetton0, jetton1 := rndm.Address(), rndm.Address()
+	at := time.Now().UTC().Truncate(time.Hour).Add(-2 * time.Hour)
+
+	t.Run("drop tables", func(t *testing.T) {
+		dropTables(t)
+	})
+
+	t.Run("create tables", func(t *testing.T) {
+		createTables(t)
+	})
+
+	t.Run("insert test data", func(t *testing.T) {
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		fake := poolState(fakePool, nil, jetton0, 100000, 1, at.Add(time.Minute))
+		fake.Fake = true
+		unknown := poolState(unknownPool, nil, jetton0, 100000, 1, at.Add(time.Minute))
+		unknown.Types = []abi.ContractName{"some_pool"}
+
+		err = repo.AddAccountStates(ctx, tx, []*core.AccountState{
+			fake,
+			unknown,
+			poolState(tonPool, nil, jetton0, 100, 400, at.Add(time.Minute)),
+			poolState(tonPool, nil, jetton0, 200, 400, at.Add(2*time.Minute)),
+			poolState(jettonPool, jetton0, jetton1, 10, 50, at.Add(3*time.Minute)),
+			poolState(jettonPool, jetton0, jetton1, 20, 40, at.Add(time.Hour+time.Minute)),
+		})
+		require.Nil(t, err)
+
+		err = tx.Commit()
+		require.Nil(t, err)
+	})
+
+	t.Run("ton pool history", func(t *testing.T) {
+		res, err := repo.AggregatePoolHistory(ctx, &history.PoolReq{
+			PoolAddress: *tonPool,
+			ReqParams:   history.ReqParams{Interval: time.Hour},
+		})
+		require.Nil(t, err)
+		require.Nil(t, res.Asset0)
+		require.Equal(t, jetton0, res.Asset1)
+		require.Nil(t, res.PricingPool)
+		require.Len(t, res.States, 1)
+		require.Equal(t, "200", res.States[0].Reserve0.String())
+		require.Equal(t, "1000", res.States[0].LPSupply.String())
+		require.Equal(t, "400", res.States[0].TVL.String())
+	})
+
+	t.Run("jetton pool history", func(t *testing.T) {
+		res, err := repo.AggregatePoolHistory(ctx, &history.PoolReq{
+			PoolAddress: *jettonPool,
+			ReqParams:   history.ReqParams{Interval: time.Hour},
+		})
+		require.Nil(t, err)
+		require.Equal(t, tonPool, res.PricingPool)
+		require.Len(t, res.States, 2)
+		require.Equal(t, "10", res.States[0].TVL.String())
+		require.Equal(t, "20", res.States[1].TVL.String())
+	})
+
+	t.Run("fake pool", func(t *testing.T) {
+		_, err := repo.AggregatePoolHistory(ctx, &history.PoolReq{
+			PoolAddress: *fakePool,
+			ReqParams:   history.ReqParams{Interval: time.Hour},
+		})
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("unknown pool", func(t *testing.T) {
+		_, err := repo.AggregatePoolHistory(ctx, &history.PoolReq{
+			PoolAddress: *rndm.Address(),
+			ReqParams:   history.ReqParams{Interval: time.Hour},
+		})
+		require.ErrorIs(t, err, core.ErrNotFound)
+	})
+
+	t.Run("drop tables again", func(t *testing.T) {
+		dropTables(t)
+	})
+}
@@ -147,3 +147,82 @@ func (r *Repository) GetMissedMasterBlocks(ctx context.Context) (res []uint32, e
 
 	return res, nil
 }
+
+func (r *Repository) GetSavedMasterBlocks(ctx context.Context, from, to uint32) ([]uint32, error) {
+	var ret []uint32
+
+	err := r.pg.NewSelect().Model((*core.Block)(nil)).
+		Column("seq_no").
+		Where("workchain = -1").
+		Where("seq_no >= ?", from).
+		Where("seq_no <= ?", to).
+		Order("seq_no ASC").
+		Scan(ctx, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) GetBlocksAfterMaster(ctx context.Context, masterSeqNo uint32) ([]core.BlockID, error) {
+	var ret []core.BlockID
+
+	err := r.pg.NewSelect().Model((*core.Block)(nil)).
+		Column("workchain", "shard", "seq_no").
+		WhereOr("workchain = -1 AND seq_no > ?", masterSeqNo).
+		WhereOr("workchain != -1 AND master_seq_no > ?", masterSeqNo).
+		Scan(ctx, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) GetMasterRangeBlocks(ctx context.Context, from, to uint32) ([]*core.BlockRange, error) {
+	var ret []*core.BlockRange
+
+	q := r.pg.NewSelect().Model((*core.Block)(nil)).
+		ColumnExpr("workchain, shard").
+		ColumnExpr("min(seq_no) AS from_seq_no").
+		ColumnExpr("max(seq_no) AS to_seq_no")
+	if to == 0 {
+		q = q.WhereOr("workchain = -1 AND seq_no >= ?", from).
+			WhereOr("workchain != -1 AND master_seq_no >= ?", from)
+	} else {
+		q = q.WhereOr("workchain = -1 AND seq_no BETWEEN ? AND ?", from, to).
+			WhereOr("workchain != -1 AND master_seq_no BETWEEN ? AND ?", from, to)
+	}
+	err := q.
+		Group("workchain", "shard").
+		Order("workchain", "shard").
+		Scan(ctx, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteBlocks(ctx context.Context, tx bun.Tx, ids []core.BlockID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	in := repository.BlockTuples(ids)
+
+	_, err := tx.NewDelete().Model((*core.Block)(nil)).
+		Where("(workchain, shard, seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete pg blocks")
+	}
+
+	_, err = r.ch.ExecContext(ctx, "ALTER TABLE block_info DELETE WHERE (workchain, shard, seq_no) IN (?)", ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "delete ch blocks")
+	}
+
+	return nil
+}
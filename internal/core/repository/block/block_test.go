@@ -127,6 +127,19 @@ func TestRepository_AddBlocks(t *testing.T) {
 		require.Equal(t, master, b)
 	})
 
+	t.Run("get master range blocks", func(t *testing.T) {
+		ranges, err := repo.GetMasterRangeBlocks(ctx, master.SeqNo, 0)
+		require.Nil(t, err)
+		require.Equal(t, []*core.BlockRange{
+			{Workchain: master.Workchain, Shard: master.Shard, FromSeqNo: master.SeqNo, ToSeqNo: master.SeqNo},
+			{Workchain: shard.Workchain, Shard: shard.Shard, FromSeqNo: shard.SeqNo, ToSeqNo: shard.SeqNo},
+		}, ranges)
+
+		ranges, err = repo.GetMasterRangeBlocks(ctx, master.SeqNo+1, master.SeqNo+10)
+		require.Nil(t, err)
+		require.Len(t, ranges, 0)
+	})
+
 	t.Run("drop tables again", func(t *testing.T) {
 		dropTables(t)
 	})
@@ -76,13 +76,13 @@ func CreateTables(ctx context.Context, pgDB *bun.DB) error {
 	return nil
 }
 
-func (r *Repository) AddDefinition(ctx context.Context, dn abi.TLBType, d abi.TLBFieldsDesc) error {
+func (r *Repository) AddDefinition(ctx context.Context, tx bun.Tx, dn abi.TLBType, d abi.TLBFieldsDesc) error {
 	def := &core.ContractDefinition{
 		Name:   dn,
 		Schema: d,
 	}
 
-	_, err := r.pg.NewInsert().Model(def).Exec(ctx)
+	_, err := tx.NewInsert().Model(def).Exec(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
 			return core.ErrAlreadyExists
@@ -93,13 +93,13 @@ func (r *Repository) AddDefinition(ctx context.Context, dn abi.TLBType, d abi.TL
 	return nil
 }
 
-func (r *Repository) UpdateDefinition(ctx context.Context, dn abi.TLBType, d abi.TLBFieldsDesc) error {
+func (r *Repository) UpdateDefinition(ctx context.Context, tx bun.Tx, dn abi.TLBType, d abi.TLBFieldsDesc) error {
 	def := &core.ContractDefinition{
 		Name:   dn,
 		Schema: d,
 	}
 
-	ret, err := r.pg.NewUpdate().Model(def).WherePK().Exec(ctx)
+	ret, err := tx.NewUpdate().Model(def).WherePK().Exec(ctx)
 	if err != nil {
 		return err
 	}
@@ -115,10 +115,10 @@ func (r *Repository) UpdateDefinition(ctx context.Context, dn abi.TLBType, d abi
 	return nil
 }
 
-func (r *Repository) DeleteDefinition(ctx context.Context, dn abi.TLBType) error {
+func (r *Repository) DeleteDefinition(ctx context.Context, tx bun.Tx, dn abi.TLBType) error {
 	def := &core.ContractDefinition{Name: dn}
 
-	ret, err := r.pg.NewDelete().Model(def).WherePK().Exec(ctx)
+	ret, err := tx.NewDelete().Model(def).WherePK().Exec(ctx)
 	if err != nil {
 		return err
 	}
@@ -150,8 +150,8 @@ func (r *Repository) GetDefinitions(ctx context.Context) (map[abi.TLBType]abi.TL
 	return res, nil
 }
 
-func (r *Repository) AddInterface(ctx context.Context, i *core.ContractInterface) error {
-	_, err := r.pg.NewInsert().Model(i).Exec(ctx)
+func (r *Repository) AddInterface(ctx context.Context, tx bun.Tx, i *core.ContractInterface) error {
+	_, err := tx.NewInsert().Model(i).Exec(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
 			return core.ErrAlreadyExists
@@ -161,16 +161,16 @@ func (r *Repository) AddInterface(ctx context.Context, i *core.ContractInterface
 	return nil
 }
 
-func (r *Repository) UpdateInterface(ctx context.Context, i *core.ContractInterface) error {
-	_, err := r.pg.NewUpdate().Model(i).WherePK().Exec(ctx)
+func (r *Repository) UpdateInterface(ctx context.Context, tx bun.Tx, i *core.ContractInterface) error {
+	_, err := tx.NewUpdate().Model(i).WherePK().Exec(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *Repository) DeleteInterface(ctx context.Context, name abi.ContractName) error {
-	_, err := r.pg.NewDelete().
+func (r *Repository) DeleteInterface(ctx context.Context, tx bun.Tx, name abi.ContractName) error {
+	_, err := tx.NewDelete().
 		Model((*core.ContractOperation)(nil)).
 		Where("contract_name = ?", name).
 		Exec(ctx)
@@ -178,7 +178,7 @@ func (r *Repository) DeleteInterface(ctx context.Context, name abi.ContractName)
 		return err
 	}
 
-	ret, err := r.pg.NewDelete().
+	ret, err := tx.NewDelete().
 		Model((*core.ContractInterface)(nil)).
 		Where("name = ?", name).
 		Exec(ctx)
@@ -284,8 +284,8 @@ func (r *Repository) GetMethodDescription(ctx context.Context, name abi.Contract
 	return abi.GetMethodDesc{}, core.ErrNotFound
 }
 
-func (r *Repository) AddOperation(ctx context.Context, op *core.ContractOperation) error {
-	_, err := r.pg.NewInsert().Model(op).Exec(ctx)
+func (r *Repository) AddOperation(ctx context.Context, tx bun.Tx, op *core.ContractOperation) error {
+	_, err := tx.NewInsert().Model(op).Exec(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
 			return core.ErrAlreadyExists
@@ -295,8 +295,8 @@ func (r *Repository) AddOperation(ctx context.Context, op *core.ContractOperatio
 	return nil
 }
 
-func (r *Repository) UpdateOperation(ctx context.Context, op *core.ContractOperation) error {
-	ret, err := r.pg.NewUpdate().Model(op).WherePK().Exec(ctx)
+func (r *Repository) UpdateOperation(ctx context.Context, tx bun.Tx, op *core.ContractOperation) error {
+	ret, err := tx.NewUpdate().Model(op).WherePK().Exec(ctx)
 	if err != nil {
 		return err
 	}
@@ -310,8 +310,8 @@ func (r *Repository) UpdateOperation(ctx context.Context, op *core.ContractOpera
 	return nil
 }
 
-func (r *Repository) DeleteOperation(ctx context.Context, opName string) error {
-	ret, err := r.pg.NewDelete().Model((*core.ContractOperation)(nil)).
+func (r *Repository) DeleteOperation(ctx context.Context, tx bun.Tx, opName string) error {
+	ret, err := tx.NewDelete().Model((*core.ContractOperation)(nil)).
 		Where("operation_name = ?", opName).
 		Exec(ctx)
 	if err != nil {
@@ -186,7 +186,13 @@ func TestRepository_AddContracts(t *testing.T) {
 	})
 
 	t.Run("insert definition", func(t *testing.T) {
-		err := repo.AddDefinition(ctx, d.Name, d.Schema)
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		err = repo.AddDefinition(ctx, tx, d.Name, d.Schema)
+		require.Nil(t, err)
+
+		err = tx.Commit()
 		require.Nil(t, err)
 	})
 
@@ -198,12 +204,24 @@ func TestRepository_AddContracts(t *testing.T) {
 	})
 
 	t.Run("insert interface", func(t *testing.T) {
-		err := repo.AddInterface(ctx, i)
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		err = repo.AddInterface(ctx, tx, i)
+		require.Nil(t, err)
+
+		err = tx.Commit()
 		require.Nil(t, err)
 	})
 
 	t.Run("insert operation", func(t *testing.T) {
-		err := repo.AddOperation(ctx, op)
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		err = repo.AddOperation(ctx, tx, op)
+		require.Nil(t, err)
+
+		err = tx.Commit()
 		require.Nil(t, err)
 	})
 
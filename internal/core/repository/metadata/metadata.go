@@ -0,0 +1,181 @@
+package metadata
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ core.MetadataRepository = (*Repository)(nil)
+
+type Repository struct {
+	pg *bun.DB
+}
+
+func NewRepository(db *bun.DB) *Repository {
+	return &Repository{pg: db}
+}
+
+func CreateTables(ctx context.Context, pgDB *bun.DB) error {
+	_, err := pgDB.NewCreateTable().
+		Model(&core.TokenMetadata{}).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "token metadata pg create table")
+	}
+
+	_, err = pgDB.NewCreateTable().
+		Model(&core.TokenMetadataFetch{}).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "token metadata fetches pg create table")
+	}
+
+	return nil
+}
+
+func (r *Repository) GetNewURIs(ctx context.Context, limit int) ([]string, error) {
+	var ret []string
+
+	err := r.pg.NewSelect().
+		TableExpr("latest_account_states AS l").
+		Join("JOIN account_states AS s ON s.address = l.address AND s.last_tx_lt = l.last_tx_lt").
+		ColumnExpr("DISTINCT s.content_uri").
+		Where("s.content_uri IS NOT NULL").
+		Where("NOT EXISTS (SELECT 1 FROM token_metadata_fetches AS f WHERE f.uri = s.content_uri)").
+		Limit(limit).
+		Scan(ctx, &ret)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) AddURIs(ctx context.Context, uris []string) error {
+	if len(uris) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	fetches := make([]*core.TokenMetadataFetch, 0, len(uris))
+	for _, u := range uris {
+		fetches = append(fetches, &core.TokenMetadataFetch{URI: u, NextFetchAt: now})
+	}
+
+	_, err := r.pg.NewInsert().Model(&fetches).On("CONFLICT DO NOTHING").Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetURIsToFetch(ctx context.Context, limit int) ([]*core.TokenMetadataFetch, error) {
+	var ret []*core.TokenMetadataFetch
+
+	err := r.pg.NewSelect().Model(&ret).
+		Where("next_fetch_at <= ?", time.Now()).
+		Order("next_fetch_at").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) SaveFetch(ctx context.Context, f *core.TokenMetadataFetch, m *core.TokenMetadata) error {
+	tx, err := r.pg.Begin()
+	if err != nil {
+		return errors.Wrap(err, "begin tx")
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if m != nil {
+		_, err = tx.NewInsert().Model(m).On("CONFLICT DO NOTHING").Exec(ctx)
+		if err != nil {
+			return errors.Wrap(err, "insert token metadata")
+		}
+	}
+
+	_, err = tx.NewInsert().Model(f).
+		On("CONFLICT (uri) DO UPDATE").
+		Set("content_hash = EXCLUDED.content_hash").
+		Set("attempts = EXCLUDED.attempts").
+		Set("error = EXCLUDED.error").
+		Set("fetched_at = EXCLUDED.fetched_at").
+		Set("next_fetch_at = EXCLUDED.next_fetch_at").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "update token metadata fetch")
+	}
+
+	return tx.Commit()
+}
+
+func (r *Repository) GetMetadata(ctx context.Context, uri string, contentHash []byte) (*core.TokenMetadata, error) {
+	if len(contentHash) == 0 {
+		ret, err := r.GetLatestMetadata(ctx, []string{uri})
+		if err != nil {
+			return nil, err
+		}
+		m, ok := ret[uri]
+		if !ok {
+			return nil, errors.Wrapf(core.ErrNotFound, "no metadata for %s uri", uri)
+		}
+		return m, nil
+	}
+
+	var ret core.TokenMetadata
+
+	err := r.pg.NewSelect().Model(&ret).
+		Where("uri = ?", uri).
+		Where("content_hash = ?", contentHash).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrapf(core.ErrNotFound, "no metadata for %s uri and %x content hash", uri, contentHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (r *Repository) GetLatestMetadata(ctx context.Context, uris []string) (map[string]*core.TokenMetadata, error) {
+	var fetches []*core.TokenMetadataFetch
+
+	if len(uris) == 0 {
+		return map[string]*core.TokenMetadata{}, nil
+	}
+
+	err := r.pg.NewSelect().Model(&fetches).
+		Relation("Metadata").
+		Where("token_metadata_fetch.uri IN (?)", bun.In(uris)).
+		Where("token_metadata_fetch.content_hash IS NOT NULL").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]*core.TokenMetadata, len(fetches))
+	for _, f := range fetches {
+		if f.Metadata != nil {
+			ret[f.URI] = f.Metadata
+		}
+	}
+
+	return ret, nil
+}
@@ -89,6 +89,16 @@ func createIndexes(ctx context.Context, pgDB *bun.DB) error {
 		return errors.Wrap(err, "message operation id pg create index")
 	}
 
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Message{}).
+		Using("HASH").
+		Column("trace_id").
+		Where("trace_id IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "message trace id pg create index")
+	}
+
 	// message payloads
 
 	_, err = pgDB.NewCreateIndex().
@@ -190,6 +200,7 @@ func (r *Repository) AddMessages(ctx context.Context, tx bun.Tx, messages []*cor
 		Set("operation_name = EXCLUDED.operation_name").
 		Set("data_json = EXCLUDED.data_json").
 		Set("error = EXCLUDED.error").
+		Set("trace_id = COALESCE(message.trace_id, EXCLUDED.trace_id)").
 		Exec(ctx)
 	if err != nil {
 		return err
@@ -242,6 +253,52 @@ func (r *Repository) UpdateMessages(ctx context.Context, messages []*core.Messag
 	return nil
 }
 
+func (r *Repository) DeleteMessages(ctx context.Context, tx bun.Tx, blocks []core.BlockID) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	in := repository.BlockTuples(blocks)
+
+	// messages without source transaction (external in)
+	// are created in the block of destination transaction
+
+	_, err := tx.NewDelete().Model((*core.Message)(nil)).
+		WhereOr("src_tx_lt IS NOT NULL AND (src_workchain, src_shard, src_block_seq_no) IN (?)", bun.Safe(in)).
+		WhereOr("src_tx_lt IS NULL AND (dst_workchain, dst_shard, dst_block_seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete pg messages")
+	}
+
+	_, err = tx.NewUpdate().Model((*core.Message)(nil)).
+		Set("dst_tx_lt = NULL").
+		Set("dst_workchain = 0").
+		Set("dst_shard = 0").
+		Set("dst_block_seq_no = 0").
+		Where("(dst_workchain, dst_shard, dst_block_seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "clear pg messages destination")
+	}
+
+	_, err = r.ch.ExecContext(ctx, `ALTER TABLE messages DELETE WHERE
+(src_tx_lt != 0 AND (src_workchain, src_shard, src_block_seq_no) IN (?)) OR
+(src_tx_lt = 0 AND (dst_workchain, dst_shard, dst_block_seq_no) IN (?))`, ch.Safe(in), ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "delete ch messages")
+	}
+
+	_, err = r.ch.ExecContext(ctx, `ALTER TABLE messages
+UPDATE dst_tx_lt = 0, dst_workchain = 0, dst_shard = 0, dst_block_seq_no = 0
+WHERE (dst_workchain, dst_shard, dst_block_seq_no) IN (?)`, ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "clear ch messages destination")
+	}
+
+	return nil
+}
+
 func (r *Repository) GetMessages(ctx context.Context, hashes [][]byte) ([]*core.Message, error) {
 	var ret []*core.Message
 
@@ -263,6 +320,7 @@ func (r *Repository) MatchMessagesByOperationDesc(ctx context.Context,
 	msgType core.MessageType,
 	outgoing bool,
 	operationId uint32,
+	scope *core.RescanScope,
 	afterAddress *addr.Address,
 	afterTxLt uint64,
 	limit int,
@@ -274,6 +332,9 @@ func (r *Repository) MatchMessagesByOperationDesc(ctx context.Context,
 	q := r.ch.NewSelect().Model((*core.AccountState)(nil)).
 		ColumnExpr("DISTINCT address").
 		Where("hasAny(types, [?])", string(contractName))
+	if scope != nil && len(scope.Addresses) > 0 {
+		q = q.Where("address IN (?)", ch.In(scope.Addresses))
+	}
 	if afterAddress != nil {
 		q = q.Where("address >= ?", afterAddress)
 	}
@@ -290,10 +351,11 @@ func (r *Repository) MatchMessagesByOperationDesc(ctx context.Context,
 		addresses = append(addresses, row.Address)
 	}
 
-	addrCol, ltCol := "dst_address", "dst_tx_lt"
+	prefix := "dst_"
 	if outgoing {
-		addrCol, ltCol = "src_address", "src_tx_lt"
+		prefix = "src_"
 	}
+	addrCol, ltCol := prefix+"address", prefix+"tx_lt"
 
 	var msgHashesRet []struct {
 		Hash []byte
@@ -303,6 +365,7 @@ func (r *Repository) MatchMessagesByOperationDesc(ctx context.Context,
 		Where("type = ?", string(msgType)).
 		Where(addrCol+" IN (?)", ch.In(addresses)).
 		Where("operation_id = ?", operationId)
+	q = repository.WhereRescanScope(q, scope, prefix, "created_at")
 	if afterAddress != nil && afterTxLt != 0 {
 		q = q.Where(fmt.Sprintf("(%s, %s) > (?, ?)", addrCol, ltCol), afterAddress, afterTxLt)
 	}
@@ -320,3 +383,35 @@ func (r *Repository) MatchMessagesByOperationDesc(ctx context.Context,
 	}
 	return hashes, nil
 }
+
+func (r *Repository) CountMessagesByOperationDesc(ctx context.Context,
+	contractName abi.ContractName,
+	msgType core.MessageType,
+	outgoing bool,
+	operationId uint32,
+	scope *core.RescanScope,
+) (int, error) {
+	var count int
+
+	prefix := "dst_"
+	if outgoing {
+		prefix = "src_"
+	}
+
+	addresses := r.ch.NewSelect().Model((*core.AccountState)(nil)).
+		ColumnExpr("DISTINCT address").
+		Where("hasAny(types, [?])", string(contractName))
+
+	q := r.ch.NewSelect().Model((*core.Message)(nil)).
+		ColumnExpr("count(DISTINCT hash)").
+		Where("type = ?", string(msgType)).
+		Where(prefix+"address IN (?)", addresses).
+		Where("operation_id = ?", operationId)
+	err := repository.WhereRescanScope(q, scope, prefix, "created_at").
+		Scan(ctx, &count)
+	if err != nil {
+		return 0, errors.Wrap(err, "count messages")
+	}
+
+	return count, nil
+}
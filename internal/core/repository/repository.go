@@ -16,7 +16,9 @@ type Account interface {
 	core.AccountRepository
 	filter.AccountRepository
 	aggregate.AccountRepository
+	aggregate.HolderRepository
 	history.AccountRepository
+	history.PoolRepository
 }
 
 type Transaction interface {
@@ -32,6 +34,18 @@ type Message interface {
 	history.MessageRepository
 }
 
+type JettonTransfer interface {
+	core.JettonTransferRepository
+	filter.JettonTransferRepository
+	history.JettonTransferRepository
+}
+
+type Swap interface {
+	core.SwapRepository
+	filter.SwapRepository
+	history.SwapRepository
+}
+
 type Contract interface {
 	core.ContractRepository
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
 
+	"github.com/stepandra/anton/abi"
 	"github.com/stepandra/anton/internal/core"
 )
 
@@ -23,8 +24,9 @@ func NewRepository(db *bun.DB) *Repository {
 }
 
 func CreateTables(ctx context.Context, pgDB *bun.DB) error {
-	_, err := pgDB.ExecContext(ctx, "CREATE TYPE rescan_task_type AS ENUM (?, ?, ?, ?, ?, ?, ?, ?)",
-		core.AddInterface, core.UpdInterface, core.DelInterface, core.AddGetMethod, core.DelGetMethod, core.UpdGetMethod, core.UpdOperation, core.DelOperation)
+	_, err := pgDB.ExecContext(ctx, "CREATE TYPE rescan_task_type AS ENUM (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		core.AddInterface, core.UpdInterface, core.DelInterface, core.AddGetMethod, core.DelGetMethod, core.UpdGetMethod, core.UpdOperation, core.DelOperation,
+		core.UpdContractData)
 	if err != nil && !strings.Contains(err.Error(), "already exists") {
 		return errors.Wrap(err, "rescan task type pg create enum")
 	}
@@ -41,17 +43,40 @@ func CreateTables(ctx context.Context, pgDB *bun.DB) error {
 	return nil
 }
 
-func (r *Repository) AddRescanTask(ctx context.Context, task *core.RescanTask) error {
+func (r *Repository) AddRescanTask(ctx context.Context, tx bun.Tx, task *core.RescanTask) error {
 	task.ID = 0
 	task.CreatedAt = time.Now()
-	_, err := r.pg.NewInsert().Model(task).Exec(ctx)
+	task.UpdatedAt = task.CreatedAt
+	_, err := tx.NewInsert().Model(task).Exec(ctx)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *Repository) GetUnfinishedRescanTask(ctx context.Context) (bun.Tx, *core.RescanTask, error) {
+func (r *Repository) GetRescanTasks(ctx context.Context, all bool) ([]*core.RescanTask, error) {
+	var tasks []*core.RescanTask
+
+	q := r.pg.NewSelect().Model(&tasks)
+	if all {
+		q = q.Order("id")
+	} else {
+		q = q.Where("finished = ?", false).
+			Where("cancelled = ?", false).
+			Order("priority DESC", "id")
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, t := range tasks {
+		t.SetProgress()
+	}
+
+	return tasks, nil
+}
+
+func (r *Repository) GetUnfinishedRescanTask(ctx context.Context, exclude []abi.ContractName) (bun.Tx, *core.RescanTask, error) {
 	var task core.RescanTask
 
 	tx, err := r.pg.Begin()
@@ -59,10 +84,15 @@ func (r *Repository) GetUnfinishedRescanTask(ctx context.Context) (bun.Tx, *core
 		return bun.Tx{}, nil, err
 	}
 
-	err = tx.NewSelect().Model(&task).
-		For("UPDATE").
+	q := tx.NewSelect().Model(&task).
+		For("UPDATE SKIP LOCKED").
 		Where("finished = ?", false).
-		Order("id").
+		Where("cancelled = ?", false)
+	if len(exclude) > 0 {
+		q = q.Where("contract_name NOT IN (?)", bun.In(exclude))
+	}
+	err = q.
+		Order("priority DESC", "id").
 		Limit(1).
 		Scan(ctx)
 	if err != nil {
@@ -114,6 +144,10 @@ func (r *Repository) SetRescanTask(ctx context.Context, tx bun.Tx, task *core.Re
 		Set("finished = ?finished").
 		Set("last_address = ?last_address").
 		Set("last_tx_lt = ?last_tx_lt").
+		Set("scanned = ?scanned").
+		Set("total = ?total").
+		Set("started_at = ?started_at").
+		Set("error = ?error").
 		Set("updated_at = ?", time.Now()).
 		WherePK().
 		Exec(ctx)
@@ -127,3 +161,56 @@ func (r *Repository) SetRescanTask(ctx context.Context, tx bun.Tx, task *core.Re
 
 	return nil
 }
+
+// updateRescanTask updates the task with the given id and returns core.ErrNotFound if no task is updated.
+func (r *Repository) updateRescanTask(ctx context.Context, id int, f func(q *bun.UpdateQuery) *bun.UpdateQuery) error {
+	res, err := f(r.pg.NewUpdate().Model((*core.RescanTask)(nil))).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.Wrapf(core.ErrNotFound, "no suitable %d rescan task", id)
+	}
+
+	return nil
+}
+
+func (r *Repository) SetRescanTaskError(ctx context.Context, id int, taskErr error) error {
+	return r.updateRescanTask(ctx, id, func(q *bun.UpdateQuery) *bun.UpdateQuery {
+		return q.Set("error = ?", taskErr.Error())
+	})
+}
+
+func (r *Repository) CancelRescanTask(ctx context.Context, id int) error {
+	return r.updateRescanTask(ctx, id, func(q *bun.UpdateQuery) *bun.UpdateQuery {
+		return q.Set("cancelled = ?", true).
+			Where("finished = ?", false)
+	})
+}
+
+func (r *Repository) RetryRescanTask(ctx context.Context, id int) error {
+	return r.updateRescanTask(ctx, id, func(q *bun.UpdateQuery) *bun.UpdateQuery {
+		return q.Set("finished = ?", false).
+			Set("cancelled = ?", false).
+			Set("last_address = NULL").
+			Set("last_tx_lt = 0").
+			Set("scanned = 0").
+			Set("total = 0").
+			Set("started_at = NULL").
+			Set("error = NULL")
+	})
+}
+
+func (r *Repository) SetRescanTaskPriority(ctx context.Context, id, priority int) error {
+	return r.updateRescanTask(ctx, id, func(q *bun.UpdateQuery) *bun.UpdateQuery {
+		return q.Set("priority = ?", priority)
+	})
+}
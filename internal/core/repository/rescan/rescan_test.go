@@ -103,9 +103,15 @@ func TestRepository_CreateNewRescanTask(t *testing.T) {
 		GetMethodHashes: rndm.GetMethodHashes(),
 	}
 
+	scope := core.RescanScope{
+		FromMasterSeqNo: 100,
+		ToMasterSeqNo:   200,
+		Addresses:       i.Addresses,
+	}
 	task := core.RescanTask{
 		Type:         core.AddInterface,
 		ContractName: known.NFTItem,
+		Scope:        scope,
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -120,17 +126,29 @@ func TestRepository_CreateNewRescanTask(t *testing.T) {
 	})
 
 	t.Run("insert interface", func(t *testing.T) {
-		err := contract.NewRepository(pg).AddInterface(ctx, i)
+		tx, err := pg.Begin()
+		require.Nil(t, err)
+
+		err = contract.NewRepository(pg).AddInterface(ctx, tx, i)
+		require.Nil(t, err)
+
+		err = tx.Commit()
 		require.Nil(t, err)
 	})
 
 	t.Run("create new task", func(t *testing.T) {
-		err := repo.AddRescanTask(ctx, &task)
+		tx, err := pg.Begin()
+		require.NoError(t, err)
+
+		err = repo.AddRescanTask(ctx, tx, &task)
+		require.NoError(t, err)
+
+		err = tx.Commit()
 		require.NoError(t, err)
 	})
 
 	t.Run("update unfinished task", func(t *testing.T) {
-		tx, task, err := repo.GetUnfinishedRescanTask(ctx)
+		tx, task, err := repo.GetUnfinishedRescanTask(ctx, nil)
 		require.NoError(t, err)
 
 		task.LastAddress = i.Addresses[0]
@@ -141,10 +159,11 @@ func TestRepository_CreateNewRescanTask(t *testing.T) {
 	})
 
 	t.Run("finish task", func(t *testing.T) {
-		tx, task, err := repo.GetUnfinishedRescanTask(ctx)
+		tx, task, err := repo.GetUnfinishedRescanTask(ctx, nil)
 		require.NoError(t, err)
 		require.Equal(t, i.Addresses[0], task.LastAddress)
 		require.Equal(t, uint64(10), task.LastTxLt)
+		require.Equal(t, scope, task.Scope)
 
 		task.LastAddress = i.Addresses[0]
 		task.LastTxLt = 20
@@ -155,16 +174,22 @@ func TestRepository_CreateNewRescanTask(t *testing.T) {
 	})
 
 	t.Run("get 'not found' error on choosing unfinished task", func(t *testing.T) {
-		_, _, err := repo.GetUnfinishedRescanTask(ctx)
+		_, _, err := repo.GetUnfinishedRescanTask(ctx, nil)
 		require.Error(t, err)
 		require.True(t, errors.Is(err, core.ErrNotFound))
 	})
 
 	t.Run("create second task", func(t *testing.T) {
-		err := repo.AddRescanTask(ctx, &task)
+		tx, err := pg.Begin()
 		require.NoError(t, err)
 
-		tx, task, err := repo.GetUnfinishedRescanTask(ctx)
+		err = repo.AddRescanTask(ctx, tx, &task)
+		require.NoError(t, err)
+
+		err = tx.Commit()
+		require.NoError(t, err)
+
+		tx, task, err := repo.GetUnfinishedRescanTask(ctx, nil)
 		require.NoError(t, err)
 		require.Equal(t, 2, task.ID)
 
@@ -178,3 +203,104 @@ func TestRepository_CreateNewRescanTask(t *testing.T) {
 		dropTables(t)
 	})
 }
+
+func TestRepository_ManageRescanTasks(t *testing.T) {
+	initdb(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	t.Run("drop tables", func(t *testing.T) {
+		dropTables(t)
+	})
+
+	t.Run("create tables", func(t *testing.T) {
+		createTables(t)
+	})
+
+	t.Run("create tasks", func(t *testing.T) {
+		for _, task := range []*core.RescanTask{
+			{Type: core.DelInterface, ContractName: known.NFTItem},
+			{Type: core.DelInterface, ContractName: known.NFTCollection},
+			{Type: core.DelInterface, ContractName: known.JettonMinter, Priority: 10},
+		} {
+			tx, err := pg.Begin()
+			require.NoError(t, err)
+
+			err = repo.AddRescanTask(ctx, tx, task)
+			require.NoError(t, err)
+
+			err = tx.Commit()
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("get tasks by priority", func(t *testing.T) {
+		tasks, err := repo.GetRescanTasks(ctx, false)
+		require.NoError(t, err)
+		require.Len(t, tasks, 3)
+		require.Equal(t, []int{3, 1, 2}, []int{tasks[0].ID, tasks[1].ID, tasks[2].ID})
+		require.Equal(t, core.RescanPending, tasks[0].Status)
+	})
+
+	t.Run("skip locked and excluded tasks", func(t *testing.T) {
+		tx, task, err := repo.GetUnfinishedRescanTask(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, task.ID)
+
+		task.StartedAt = time.Now().Add(-time.Minute)
+		task.Total, task.Scanned = 100, 25
+
+		tx2, task2, err := repo.GetUnfinishedRescanTask(ctx, []abi.ContractName{known.NFTItem})
+		require.NoError(t, err)
+		require.Equal(t, 2, task2.ID)
+		_ = tx2.Rollback()
+
+		err = repo.SetRescanTask(ctx, tx, task)
+		require.NoError(t, err)
+
+		tasks, err := repo.GetRescanTasks(ctx, false)
+		require.NoError(t, err)
+		require.Equal(t, core.RescanRunning, tasks[0].Status)
+		require.Equal(t, 0.25, tasks[0].Progress)
+		require.NotNil(t, tasks[0].ETA)
+	})
+
+	t.Run("change priority", func(t *testing.T) {
+		err := repo.SetRescanTaskPriority(ctx, 2, 20)
+		require.NoError(t, err)
+
+		tx, task, err := repo.GetUnfinishedRescanTask(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, task.ID)
+		_ = tx.Rollback()
+	})
+
+	t.Run("cancel task", func(t *testing.T) {
+		err := repo.CancelRescanTask(ctx, 3)
+		require.NoError(t, err)
+
+		tasks, err := repo.GetRescanTasks(ctx, false)
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+
+		err = repo.CancelRescanTask(ctx, 42)
+		require.True(t, errors.Is(err, core.ErrNotFound))
+	})
+
+	t.Run("retry task", func(t *testing.T) {
+		err := repo.RetryRescanTask(ctx, 3)
+		require.NoError(t, err)
+
+		tasks, err := repo.GetRescanTasks(ctx, true)
+		require.NoError(t, err)
+		require.Len(t, tasks, 3)
+		require.Equal(t, core.RescanPending, tasks[2].Status)
+		require.Nil(t, tasks[2].LastAddress)
+		require.Zero(t, tasks[2].Scanned)
+	})
+
+	t.Run("drop tables", func(t *testing.T) {
+		dropTables(t)
+	})
+}
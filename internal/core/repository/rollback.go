@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"strconv"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+// BlockTuples formats block identifiers as a list of (workchain, shard, seq_no) tuples,
+// which can be used in IN expression of both PostgreSQL and ClickHouse queries.
+func BlockTuples(ids []core.BlockID) string {
+	var b []byte
+
+	for it, id := range ids {
+		if it > 0 {
+			b = append(b, ", "...)
+		}
+		b = append(b, '(')
+		b = strconv.AppendInt(b, int64(id.Workchain), 10)
+		b = append(b, ", "...)
+		b = strconv.AppendInt(b, id.Shard, 10)
+		b = append(b, ", "...)
+		b = strconv.AppendUint(b, uint64(id.SeqNo), 10)
+		b = append(b, ')')
+	}
+
+	return string(b)
+}
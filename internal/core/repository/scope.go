@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+// WhereRescanScope limits ClickHouse query to rows within the rescan task scope.
+// Columns prefix selects the account columns of a row (e.g., src_ or dst_ in messages),
+// and timeCol is the name of the row time column.
+func WhereRescanScope(q *ch.SelectQuery, scope *core.RescanScope, prefix, timeCol string) *ch.SelectQuery {
+	if scope == nil {
+		return q
+	}
+
+	if len(scope.Addresses) > 0 {
+		q = q.Where(prefix+"address IN (?)", ch.In(scope.Addresses))
+	}
+	if !scope.FromTime.IsZero() {
+		q = q.Where(timeCol+" >= ?", scope.FromTime)
+	}
+	if !scope.ToTime.IsZero() {
+		q = q.Where(timeCol+" <= ?", scope.ToTime)
+	}
+	if len(scope.Blocks) > 0 {
+		q = q.WhereGroup(" AND ", func(q *ch.SelectQuery) *ch.SelectQuery {
+			for _, b := range scope.Blocks {
+				q = q.WhereOr("("+prefix+"workchain = ? AND "+prefix+"shard = ? AND "+prefix+"block_seq_no BETWEEN ? AND ?)",
+					b.Workchain, b.Shard, b.FromSeqNo, b.ToSeqNo)
+			}
+			return q
+		})
+	}
+
+	return q
+}
@@ -0,0 +1,111 @@
+package swap
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+func (r *Repository) filterSwaps(ctx context.Context, req *filter.SwapsReq) (ret []*core.Swap, err error) {
+	q := r.pg.NewSelect().Model(&ret)
+
+	if len(req.TxHash) > 0 {
+		q = q.Where("tx_hash = ?", req.TxHash)
+	}
+	if len(req.TraceID) > 0 {
+		q = q.Where("trace_id = ?", req.TraceID)
+	}
+	if req.DEX != "" {
+		q = q.Where("dex = ?", req.DEX)
+	}
+	if len(req.PoolAddresses) > 0 {
+		q = q.Where("pool_address in (?)", bun.In(req.PoolAddresses))
+	}
+	if len(req.Traders) > 0 {
+		q = q.Where("trader in (?)", bun.In(req.Traders))
+	}
+	if len(req.Assets) > 0 {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("asset_in in (?)", bun.In(req.Assets)).
+				WhereOr("asset_out in (?)", bun.In(req.Assets))
+		})
+	}
+
+	if req.AfterTxLT != nil {
+		if req.Order == "ASC" {
+			q = q.Where("created_lt > ?", req.AfterTxLT)
+		} else {
+			q = q.Where("created_lt < ?", req.AfterTxLT)
+		}
+	}
+
+	if req.Order != "" {
+		q = q.Order("created_lt " + strings.ToUpper(req.Order))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 3
+	}
+	q = q.Limit(req.Limit)
+
+	err = q.Scan(ctx)
+	return ret, err
+}
+
+func (r *Repository) countSwaps(ctx context.Context, req *filter.SwapsReq) (int, error) {
+	q := r.ch.NewSelect().
+		Model((*core.Swap)(nil))
+
+	if len(req.TxHash) > 0 {
+		q = q.Where("tx_hash = ?", req.TxHash)
+	}
+	if len(req.TraceID) > 0 {
+		q = q.Where("trace_id = ?", req.TraceID)
+	}
+	if req.DEX != "" {
+		q = q.Where("dex = ?", req.DEX)
+	}
+	if len(req.PoolAddresses) > 0 {
+		q = q.Where("pool_address in (?)", ch.In(req.PoolAddresses))
+	}
+	if len(req.Traders) > 0 {
+		q = q.Where("trader in (?)", ch.In(req.Traders))
+	}
+	if len(req.Assets) > 0 {
+		q = q.Where("(asset_in in (?) OR asset_out in (?))", ch.In(req.Assets), ch.In(req.Assets))
+	}
+
+	return q.Count(ctx)
+}
+
+func (r *Repository) FilterSwaps(ctx context.Context, req *filter.SwapsReq) (*filter.SwapsRes, error) {
+	var (
+		res = new(filter.SwapsRes)
+		err error
+	)
+
+	res.Rows, err = r.filterSwaps(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	switch {
+	case len(res.Rows) == 0:
+
+	case len(req.TxHash) > 0:
+		res.Total = len(res.Rows)
+
+	case req.Count:
+		res.Total, err = r.countSwaps(ctx, req)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,45 @@
+package swap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+)
+
+func (r *Repository) AggregateSwapCandles(ctx context.Context, req *history.SwapCandlesReq) (*history.SwapCandlesRes, error) {
+	var res history.SwapCandlesRes
+
+	rounding, err := history.GetRoundingFunction(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	// assets of the pair are ordered by address bytes, see core.Swap price
+	q := r.ch.NewSelect().Model((*core.Swap)(nil)).
+		ColumnExpr(fmt.Sprintf(rounding, "created_at")+" as timestamp").
+		ColumnExpr("argMin(price, created_lt) as open").
+		ColumnExpr("max(price) as high").
+		ColumnExpr("min(price) as low").
+		ColumnExpr("argMax(price, created_lt) as close").
+		ColumnExpr("sum(if(asset_in < asset_out, amount_in, amount_out)) as volume0").
+		ColumnExpr("sum(if(asset_in < asset_out, amount_out, amount_in)) as volume1").
+		ColumnExpr("count() as swap_count").
+		Where("pool_address = ?", &req.PoolAddress)
+
+	if !req.From.IsZero() {
+		q = q.Where("created_at > ?", req.From)
+	}
+	if !req.To.IsZero() {
+		q = q.Where("created_at < ?", req.To)
+	}
+
+	q = q.Group("timestamp").Order("timestamp ASC")
+
+	if err := q.Scan(ctx, &res.Candles); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,142 @@
+package swap
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/repository"
+)
+
+var _ repository.Swap = (*Repository)(nil)
+
+type Repository struct {
+	ch *ch.DB
+	pg *bun.DB
+}
+
+func NewRepository(ck *ch.DB, pg *bun.DB) *Repository {
+	return &Repository{ch: ck, pg: pg}
+}
+
+func createIndexes(ctx context.Context, pgDB *bun.DB) error {
+	var err error
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Swap{}).
+		Using("BTREE").
+		Column("created_lt").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap created_lt pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Swap{}).
+		Using("HASH").
+		Column("pool_address").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap pool address pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Swap{}).
+		Using("HASH").
+		Column("trader").
+		Where("trader IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap trader pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Swap{}).
+		Using("HASH").
+		Column("trace_id").
+		Where("trace_id IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap trace id pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Swap{}).
+		Column("workchain", "shard", "block_seq_no").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap block id pg create index")
+	}
+
+	return nil
+}
+
+func CreateTables(ctx context.Context, chDB *ch.DB, pgDB *bun.DB) error {
+	_, err := chDB.NewCreateTable().
+		IfNotExists().
+		Engine("ReplacingMergeTree").
+		Model(&core.Swap{}).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap ch create table")
+	}
+
+	_, err = pgDB.NewCreateTable().
+		Model(&core.Swap{}).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "swap pg create table")
+	}
+
+	if err := createIndexes(ctx, pgDB); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) AddSwaps(ctx context.Context, tx bun.Tx, swaps []*core.Swap) error {
+	if len(swaps) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewInsert().Model(&swaps).
+		On("CONFLICT (tx_hash) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.ch.NewInsert().Model(&swaps).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteSwaps(ctx context.Context, tx bun.Tx, blocks []core.BlockID) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	in := repository.BlockTuples(blocks)
+
+	_, err := tx.NewDelete().Model((*core.Swap)(nil)).
+		Where("(workchain, shard, block_seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete pg swaps")
+	}
+
+	_, err = r.ch.ExecContext(ctx, "ALTER TABLE swaps DELETE WHERE (workchain, shard, block_seq_no) IN (?)", ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "delete ch swaps")
+	}
+
+	return nil
+}
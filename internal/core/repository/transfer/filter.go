@@ -0,0 +1,123 @@
+package transfer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/filter"
+)
+
+func (r *Repository) filterTransfers(ctx context.Context, req *filter.JettonTransfersReq) (ret []*core.JettonTransfer, err error) {
+	q := r.pg.NewSelect().Model(&ret)
+
+	if len(req.MsgHash) > 0 {
+		q = q.Where("msg_hash = ?", req.MsgHash)
+	}
+	if len(req.TraceID) > 0 {
+		q = q.Where("trace_id = ?", req.TraceID)
+	}
+	if req.MinterAddress != nil {
+		q = q.Where("minter_address = ?", req.MinterAddress)
+	}
+	if len(req.SrcOwners) > 0 {
+		q = q.Where("src_owner in (?)", bun.In(req.SrcOwners))
+	}
+	if len(req.DstOwners) > 0 {
+		q = q.Where("dst_owner in (?)", bun.In(req.DstOwners))
+	}
+	if len(req.Owners) > 0 {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("src_owner in (?)", bun.In(req.Owners)).
+				WhereOr("dst_owner in (?)", bun.In(req.Owners))
+		})
+	}
+	if req.QueryID != nil {
+		q = q.Where("query_id = ?", *req.QueryID)
+	}
+	if req.Status != "" {
+		q = q.Where("status = ?", req.Status)
+	}
+
+	if req.AfterTxLT != nil {
+		if req.Order == "ASC" {
+			q = q.Where("created_lt > ?", req.AfterTxLT)
+		} else {
+			q = q.Where("created_lt < ?", req.AfterTxLT)
+		}
+	}
+
+	if req.Order != "" {
+		q = q.Order("created_lt " + strings.ToUpper(req.Order))
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 3
+	}
+	q = q.Limit(req.Limit)
+
+	err = q.Scan(ctx)
+	return ret, err
+}
+
+func (r *Repository) countTransfers(ctx context.Context, req *filter.JettonTransfersReq) (int, error) {
+	q := r.ch.NewSelect().
+		Model((*core.JettonTransfer)(nil))
+
+	if len(req.MsgHash) > 0 {
+		q = q.Where("msg_hash = ?", req.MsgHash)
+	}
+	if len(req.TraceID) > 0 {
+		q = q.Where("trace_id = ?", req.TraceID)
+	}
+	if req.MinterAddress != nil {
+		q = q.Where("minter_address = ?", req.MinterAddress)
+	}
+	if len(req.SrcOwners) > 0 {
+		q = q.Where("src_owner in (?)", ch.In(req.SrcOwners))
+	}
+	if len(req.DstOwners) > 0 {
+		q = q.Where("dst_owner in (?)", ch.In(req.DstOwners))
+	}
+	if len(req.Owners) > 0 {
+		q = q.Where("(src_owner in (?) OR dst_owner in (?))", ch.In(req.Owners), ch.In(req.Owners))
+	}
+	if req.QueryID != nil {
+		q = q.Where("query_id = ?", *req.QueryID)
+	}
+	if req.Status != "" {
+		q = q.Where("status = ?", req.Status)
+	}
+
+	return q.Count(ctx)
+}
+
+func (r *Repository) FilterJettonTransfers(ctx context.Context, req *filter.JettonTransfersReq) (*filter.JettonTransfersRes, error) {
+	var (
+		res = new(filter.JettonTransfersRes)
+		err error
+	)
+
+	res.Rows, err = r.filterTransfers(ctx, req)
+	if err != nil {
+		return res, err
+	}
+
+	switch {
+	case len(res.Rows) == 0:
+
+	case len(req.MsgHash) > 0:
+		res.Total = len(res.Rows)
+
+	case req.Count:
+		res.Total, err = r.countTransfers(ctx, req)
+		if err != nil {
+			return res, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,72 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/aggregate/history"
+)
+
+func (r *Repository) AggregateJettonTransfersHistory(ctx context.Context, req *history.JettonTransfersReq) (*history.JettonTransfersRes, error) {
+	var res history.JettonTransfersRes
+	var bigIntRes bool
+
+	q := r.ch.NewSelect().Model((*core.JettonTransfer)(nil))
+
+	if req.MinterAddress != nil {
+		q = q.Where("minter_address = ?", req.MinterAddress)
+	}
+	if len(req.SrcOwners) > 0 {
+		q = q.Where("src_owner in (?)", ch.In(req.SrcOwners))
+	}
+	if len(req.DstOwners) > 0 {
+		q = q.Where("dst_owner in (?)", ch.In(req.DstOwners))
+	}
+	if req.Status != "" {
+		q = q.Where("status = ?", req.Status)
+	}
+
+	switch req.Metric {
+	case history.JettonTransferCount:
+		q = q.ColumnExpr("count() as value")
+	case history.JettonTransferAmountSum:
+		if req.MinterAddress == nil {
+			return nil, errors.Wrap(core.ErrInvalidArg, "minter address must be set to sum amounts of the same jetton")
+		}
+		q, bigIntRes = q.ColumnExpr("sum(amount) as value"), true
+	default:
+		return nil, errors.Wrapf(core.ErrInvalidArg, "invalid jetton transfer metric %s", req.Metric)
+	}
+
+	rounding, err := history.GetRoundingFunction(req.Interval)
+	if err != nil {
+		return nil, err
+	}
+	q = q.ColumnExpr(fmt.Sprintf(rounding, "created_at") + " as timestamp")
+	q = q.Group("timestamp")
+
+	if !req.From.IsZero() {
+		q = q.Where("created_at > ?", req.From)
+	}
+	if !req.To.IsZero() {
+		q = q.Where("created_at < ?", req.To)
+	}
+
+	q = q.Order("timestamp ASC")
+
+	if bigIntRes {
+		if err := q.Scan(ctx, &res.BigIntRes); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := q.Scan(ctx, &res.CountRes); err != nil {
+			return nil, err
+		}
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,153 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/internal/core"
+	"github.com/stepandra/anton/internal/core/repository"
+)
+
+var _ repository.JettonTransfer = (*Repository)(nil)
+
+type Repository struct {
+	ch *ch.DB
+	pg *bun.DB
+}
+
+func NewRepository(ck *ch.DB, pg *bun.DB) *Repository {
+	return &Repository{ch: ck, pg: pg}
+}
+
+func createIndexes(ctx context.Context, pgDB *bun.DB) error {
+	var err error
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.JettonTransfer{}).
+		Using("BTREE").
+		Column("created_lt").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer created_lt pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.JettonTransfer{}).
+		Using("HASH").
+		Column("minter_address").
+		Where("minter_address IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer minter address pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.JettonTransfer{}).
+		Using("HASH").
+		Column("src_owner").
+		Where("src_owner IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer src owner pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.JettonTransfer{}).
+		Using("HASH").
+		Column("dst_owner").
+		Where("dst_owner IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer dst owner pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.JettonTransfer{}).
+		Using("HASH").
+		Column("trace_id").
+		Where("trace_id IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer trace id pg create index")
+	}
+
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.JettonTransfer{}).
+		Column("workchain", "shard", "block_seq_no").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer block id pg create index")
+	}
+
+	return nil
+}
+
+func CreateTables(ctx context.Context, chDB *ch.DB, pgDB *bun.DB) error {
+	_, err := chDB.NewCreateTable().
+		IfNotExists().
+		Engine("ReplacingMergeTree").
+		Model(&core.JettonTransfer{}).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer ch create table")
+	}
+
+	_, err = pgDB.NewCreateTable().
+		Model(&core.JettonTransfer{}).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "jetton transfer pg create table")
+	}
+
+	if err := createIndexes(ctx, pgDB); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) AddJettonTransfers(ctx context.Context, tx bun.Tx, transfers []*core.JettonTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewInsert().Model(&transfers).
+		On("CONFLICT (msg_hash) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.ch.NewInsert().Model(&transfers).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteJettonTransfers(ctx context.Context, tx bun.Tx, blocks []core.BlockID) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	in := repository.BlockTuples(blocks)
+
+	_, err := tx.NewDelete().Model((*core.JettonTransfer)(nil)).
+		Where("(workchain, shard, block_seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete pg jetton transfers")
+	}
+
+	_, err = r.ch.ExecContext(ctx, "ALTER TABLE jetton_transfers DELETE WHERE (workchain, shard, block_seq_no) IN (?)", ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "delete ch jetton transfers")
+	}
+
+	return nil
+}
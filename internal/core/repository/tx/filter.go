@@ -34,6 +34,9 @@ func (r *Repository) filterTx(ctx context.Context, req *filter.TransactionsReq)
 	if len(req.InMsgHash) > 0 {
 		q = q.Where("transaction.in_msg_hash = ?", req.InMsgHash)
 	}
+	if len(req.TraceID) > 0 {
+		q = q.Where("transaction.trace_id = ?", req.TraceID)
+	}
 	if len(req.Addresses) > 0 {
 		q = q.Where("transaction.address in (?)", bun.In(req.Addresses))
 	}
@@ -80,6 +83,9 @@ func (r *Repository) countTx(ctx context.Context, req *filter.TransactionsReq) (
 	if len(req.InMsgHash) > 0 {
 		q = q.Where("in_msg_hash = ?", req.InMsgHash)
 	}
+	if len(req.TraceID) > 0 {
+		q = q.Where("trace_id = ?", req.TraceID)
+	}
 	if len(req.Addresses) > 0 {
 		q = q.Where("address in (?)", ch.In(req.Addresses))
 	}
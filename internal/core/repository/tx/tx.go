@@ -71,6 +71,16 @@ func createIndexes(ctx context.Context, pgDB *bun.DB) error {
 		return errors.Wrap(err, "tx in_msg hash pg create index")
 	}
 
+	_, err = pgDB.NewCreateIndex().
+		Model(&core.Transaction{}).
+		Using("HASH").
+		Column("trace_id").
+		Where("trace_id IS NOT NULL").
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "tx trace id pg create index")
+	}
+
 	return nil
 }
 
@@ -117,3 +127,25 @@ func (r *Repository) AddTransactions(ctx context.Context, tx bun.Tx, transaction
 
 	return nil
 }
+
+func (r *Repository) DeleteTransactions(ctx context.Context, tx bun.Tx, blocks []core.BlockID) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	in := repository.BlockTuples(blocks)
+
+	_, err := tx.NewDelete().Model((*core.Transaction)(nil)).
+		Where("(workchain, shard, block_seq_no) IN (?)", bun.Safe(in)).
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete pg transactions")
+	}
+
+	_, err = r.ch.ExecContext(ctx, "ALTER TABLE transactions DELETE WHERE (workchain, shard, block_seq_no) IN (?)", ch.Safe(in))
+	if err != nil {
+		return errors.Wrap(err, "delete ch transactions")
+	}
+
+	return nil
+}
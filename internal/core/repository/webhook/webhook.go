@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/uptrace/bun"
+
+	"github.com/stepandra/anton/internal/core"
+)
+
+var _ core.WebhookRepository = (*Repository)(nil)
+
+type Repository struct {
+	pg *bun.DB
+}
+
+func NewRepository(db *bun.DB) *Repository {
+	return &Repository{pg: db}
+}
+
+func CreateTables(ctx context.Context, pgDB *bun.DB) error {
+	_, err := pgDB.NewCreateTable().
+		Model(&core.Webhook{}).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "webhook pg create table")
+	}
+
+	_, err = pgDB.NewCreateTable().
+		Model(&core.WebhookDeadLetter{}).
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return errors.Wrap(err, "webhook dead letter pg create table")
+	}
+
+	return nil
+}
+
+func (r *Repository) AddWebhook(ctx context.Context, w *core.Webhook) error {
+	w.ID = 0
+	w.CreatedAt = time.Now()
+	_, err := r.pg.NewInsert().Model(w).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetWebhooks(ctx context.Context) ([]*core.Webhook, error) {
+	var ret []*core.Webhook
+
+	err := r.pg.NewSelect().Model(&ret).Order("id").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) DeleteWebhook(ctx context.Context, id int) error {
+	res, err := r.pg.NewDelete().Model((*core.Webhook)(nil)).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errors.Wrapf(core.ErrNotFound, "no webhook with %d id", id)
+	}
+
+	return nil
+}
+
+func (r *Repository) AddDeadLetter(ctx context.Context, l *core.WebhookDeadLetter) error {
+	l.ID = 0
+	l.CreatedAt = time.Now()
+	_, err := r.pg.NewInsert().Model(l).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetDeadLetters(ctx context.Context, webhookID int, limit int) ([]*core.WebhookDeadLetter, error) {
+	var ret []*core.WebhookDeadLetter
+
+	q := r.pg.NewSelect().Model(&ret)
+	if webhookID != 0 {
+		q = q.Where("webhook_id = ?", webhookID)
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	err := q.Order("id DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/uptrace/bun"
 
 	"github.com/stepandra/anton/abi"
@@ -39,6 +40,11 @@ const (
 	// and re-execute the changed get method.
 	UpdGetMethod RescanTaskType = "upd_get_method"
 
+	// UpdContractData task is invoked when the contract data schema of the interface changes.
+	// It iterates through all account states associated with the specified contract name
+	// and decodes the account data once again.
+	UpdContractData RescanTaskType = "upd_contract_data"
+
 	// UpdOperation task parses contract messages.
 	// It iterates through all messages with specified operation id,
 	// directed to (or originating from, in the case of outgoing operations) the given contract
@@ -49,16 +55,64 @@ const (
 	DelOperation RescanTaskType = "del_operation"
 )
 
+// RescanTaskStatus is computed from the task state and is not stored in the database.
+type RescanTaskStatus string
+
+const (
+	RescanPending   RescanTaskStatus = "pending"
+	RescanRunning   RescanTaskStatus = "running"
+	RescanFinished  RescanTaskStatus = "finished"
+	RescanCancelled RescanTaskStatus = "cancelled"
+)
+
+// RescanScope limits account states and messages rescanned by the task.
+// Zero fields do not limit anything, so the empty scope covers the whole history.
+type RescanScope struct {
+	// masterchain blocks range, shard blocks are matched by the masterchain block they are committed in
+	FromMasterSeqNo uint32 `bun:"from_master_seq_no,nullzero" json:"from_master_seq_no,omitempty"`
+	ToMasterSeqNo   uint32 `bun:"to_master_seq_no,nullzero" json:"to_master_seq_no,omitempty"`
+
+	// account state update time or message creation time
+	FromTime time.Time `bun:"from_time,type:timestamp without time zone,nullzero" json:"from_time,omitempty"`
+	ToTime   time.Time `bun:"to_time,type:timestamp without time zone,nullzero" json:"to_time,omitempty"`
+
+	// rescanned contract addresses
+	Addresses []*addr.Address `bun:"addresses,type:bytea[]" json:"addresses,omitempty"`
+
+	// Blocks are resolved from the masterchain blocks range before the task run
+	Blocks []*BlockRange `bun:"-" json:"-"`
+}
+
+// HasBlocks tells if the scope is limited by masterchain blocks range.
+func (s *RescanScope) HasBlocks() bool {
+	return s.FromMasterSeqNo != 0 || s.ToMasterSeqNo != 0
+}
+
+// Validate checks that the scope ranges are not reversed.
+func (s *RescanScope) Validate() error {
+	if s.ToMasterSeqNo != 0 && s.FromMasterSeqNo > s.ToMasterSeqNo {
+		return errors.Wrapf(ErrInvalidArg, "from master seq_no %d is greater than to master seq_no %d", s.FromMasterSeqNo, s.ToMasterSeqNo)
+	}
+	if !s.ToTime.IsZero() && s.FromTime.After(s.ToTime) {
+		return errors.Wrapf(ErrInvalidArg, "from time %s is after to time %s", s.FromTime, s.ToTime)
+	}
+	return nil
+}
+
 type RescanTask struct {
 	bun.BaseModel `bun:"table:rescan_tasks" json:"-"`
 
-	ID       int            `bun:",pk,autoincrement"`
-	Finished bool           `bun:"finished,notnull"`
-	Type     RescanTaskType `bun:"type:rescan_task_type,notnull"`
+	ID       int            `bun:",pk,autoincrement" json:"id"`
+	Finished bool           `bun:"finished,notnull" json:"finished"`
+	Type     RescanTaskType `bun:"type:rescan_task_type,notnull" json:"type"`
+
+	// tasks with higher priority are run first, tasks with the same priority are run in the order of creation
+	Priority  int  `bun:"priority,notnull" json:"priority"`
+	Cancelled bool `bun:"cancelled,notnull" json:"cancelled"`
 
 	// contract being rescanned
 	ContractName abi.ContractName   `bun:",notnull" json:"contract_name"`
-	Contract     *ContractInterface `bun:"rel:has-one,join:contract_name=name" json:"contract_interface"`
+	Contract     *ContractInterface `bun:"rel:has-one,join:contract_name=name" json:"contract_interface,omitempty"`
 
 	// for get-method update
 	ChangedGetMethods []string `bun:"type:text[],array" json:"changed_get_methods,omitempty"`
@@ -67,18 +121,76 @@ type RescanTask struct {
 	MessageType MessageType        `bun:"type:message_type,nullzero" json:"message_type,omitempty"`
 	Outgoing    bool               `bun:",nullzero" json:"outgoing,omitempty"` // if operation is going from contract
 	OperationID uint32             `bun:",nullzero" json:"operation_id,omitempty"`
-	Operation   *ContractOperation `bun:"rel:has-one,join:contract_name=contract_name,join:outgoing=outgoing,join:operation_id=operation_id" json:"contract_operation"`
+	Operation   *ContractOperation `bun:"rel:has-one,join:contract_name=contract_name,join:outgoing=outgoing,join:operation_id=operation_id" json:"contract_operation,omitempty"`
+
+	// optional limits of the rescanned rows
+	Scope RescanScope `bun:"embed:scope_" json:"scope"`
 
 	// checkpoint
 	LastAddress *addr.Address `bun:"type:bytea" json:"last_address"`
 	LastTxLt    uint64        `bun:"type:bigint" json:"last_tx_lt"`
 
+	// progress: Total is the number of rows matched on the task start, Scanned is the number of rows processed
+	Scanned   int       `bun:"scanned,notnull" json:"scanned"`
+	Total     int       `bun:"total,notnull" json:"total"`
+	StartedAt time.Time `bun:"type:timestamp without time zone,nullzero" json:"started_at,omitempty"`
+
+	// Error is the last error occurred while running the task, the task is retried after it
+	Error string `bun:",nullzero" json:"error,omitempty"`
+
+	Status   RescanTaskStatus `bun:"-" json:"status"`
+	Progress float64          `bun:"-" json:"progress"`
+	ETA      *time.Time       `bun:"-" json:"eta,omitempty"`
+
 	UpdatedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"updated_at"`
 	CreatedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"created_at"`
 }
 
+// SetProgress fills task status, progress and the estimated time of finishing.
+// ETA is extrapolated from the scanning speed since the task start.
+func (t *RescanTask) SetProgress() {
+	switch {
+	case t.Finished:
+		t.Status, t.Progress = RescanFinished, 1
+		return
+	case t.Cancelled:
+		t.Status = RescanCancelled
+	case t.StartedAt.IsZero():
+		t.Status = RescanPending
+	default:
+		t.Status = RescanRunning
+	}
+
+	if t.Total == 0 || t.Scanned == 0 {
+		return
+	}
+	t.Progress = float64(t.Scanned) / float64(t.Total)
+	if t.Progress > 1 {
+		t.Progress = 1 // new rows could be matched after the task start
+	}
+	if t.Status != RescanRunning {
+		return
+	}
+
+	elapsed := t.UpdatedAt.Sub(t.StartedAt)
+	eta := t.UpdatedAt.Add(time.Duration(float64(elapsed) * (1 - t.Progress) / t.Progress))
+	t.ETA = &eta
+}
+
 type RescanRepository interface {
-	AddRescanTask(ctx context.Context, task *RescanTask) error
-	GetUnfinishedRescanTask(context.Context) (bun.Tx, *RescanTask, error)
+	AddRescanTask(ctx context.Context, tx bun.Tx, task *RescanTask) error
+
+	// GetRescanTasks returns unfinished tasks in the order they are run, or all tasks if all is set.
+	GetRescanTasks(ctx context.Context, all bool) ([]*RescanTask, error)
+
+	// GetUnfinishedRescanTask locks the unfinished task with the highest priority.
+	// It skips tasks locked by other transactions and tasks of the excluded contracts.
+	GetUnfinishedRescanTask(ctx context.Context, exclude []abi.ContractName) (bun.Tx, *RescanTask, error)
 	SetRescanTask(context.Context, bun.Tx, *RescanTask) error
+	SetRescanTaskError(ctx context.Context, id int, err error) error
+
+	CancelRescanTask(ctx context.Context, id int) error
+	// RetryRescanTask restarts the task from the beginning.
+	RetryRescanTask(ctx context.Context, id int) error
+	SetRescanTaskPriority(ctx context.Context, id int, priority int) error
 }
@@ -63,7 +63,7 @@ func AddressState(a *addr.Address, t []abi.ContractName, minter *addr.Address) *
 				Returns: []any{true},
 			}},
 		},
-		FTWalletData:   core.FTWalletData{JettonBalance: BigInt()},
+		TokenBalance:   BigInt(),
 		NFTContentData: core.NFTContentData{ContentImageData: []byte{}}, // TODO: i dunno why ",nullzero" tag does not work in pg
 		UpdatedAt:      timestamp,
 	}
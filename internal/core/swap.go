@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/addr"
+)
+
+type DEX string
+
+const (
+	DeDustV2 DEX = "dedust_v2"
+	StonFi   DEX = "stonfi"
+)
+
+// Swap is a trade executed by a DEX pool.
+// Assets are jetton minters, empty asset address stands for native TON.
+// STON.fi router jetton wallets are resolved to their minters, and proxy TON is stored as native TON.
+type Swap struct {
+	ch.CHModel    `ch:"swaps,partition:toYYYYMM(created_at)" json:"-"`
+	bun.BaseModel `bun:"table:swaps" json:"-"`
+
+	TxHash  []byte `ch:",pk" bun:"type:bytea,pk,notnull" json:"tx_hash"` // transaction of the pool
+	TraceID []byte `bun:"type:bytea" json:"trace_id,omitempty"`
+
+	DEX         DEX          `ch:",lc" bun:"type:text,notnull" json:"dex"`
+	PoolAddress addr.Address `ch:"type:String" bun:"type:bytea,notnull" json:"pool_address"`
+	Trader      addr.Address `ch:"type:String" bun:"type:bytea,nullzero" json:"trader,omitempty"`
+
+	AssetIn   addr.Address `ch:"type:String" bun:"type:bytea,nullzero" json:"asset_in,omitempty"`
+	AssetOut  addr.Address `ch:"type:String" bun:"type:bytea,nullzero" json:"asset_out,omitempty"`
+	AmountIn  *bunbig.Int  `ch:"type:UInt256" bun:"type:numeric,notnull" json:"amount_in"`
+	AmountOut *bunbig.Int  `ch:"type:UInt256" bun:"type:numeric,notnull" json:"amount_out"`
+
+	// Price is the amount of the first asset of the pair paid for one unit of the second asset.
+	// Assets are ordered by address bytes, so native TON always goes first.
+	Price float64 `bun:"type:double precision,notnull" json:"price"`
+
+	Workchain  int32  `bun:"type:integer,notnull" json:"workchain"`
+	Shard      int64  `bun:"type:bigint,notnull" json:"shard"`
+	BlockSeqNo uint32 `bun:"type:integer,notnull" json:"block_seq_no"`
+
+	CreatedLT uint64    `bun:",notnull" json:"created_lt"`
+	CreatedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"created_at"`
+}
+
+type SwapRepository interface {
+	AddSwaps(ctx context.Context, tx bun.Tx, swaps []*Swap) error
+
+	// DeleteSwaps removes swaps executed in the given blocks.
+	DeleteSwaps(ctx context.Context, tx bun.Tx, blocks []BlockID) error
+}
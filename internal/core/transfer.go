@@ -0,0 +1,60 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/extra/bunbig"
+	"github.com/uptrace/go-clickhouse/ch"
+
+	"github.com/stepandra/anton/addr"
+)
+
+type JettonTransferStatus string
+
+const (
+	JettonTransferSuccess JettonTransferStatus = "success"
+	JettonTransferBounced JettonTransferStatus = "bounced"
+)
+
+// JettonTransfer is built from jetton_internal_transfer message received by the destination jetton wallet.
+// Owners and minter are taken from the parsed message and the states of jetton wallets.
+type JettonTransfer struct {
+	ch.CHModel    `ch:"jetton_transfers,partition:toYYYYMM(created_at)" json:"-"`
+	bun.BaseModel `bun:"table:jetton_transfers" json:"-"`
+
+	MsgHash []byte `ch:",pk" bun:"type:bytea,pk,notnull" json:"msg_hash"`
+	TraceID []byte `bun:"type:bytea" json:"trace_id,omitempty"`
+
+	MinterAddress addr.Address `ch:"type:String" bun:"type:bytea,nullzero" json:"minter_address,omitempty"`
+
+	SrcWallet addr.Address `ch:"type:String" bun:"type:bytea,notnull" json:"src_wallet"`
+	SrcOwner  addr.Address `ch:"type:String" bun:"type:bytea,nullzero" json:"src_owner,omitempty"`
+	DstWallet addr.Address `ch:"type:String" bun:"type:bytea,notnull" json:"dst_wallet"`
+	DstOwner  addr.Address `ch:"type:String" bun:"type:bytea,nullzero" json:"dst_owner,omitempty"`
+
+	Amount           *bunbig.Int `ch:"type:UInt256" bun:"type:numeric,notnull" json:"amount"`
+	QueryID          uint64      `bun:"type:numeric,notnull" json:"query_id"`
+	ForwardTonAmount *bunbig.Int `ch:"type:UInt256" bun:"type:numeric" json:"forward_ton_amount,omitempty"`
+	ForwardPayload   []byte      `bun:"type:bytea" json:"forward_payload,omitempty"`
+
+	Status JettonTransferStatus `ch:",lc" bun:"type:text,notnull" json:"status"`
+
+	// Workchain, Shard and BlockSeqNo identify the block of the destination transaction.
+	Workchain  int32  `bun:"type:integer,notnull" json:"workchain"`
+	Shard      int64  `bun:"type:bigint,notnull" json:"shard"`
+	BlockSeqNo uint32 `bun:"type:integer,notnull" json:"block_seq_no"`
+	DstTxLT    uint64 `bun:",notnull" json:"dst_tx_lt"`
+
+	CreatedLT  uint64    `bun:",notnull" json:"created_lt"`
+	CreatedAt  time.Time `bun:"type:timestamp without time zone,notnull" json:"created_at"`
+	ReceivedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"received_at"`
+}
+
+type JettonTransferRepository interface {
+	AddJettonTransfers(ctx context.Context, tx bun.Tx, transfers []*JettonTransfer) error
+
+	// DeleteJettonTransfers removes transfers received in the given blocks.
+	DeleteJettonTransfers(ctx context.Context, tx bun.Tx, blocks []BlockID) error
+}
@@ -23,6 +23,9 @@ type Transaction struct {
 	CreatedLT uint64        `ch:",pk" bun:",notnull" json:"created_lt"`
 	Account   *AccountState `ch:"-" bun:"rel:has-one,join:address=address,join:created_lt=last_tx_lt" json:"account"`
 
+	// TraceID is the hash of the transaction, which started the chain of messages.
+	TraceID []byte `bun:"type:bytea" json:"trace_id,omitempty"`
+
 	Workchain  int32  `bun:"type:integer,notnull" json:"workchain"`
 	Shard      int64  `bun:"type:bigint,notnull" json:"shard"`
 	BlockSeqNo uint32 `bun:"type:integer,notnull" json:"block_seq_no"`
@@ -70,4 +73,7 @@ func (tx *Transaction) LoadDescription() error { // TODO: optionally load descri
 
 type TransactionRepository interface {
 	AddTransactions(ctx context.Context, tx bun.Tx, transactions []*Transaction) error
+
+	// DeleteTransactions removes transactions included in the given blocks.
+	DeleteTransactions(ctx context.Context, tx bun.Tx, blocks []BlockID) error
 }
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/stepandra/anton/abi"
+	"github.com/stepandra/anton/addr"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookMessage     WebhookEvent = "message"
+	WebhookTransaction WebhookEvent = "transaction"
+)
+
+// Webhook describes an endpoint, which receives indexed data matching the filter.
+// Empty filter fields match everything.
+type Webhook struct {
+	bun.BaseModel `bun:"table:webhooks" json:"-"`
+
+	ID     int    `bun:",pk,autoincrement" json:"id"`
+	URL    string `bun:",notnull" json:"url"`
+	Secret string `bun:",notnull" json:"-"` // payloads are signed with HMAC-SHA256 using this secret
+
+	Events []WebhookEvent `bun:"type:text[],array" json:"events"`
+
+	// messages are matched by source or destination address,
+	// transactions are matched by account address
+	Addresses      []*addr.Address    `bun:"type:bytea[]" json:"addresses,omitempty"`
+	DstContracts   []abi.ContractName `bun:"type:text[],array" json:"dst_contracts,omitempty"`
+	OperationNames []string           `bun:"type:text[],array" json:"operation_names,omitempty"`
+
+	CreatedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"created_at"`
+}
+
+// WebhookDeadLetter is a payload, which was not delivered after all retries.
+type WebhookDeadLetter struct {
+	bun.BaseModel `bun:"table:webhook_dead_letters" json:"-"`
+
+	ID        int             `bun:",pk,autoincrement" json:"id"`
+	WebhookID int             `bun:",notnull" json:"webhook_id"`
+	Payload   json.RawMessage `bun:"type:jsonb,notnull" json:"payload"`
+	Attempts  int             `bun:",notnull" json:"attempts"`
+	Error     string          `bun:",notnull" json:"error"`
+
+	CreatedAt time.Time `bun:"type:timestamp without time zone,notnull" json:"created_at"`
+}
+
+type WebhookRepository interface {
+	AddWebhook(context.Context, *Webhook) error
+	GetWebhooks(context.Context) ([]*Webhook, error)
+	DeleteWebhook(ctx context.Context, id int) error
+
+	AddDeadLetter(context.Context, *WebhookDeadLetter) error
+	GetDeadLetters(ctx context.Context, webhookID int, limit int) ([]*WebhookDeadLetter, error)
+}
@@ -12,10 +12,14 @@ import (
 	"github.com/stepandra/anton/cmd/archive"
 	"github.com/stepandra/anton/cmd/contract"
 	"github.com/stepandra/anton/cmd/db"
+	"github.com/stepandra/anton/cmd/emulate"
 	"github.com/stepandra/anton/cmd/indexer"
 	"github.com/stepandra/anton/cmd/label"
+	"github.com/stepandra/anton/cmd/metadata"
+	"github.com/stepandra/anton/cmd/parse"
 	"github.com/stepandra/anton/cmd/rescan"
 	"github.com/stepandra/anton/cmd/web"
+	"github.com/stepandra/anton/cmd/webhook"
 )
 
 func init() {
@@ -42,6 +46,10 @@ func main() {
 			contract.Command,
 			label.Command,
 			rescan.Command,
+			webhook.Command,
+			metadata.Command,
+			emulate.Command,
+			parse.Command,
 		},
 	}
 	if err := app.Run(os.Args); err != nil {
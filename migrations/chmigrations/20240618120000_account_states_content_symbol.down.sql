@@ -0,0 +1,5 @@
+ALTER TABLE account_states DROP COLUMN content_decimals;
+
+--migration:split
+
+ALTER TABLE account_states DROP COLUMN content_symbol;
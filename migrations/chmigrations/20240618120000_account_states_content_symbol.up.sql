@@ -0,0 +1,5 @@
+ALTER TABLE account_states ADD COLUMN content_symbol String;
+
+--migration:split
+
+ALTER TABLE account_states ADD COLUMN content_decimals Nullable(UInt8);
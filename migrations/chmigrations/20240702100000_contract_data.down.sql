@@ -0,0 +1 @@
+ALTER TABLE account_states DROP COLUMN contract_data;
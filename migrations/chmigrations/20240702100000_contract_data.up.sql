@@ -0,0 +1 @@
+ALTER TABLE account_states ADD COLUMN contract_data String;
@@ -0,0 +1,5 @@
+ALTER TABLE messages DROP COLUMN trace_id;
+
+--migration:split
+
+ALTER TABLE transactions DROP COLUMN trace_id;
@@ -0,0 +1,5 @@
+ALTER TABLE transactions ADD COLUMN trace_id String;
+
+--migration:split
+
+ALTER TABLE messages ADD COLUMN trace_id String;
@@ -0,0 +1 @@
+DROP TABLE jetton_transfers;
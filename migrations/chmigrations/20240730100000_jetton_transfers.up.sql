@@ -0,0 +1,25 @@
+CREATE TABLE jetton_transfers
+(
+    msg_hash String,
+    trace_id String,
+    minter_address String,
+    src_wallet String,
+    src_owner String,
+    dst_wallet String,
+    dst_owner String,
+    amount UInt256,
+    query_id UInt64,
+    forward_ton_amount UInt256,
+    forward_payload String,
+    status LowCardinality(String),
+    workchain Int32,
+    shard Int64,
+    block_seq_no UInt32,
+    dst_tx_lt UInt64,
+    created_lt UInt64,
+    created_at DateTime,
+    received_at DateTime
+)
+ENGINE = ReplacingMergeTree
+PARTITION BY toYYYYMM(created_at)
+ORDER BY msg_hash;
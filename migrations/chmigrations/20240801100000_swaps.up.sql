@@ -0,0 +1,21 @@
+CREATE TABLE swaps
+(
+    tx_hash String,
+    trace_id String,
+    dex LowCardinality(String),
+    pool_address String,
+    trader String,
+    asset_in String,
+    asset_out String,
+    amount_in UInt256,
+    amount_out UInt256,
+    price Float64,
+    workchain Int32,
+    shard Int64,
+    block_seq_no UInt32,
+    created_lt UInt64,
+    created_at DateTime
+)
+ENGINE = ReplacingMergeTree
+PARTITION BY toYYYYMM(created_at)
+ORDER BY tx_hash;
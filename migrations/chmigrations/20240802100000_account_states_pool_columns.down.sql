@@ -0,0 +1,17 @@
+ALTER TABLE account_states DROP COLUMN reserve1;
+
+--migration:split
+
+ALTER TABLE account_states DROP COLUMN reserve0;
+
+--migration:split
+
+ALTER TABLE account_states DROP COLUMN asset1_address;
+
+--migration:split
+
+ALTER TABLE account_states DROP COLUMN asset0_address;
+
+--migration:split
+
+ALTER TABLE account_states DROP COLUMN token_supply;
@@ -0,0 +1,17 @@
+ALTER TABLE account_states ADD COLUMN token_supply UInt256;
+
+--migration:split
+
+ALTER TABLE account_states ADD COLUMN asset0_address String;
+
+--migration:split
+
+ALTER TABLE account_states ADD COLUMN asset1_address String;
+
+--migration:split
+
+ALTER TABLE account_states ADD COLUMN reserve0 UInt256;
+
+--migration:split
+
+ALTER TABLE account_states ADD COLUMN reserve1 UInt256;
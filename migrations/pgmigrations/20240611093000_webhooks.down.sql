@@ -0,0 +1,7 @@
+SET statement_timeout = 0;
+
+DROP TABLE webhook_dead_letters;
+
+--bun:split
+
+DROP TABLE webhooks;
@@ -0,0 +1,30 @@
+SET statement_timeout = 0;
+
+CREATE TABLE webhooks (
+    id serial NOT NULL,
+    url text NOT NULL,
+    secret text NOT NULL,
+    events text[],
+    addresses bytea[],
+    dst_contracts text[],
+    operation_names text[],
+    created_at timestamp without time zone NOT NULL,
+    CONSTRAINT webhooks_pkey PRIMARY KEY (id)
+);
+
+--bun:split
+
+CREATE TABLE webhook_dead_letters (
+    id serial NOT NULL,
+    webhook_id integer NOT NULL,
+    payload jsonb NOT NULL,
+    attempts integer NOT NULL,
+    error text NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    CONSTRAINT webhook_dead_letters_pkey PRIMARY KEY (id),
+    CONSTRAINT webhook_dead_letters_webhook_id_fkey FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+--bun:split
+
+CREATE INDEX webhook_dead_letters_webhook_id_idx ON webhook_dead_letters USING btree (webhook_id);
@@ -0,0 +1,13 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+DROP INDEX account_states_content_symbol_idx;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN content_decimals;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN content_symbol;
@@ -0,0 +1,13 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN content_symbol character varying;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN content_decimals smallint;
+
+--bun:split
+
+CREATE INDEX account_states_content_symbol_idx ON account_states USING btree (content_symbol) WHERE (content_symbol IS NOT NULL);
@@ -0,0 +1,7 @@
+SET statement_timeout = 0;
+
+DROP TABLE token_metadata_fetches;
+
+--bun:split
+
+DROP TABLE token_metadata;
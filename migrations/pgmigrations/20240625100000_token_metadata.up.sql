@@ -0,0 +1,30 @@
+SET statement_timeout = 0;
+
+CREATE TABLE token_metadata (
+    uri text NOT NULL,
+    content_hash bytea NOT NULL,
+    document jsonb NOT NULL,
+    name text,
+    description text,
+    image text,
+    symbol text,
+    decimals smallint,
+    fetched_at timestamp without time zone NOT NULL,
+    CONSTRAINT token_metadata_pkey PRIMARY KEY (uri, content_hash)
+);
+
+--bun:split
+
+CREATE TABLE token_metadata_fetches (
+    uri text NOT NULL,
+    content_hash bytea,
+    attempts integer NOT NULL,
+    error text,
+    fetched_at timestamp without time zone,
+    next_fetch_at timestamp without time zone NOT NULL,
+    CONSTRAINT token_metadata_fetches_pkey PRIMARY KEY (uri)
+);
+
+--bun:split
+
+CREATE INDEX token_metadata_fetches_next_fetch_at_idx ON token_metadata_fetches USING btree (next_fetch_at);
@@ -0,0 +1,11 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+-- enum values cannot be dropped, so 'upd_contract_data' rescan task type is kept
+
+ALTER TABLE account_states DROP COLUMN contract_data;
+
+--bun:split
+
+ALTER TABLE contract_interfaces DROP COLUMN contract_data;
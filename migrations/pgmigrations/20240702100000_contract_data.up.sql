@@ -0,0 +1,13 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE contract_interfaces ADD COLUMN contract_data jsonb;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN contract_data jsonb;
+
+--bun:split
+
+ALTER TYPE rescan_task_type ADD VALUE IF NOT EXISTS 'upd_contract_data';
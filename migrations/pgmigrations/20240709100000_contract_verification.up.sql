@@ -0,0 +1,5 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE contract_interfaces ADD COLUMN verification jsonb;
@@ -0,0 +1,6 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+UPDATE contract_interfaces SET verification = NULL
+WHERE name IN ('jetton_wallet', 'nft_item', 'dedust_v2_pool', 'stonfi_pool');
@@ -0,0 +1,33 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+-- verification rules of known interfaces reference the dedust pool 'is_stable' return value,
+-- which was named 'version' in the interfaces imported before
+UPDATE contract_interfaces AS i
+SET get_methods_desc = (
+    SELECT coalesce(jsonb_agg(
+        CASE WHEN gm->>'name' = 'is_stable' AND gm->'return_values'->0->>'name' = 'version'
+             THEN jsonb_set(gm, '{return_values,0,name}', '"is_stable"')
+             ELSE gm END
+        ORDER BY gm_idx), '[]'::jsonb)
+    FROM jsonb_array_elements(i.get_methods_desc::jsonb) WITH ORDINALITY AS g(gm, gm_idx)
+)::text
+WHERE i.name = 'dedust_v2_pool'
+  AND jsonb_typeof(i.get_methods_desc::jsonb) = 'array';
+
+--bun:split
+
+-- fake contracts are detected only by verification rules,
+-- so rules of known interfaces are added to the interfaces imported before they were introduced
+UPDATE contract_interfaces AS i
+SET verification = r.verification
+FROM (
+    VALUES
+        ('jetton_wallet', '{"parent_interface":"jetton_minter","parent_address":{"get_method":"get_wallet_data","return_value":"jetton_master_address"},"get_method":"get_wallet_address","arguments":[{"get_method":"get_wallet_data","return_value":"owner_address"}],"return_value":"wallet_address"}'::jsonb),
+        ('nft_item', '{"parent_interface":"nft_collection","parent_address":{"get_method":"get_nft_data","return_value":"collection_address"},"get_method":"get_nft_address_by_index","arguments":[{"get_method":"get_nft_data","return_value":"index"}],"return_value":"address"}'::jsonb),
+        ('dedust_v2_pool', '{"parent_interface":"dedust_v2_factory","get_method":"get_pool_address","arguments":[{"get_method":"is_stable","return_value":"is_stable"},{"get_method":"get_assets","return_value":"asset0"},{"get_method":"get_assets","return_value":"asset1"}],"return_value":"pool_addr"}'::jsonb),
+        ('stonfi_pool', '{"parent_interface":"stonfi_router","get_method":"get_pool_address","arguments":[{"get_method":"get_pool_data","return_value":"token0_wallet_address"},{"get_method":"get_pool_data","return_value":"token1_wallet_address"}],"return_value":"pool"}'::jsonb)
+) AS r (name, verification)
+WHERE i.name = r.name
+  AND i.verification IS NULL;
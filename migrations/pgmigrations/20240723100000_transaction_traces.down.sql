@@ -0,0 +1,17 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+DROP INDEX messages_trace_id_idx;
+
+--bun:split
+
+DROP INDEX transactions_trace_id_idx;
+
+--bun:split
+
+ALTER TABLE messages DROP COLUMN trace_id;
+
+--bun:split
+
+ALTER TABLE transactions DROP COLUMN trace_id;
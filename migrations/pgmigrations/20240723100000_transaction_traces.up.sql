@@ -0,0 +1,17 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE transactions ADD COLUMN trace_id bytea;
+
+--bun:split
+
+ALTER TABLE messages ADD COLUMN trace_id bytea;
+
+--bun:split
+
+CREATE INDEX transactions_trace_id_idx ON transactions USING hash (trace_id) WHERE (trace_id IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX messages_trace_id_idx ON messages USING hash (trace_id) WHERE (trace_id IS NOT NULL);
@@ -0,0 +1,50 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+CREATE TABLE jetton_transfers (
+    msg_hash bytea NOT NULL,
+    trace_id bytea,
+    minter_address bytea,
+    src_wallet bytea NOT NULL,
+    src_owner bytea,
+    dst_wallet bytea NOT NULL,
+    dst_owner bytea,
+    amount numeric NOT NULL,
+    query_id numeric NOT NULL,
+    forward_ton_amount numeric,
+    forward_payload bytea,
+    status text NOT NULL,
+    workchain integer NOT NULL,
+    shard bigint NOT NULL,
+    block_seq_no integer NOT NULL,
+    dst_tx_lt bigint NOT NULL,
+    created_lt bigint NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    received_at timestamp without time zone NOT NULL,
+    CONSTRAINT jetton_transfers_pkey PRIMARY KEY (msg_hash)
+);
+
+--bun:split
+
+CREATE INDEX jetton_transfers_created_lt_idx ON jetton_transfers USING btree (created_lt);
+
+--bun:split
+
+CREATE INDEX jetton_transfers_minter_address_idx ON jetton_transfers USING hash (minter_address) WHERE (minter_address IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX jetton_transfers_src_owner_idx ON jetton_transfers USING hash (src_owner) WHERE (src_owner IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX jetton_transfers_dst_owner_idx ON jetton_transfers USING hash (dst_owner) WHERE (dst_owner IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX jetton_transfers_trace_id_idx ON jetton_transfers USING hash (trace_id) WHERE (trace_id IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX jetton_transfers_workchain_shard_block_seq_no_idx ON jetton_transfers USING btree (workchain, shard, block_seq_no);
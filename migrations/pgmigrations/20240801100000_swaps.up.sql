@@ -0,0 +1,42 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+CREATE TABLE swaps (
+    tx_hash bytea NOT NULL,
+    trace_id bytea,
+    dex text NOT NULL,
+    pool_address bytea NOT NULL,
+    trader bytea,
+    asset_in bytea,
+    asset_out bytea,
+    amount_in numeric NOT NULL,
+    amount_out numeric NOT NULL,
+    price double precision NOT NULL,
+    workchain integer NOT NULL,
+    shard bigint NOT NULL,
+    block_seq_no integer NOT NULL,
+    created_lt bigint NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    CONSTRAINT swaps_pkey PRIMARY KEY (tx_hash)
+);
+
+--bun:split
+
+CREATE INDEX swaps_created_lt_idx ON swaps USING btree (created_lt);
+
+--bun:split
+
+CREATE INDEX swaps_pool_address_idx ON swaps USING hash (pool_address);
+
+--bun:split
+
+CREATE INDEX swaps_trader_idx ON swaps USING hash (trader) WHERE (trader IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX swaps_trace_id_idx ON swaps USING hash (trace_id) WHERE (trace_id IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX swaps_workchain_shard_block_seq_no_idx ON swaps USING btree (workchain, shard, block_seq_no);
@@ -0,0 +1,29 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+DROP INDEX account_states_asset1_address_idx;
+
+--bun:split
+
+DROP INDEX account_states_asset0_address_idx;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN reserve1;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN reserve0;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN asset1_address;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN asset0_address;
+
+--bun:split
+
+ALTER TABLE account_states DROP COLUMN token_supply;
@@ -0,0 +1,29 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN token_supply numeric;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN asset0_address bytea;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN asset1_address bytea;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN reserve0 numeric;
+
+--bun:split
+
+ALTER TABLE account_states ADD COLUMN reserve1 numeric;
+
+--bun:split
+
+CREATE INDEX account_states_asset0_address_idx ON account_states USING hash (asset0_address) WHERE (asset0_address IS NOT NULL);
+
+--bun:split
+
+CREATE INDEX account_states_asset1_address_idx ON account_states USING hash (asset1_address) WHERE (asset1_address IS NOT NULL);
@@ -0,0 +1,25 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN error;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN started_at;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN total;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN scanned;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN cancelled;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN priority;
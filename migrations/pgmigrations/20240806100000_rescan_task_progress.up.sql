@@ -0,0 +1,25 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN priority integer NOT NULL DEFAULT 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN cancelled boolean NOT NULL DEFAULT false;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN scanned integer NOT NULL DEFAULT 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN total integer NOT NULL DEFAULT 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN started_at timestamp without time zone;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN error text;
@@ -0,0 +1,21 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN scope_addresses;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN scope_to_time;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN scope_from_time;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN scope_to_master_seq_no;
+
+--bun:split
+
+ALTER TABLE rescan_tasks DROP COLUMN scope_from_master_seq_no;
@@ -0,0 +1,21 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN scope_from_master_seq_no integer;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN scope_to_master_seq_no integer;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN scope_from_time timestamp without time zone;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN scope_to_time timestamp without time zone;
+
+--bun:split
+
+ALTER TABLE rescan_tasks ADD COLUMN scope_addresses bytea[];
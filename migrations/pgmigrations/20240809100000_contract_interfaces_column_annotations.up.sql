@@ -0,0 +1,46 @@
+SET statement_timeout = 0;
+
+--bun:split
+
+-- get-method return values fill account columns only if they are annotated with the column name,
+-- so annotations of known interfaces are added to the interfaces imported before they were introduced
+WITH annotations (interface_name, get_method, return_value, account_column) AS (
+    VALUES
+        ('nft_collection', 'get_collection_data', 'collection_content', 'content'),
+        ('nft_collection', 'get_collection_data', 'owner_address', 'owner_address'),
+        ('nft_item', 'get_nft_data', 'collection_address', 'minter_address'),
+        ('nft_item', 'get_nft_data', 'owner_address', 'owner_address'),
+        ('nft_sale', 'get_sale_data', 'nft_owner_address', 'owner_address'),
+        ('jetton_minter', 'get_jetton_data', 'total_supply', 'token_supply'),
+        ('jetton_minter', 'get_jetton_data', 'content', 'content'),
+        ('jetton_wallet', 'get_wallet_data', 'balance', 'token_balance'),
+        ('jetton_wallet', 'get_wallet_data', 'owner_address', 'owner_address'),
+        ('jetton_wallet', 'get_wallet_data', 'jetton_master_address', 'minter_address'),
+        ('bcl_wallet', 'get_wallet_data', 'balance', 'token_balance'),
+        ('bcl_wallet', 'get_wallet_data', 'owner', 'owner_address'),
+        ('dedust_v2_pool', 'get_reserves', 'asset0_reserve', 'reserve0'),
+        ('dedust_v2_pool', 'get_reserves', 'asset1_reserve', 'reserve1'),
+        ('dedust_v2_pool', 'get_assets', 'asset0', 'asset0_address'),
+        ('dedust_v2_pool', 'get_assets', 'asset1', 'asset1_address'),
+        ('stonfi_pool', 'get_pool_data', 'reserve0', 'reserve0'),
+        ('stonfi_pool', 'get_pool_data', 'reserve1', 'reserve1'),
+        ('stonfi_pool', 'get_pool_data', 'token0_wallet_address', 'asset0_address'),
+        ('stonfi_pool', 'get_pool_data', 'token1_wallet_address', 'asset1_address')
+)
+UPDATE contract_interfaces AS i
+SET get_methods_desc = (
+    SELECT coalesce(jsonb_agg(
+        CASE WHEN jsonb_typeof(gm->'return_values') = 'array' THEN jsonb_set(gm, '{return_values}', (
+            SELECT coalesce(jsonb_agg(
+                CASE WHEN a.account_column IS NULL OR rv->'column' IS NOT NULL THEN rv
+                     ELSE rv || jsonb_build_object('column', a.account_column) END
+                ORDER BY rv_idx), '[]'::jsonb)
+            FROM jsonb_array_elements(gm->'return_values') WITH ORDINALITY AS r(rv, rv_idx)
+            LEFT JOIN annotations AS a
+                ON a.interface_name = i.name AND a.get_method = gm->>'name' AND a.return_value = rv->>'name'
+        )) ELSE gm END
+        ORDER BY gm_idx), '[]'::jsonb)
+    FROM jsonb_array_elements(i.get_methods_desc::jsonb) WITH ORDINALITY AS g(gm, gm_idx)
+)::text
+WHERE i.name IN (SELECT interface_name FROM annotations)
+  AND jsonb_typeof(i.get_methods_desc::jsonb) = 'array';